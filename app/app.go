@@ -22,10 +22,13 @@ import (
 	"github.com/maticnetwork/heimdall/chainmanager"
 	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointClient "github.com/maticnetwork/heimdall/checkpoint/client"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/clerk"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/delegation"
+	delegationTypes "github.com/maticnetwork/heimdall/delegation/types"
 	gov "github.com/maticnetwork/heimdall/gov"
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
@@ -45,6 +48,9 @@ import (
 	topupTypes "github.com/maticnetwork/heimdall/topup/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmModule "github.com/maticnetwork/heimdall/types/module"
+	"github.com/maticnetwork/heimdall/upgrade"
+	upgradeClient "github.com/maticnetwork/heimdall/upgrade/client"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -77,8 +83,16 @@ var (
 		bor.AppModuleBasic{},
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
+		delegation.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
+		upgrade.AppModuleBasic{},
+		gov.NewAppModuleBasic(
+			paramsClient.ProposalHandler,
+			upgradeClient.ProposalHandler,
+			upgradeClient.CancelProposalHandler,
+			checkpointClient.AdjustProposalHandler,
+			checkpointClient.BufferFlushProposalHandler,
+		),
 	)
 
 	// module account permissions
@@ -115,7 +129,9 @@ type HeimdallApp struct {
 	BorKeeper         bor.Keeper
 	ClerkKeeper       clerk.Keeper
 	TopupKeeper       topup.Keeper
+	DelegationKeeper  delegation.Keeper
 	SlashingKeeper    slashing.Keeper
+	UpgradeKeeper     upgrade.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -131,6 +147,15 @@ type HeimdallApp struct {
 
 	// simulation module manager
 	sm *hmModule.SimulationManager
+
+	// invariants of all modules
+	invariants *hmModule.InvariantRegistry
+
+	// number of blocks between invariant checks in end blocker, 0 disables them
+	invCheckPeriod uint64
+
+	// receives validator updates sent to tendermint from end blocker
+	validatorUpdateListener func(height int64, updates []abci.ValidatorUpdate)
 }
 
 var logger = helper.Logger.With("module", "app")
@@ -179,6 +204,12 @@ func (d ModuleCommunicator) SendCoins(ctx sdk.Context, fromAddr types.HeimdallAd
 	return d.App.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// GetCheckpointAckedHeight returns height at which checkpoint was acked, if its metadata is recorded
+func (d ModuleCommunicator) GetCheckpointAckedHeight(ctx sdk.Context, number uint64) (int64, bool) {
+	metadata, found := d.App.CheckpointKeeper.GetMetadata(ctx, number)
+	return metadata.AckedHeight, found
+}
+
 // Create ValidatorSigningInfo used by slashing module
 func (d ModuleCommunicator) CreateValiatorSigningInfo(ctx sdk.Context, valID types.ValidatorID, valSigningInfo types.ValidatorSigningInfo) {
 	d.App.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, valSigningInfo)
@@ -218,7 +249,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
+		delegationTypes.StoreKey,
 		paramsTypes.StoreKey,
+		upgradeTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
 
@@ -329,11 +362,30 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	// upgrade keeper
+	app.UpgradeKeeper = upgrade.NewKeeper(
+		app.cdc,
+		keys[upgradeTypes.StoreKey], // target store
+		upgradeTypes.DefaultCodespace,
+	)
+
+	app.CheckpointKeeper = checkpoint.NewKeeper(
+		app.cdc,
+		keys[checkpointTypes.StoreKey], // target store
+		app.subspaces[checkpointTypes.ModuleName],
+		common.DefaultCodespace,
+		app.StakingKeeper,
+		app.ChainKeeper,
+		moduleCommunicator,
+	)
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.
 		AddRoute(govTypes.RouterKey, govTypes.ProposalHandler).
-		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
+		AddRoute(paramsTypes.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradeTypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(checkpointTypes.RouterKey, checkpoint.NewProposalHandler(app.CheckpointKeeper))
 
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc,
@@ -345,16 +397,6 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		govRouter,
 	)
 
-	app.CheckpointKeeper = checkpoint.NewKeeper(
-		app.cdc,
-		keys[checkpointTypes.StoreKey], // target store
-		app.subspaces[checkpointTypes.ModuleName],
-		common.DefaultCodespace,
-		app.StakingKeeper,
-		app.ChainKeeper,
-		moduleCommunicator,
-	)
-
 	app.BorKeeper = bor.NewKeeper(
 		app.cdc,
 		keys[borTypes.StoreKey], // target store
@@ -384,9 +426,19 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.StakingKeeper,
 	)
 
+	app.DelegationKeeper = delegation.NewKeeper(
+		app.cdc,
+		keys[delegationTypes.StoreKey],
+		delegationTypes.DefaultCodespace,
+		app.ChainKeeper,
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
+	// NOTE: upgrade module must be the first one so that it runs its begin blocker
+	// (and halts or migrates stores) before any other module.
 	app.mm = module.NewManager(
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		sidechannel.NewAppModule(app.SidechannelKeeper),
 		auth.NewAppModule(app.AccountKeeper, &app.caller, []authTypes.AccountProcessor{
 			supplyTypes.AccountProcessor,
@@ -401,11 +453,13 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
+		delegation.NewAppModule(app.DelegationKeeper, &app.caller),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
+		upgradeTypes.ModuleName,
 		sidechannelTypes.ModuleName,
 		authTypes.ModuleName,
 		bankTypes.ModuleName,
@@ -418,11 +472,20 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		delegationTypes.ModuleName,
 	)
 
 	// register message routes and query routes
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
+	// register invariants
+	app.invariants = hmModule.NewInvariantRegistry()
+	app.mm.RegisterInvariants(app.invariants)
+	app.invCheckPeriod = helper.GetConfig().InvariantCheckPeriod
+
+	// register store migrations of upgrades known to this binary
+	app.registerUpgradeHandlers()
+
 	// side router
 	app.sideRouter = types.NewSideRouter()
 	for _, m := range app.mm.Modules {
@@ -560,6 +623,9 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 
 // EndBlocker executes on each end block
 func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// events of end block only, returned along with events of modules
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 	// transfer fees to current proposer
 	if proposer, ok := app.AccountKeeper.GetBlockProposer(ctx); ok {
 		moduleAccount := app.SupplyKeeper.GetModuleAccount(ctx, authTypes.FeeCollectorName)
@@ -616,18 +682,36 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 				Power:  int64(v.VotingPower),
 				PubKey: v.PubKey.ABCIPubKey(),
 			})
+
+			// record validator set change in validator history
+			app.StakingKeeper.AddValidatorHistory(ctx, *v, stakingTypes.HistoryEventValidatorSetChange)
 		}
 	}
 
 	// end block
-	app.mm.EndBlock(ctx, req)
+	moduleRes := app.mm.EndBlock(ctx, req)
+
+	// check invariants periodically
+	if app.invCheckPeriod != 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
+		app.AssertInvariants(ctx)
+	}
+
+	if app.validatorUpdateListener != nil {
+		app.validatorUpdateListener(ctx.BlockHeight(), tmValUpdates)
+	}
 
-	// send validator updates to peppermint
+	// send validator updates to peppermint, events are fetched by bridge
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
+		Events:           append(ctx.EventManager().ABCIEvents(), moduleRes.Events...),
 	}
 }
 
+// SetValidatorUpdateListener sets listener called with validator updates on every end block
+func (app *HeimdallApp) SetValidatorUpdateListener(listener func(height int64, updates []abci.ValidatorUpdate)) {
+	app.validatorUpdateListener = listener
+}
+
 // LoadHeight loads a particular height
 func (app *HeimdallApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[bam.MainStoreKey])
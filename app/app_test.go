@@ -1,19 +1,36 @@
 package app
 
 import (
+	"math/big"
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/delegation"
+	delegationTypes "github.com/maticnetwork/heimdall/delegation/types"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	"github.com/maticnetwork/heimdall/simulation"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
 )
 
 func TestHeimdallAppExport(t *testing.T) {
@@ -133,3 +150,423 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+// passGovProposal submits content as a proposal voted yes by a single validator, with voting ending at block time
+func passGovProposal(t *testing.T, happ *HeimdallApp, ctx sdk.Context, content govTypes.Content) {
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+	require.NoError(t, happ.StakingKeeper.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet([]*hmTypes.Validator{validator})))
+
+	proposal, err := happ.GovKeeper.SubmitProposal(ctx, content)
+	require.NoError(t, err)
+
+	happ.GovKeeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
+	proposal.Status = govTypes.StatusVotingPeriod
+	proposal.VotingStartTime = ctx.BlockTime()
+	proposal.VotingEndTime = ctx.BlockTime()
+	happ.GovKeeper.SetProposal(ctx, proposal)
+	happ.GovKeeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+	require.NoError(t, happ.GovKeeper.AddVote(ctx, proposal.ProposalID, validator.Signer, govTypes.OptionYes, validator.ID))
+}
+
+// findEvent returns first event of type in events
+func findEvent(events []abci.Event, eventType string) (abci.Event, bool) {
+	for _, event := range events {
+		if event.Type == eventType {
+			return event, true
+		}
+	}
+
+	return abci.Event{}, false
+}
+
+func TestEndBlockerCheckpointFlushEvent(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointBuffer(ctx, checkpoint))
+	passGovProposal(t, happ, ctx, checkpointTypes.NewCheckpointBufferFlushProposal("Flush", "Rootchain moved on", checkpoint.Proposer, 0, ""))
+
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+
+	// proposal passed and flush event of its handler reaches end block response
+	_, ok := findEvent(res.Events, govTypes.EventTypeActiveProposal)
+	require.True(t, ok)
+	event, ok := findEvent(res.Events, checkpointTypes.EventTypeCheckpointFlush)
+	require.True(t, ok, "Flush event should be in end block events")
+	require.Equal(t, checkpointTypes.EventTypeCheckpointFlush, sdk.StringifyEvent(event).Type)
+
+	buffer, _ := happ.CheckpointKeeper.GetCheckpointFromBuffer(ctx)
+	require.Nil(t, buffer)
+}
+
+func TestEndBlockerCheckpointFlushChangedBuffer(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointBuffer(ctx, checkpoint))
+	passGovProposal(t, happ, ctx, checkpointTypes.NewCheckpointBufferFlushProposal("Flush", "Rootchain moved on", checkpoint.Proposer, 0, ""))
+
+	// buffer timed out and proposer proposed another checkpoint while proposal was voted on
+	reproposed := hmTypes.CreateBlock(0, 300, hmTypes.HexToHeimdallHash("456"), checkpoint.Proposer, "1234", 2)
+	happ.CheckpointKeeper.FlushCheckpointBuffer(ctx)
+	require.NoError(t, happ.CheckpointKeeper.SetCheckpointBuffer(ctx, reproposed))
+
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+
+	event, ok := findEvent(res.Events, checkpointTypes.EventTypeCheckpointFlush)
+	require.True(t, ok, "Flush event should be in end block events")
+	require.Equal(t, "300", eventAttributes(event)[checkpointTypes.AttributeKeyEndBlock])
+
+	buffer, _ := happ.CheckpointKeeper.GetCheckpointFromBuffer(ctx)
+	require.Nil(t, buffer)
+}
+
+func TestEndBlockerCheckpointAdjustEvent(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+	require.NoError(t, happ.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpoint))
+	happ.CheckpointKeeper.UpdateACKCount(ctx)
+	passGovProposal(t, happ, ctx, checkpointTypes.NewCheckpointAdjustProposal("Adjust", "Match rootchain header", 1, hmTypes.HexToHeimdallAddress("456"), 0, 200, hmTypes.HexToHeimdallHash("456"), ""))
+
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+
+	// approve event of proposal handler reaches end block response
+	event, ok := findEvent(res.Events, checkpointTypes.EventTypeCheckpointAdjustApprove)
+	require.True(t, ok, "Adjust approve event should be in end block events")
+
+	attributes := eventAttributes(event)
+	require.Equal(t, "1", attributes[checkpointTypes.AttributeKeyHeaderIndex])
+	require.Equal(t, "200", attributes[checkpointTypes.AttributeKeyEndBlock])
+
+	_, found := happ.CheckpointKeeper.GetApprovedAdjust(ctx, 1)
+	require.True(t, found)
+	require.Empty(t, happ.CheckpointKeeper.GetAdjustments(ctx))
+}
+
+// eventAttributes returns attributes of event by key
+func eventAttributes(event abci.Event) map[string]string {
+	attributes := make(map[string]string)
+	for _, attribute := range sdk.StringifyEvent(event).Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+
+	return attributes
+}
+
+func TestEndBlockerValidatorStatusEvent(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// validator leaves validator set at epoch 2, which starts with first ack
+	happ.StakingKeeper.SetValidatorExit(ctx, stakingTypes.NewValidatorExit(1, 2, 1))
+	happ.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+
+	// status event of staking end blocker reaches end block response
+	event, ok := findEvent(res.Events, stakingTypes.EventTypeValidatorStatus)
+	require.True(t, ok, "Validator status event should be in end block events")
+
+	attributes := eventAttributes(event)
+	require.Equal(t, "1", attributes[stakingTypes.AttributeKeyValidatorID])
+	require.Equal(t, stakingTypes.ValidatorStatusExited, attributes[stakingTypes.AttributeKeyStatus])
+}
+
+func TestUpgradeProposal(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// chains started before the upgrade have no mirror
+	ctx.KVStore(happ.keys[delegationTypes.StoreKey]).Delete(delegation.MirroredSinceKey)
+
+	// upgrade is scheduled by the binary which ships its handler
+	plan := upgradeTypes.NewPlan(DelegationMirrorUpgrade, 10, "")
+	passGovProposal(t, happ, ctx, upgradeTypes.NewSoftwareUpgradeProposal("Mirror", "Mirror delegations", plan))
+	happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+
+	scheduled, found := happ.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, scheduled)
+
+	// blocks before the plan height are processed without applying the upgrade
+	for height := int64(2); height < plan.Height; height++ {
+		require.NotPanics(t, func() {
+			happ.BeginBlocker(ctx.WithBlockHeight(height), abci.RequestBeginBlock{})
+		})
+	}
+	require.False(t, happ.DelegationKeeper.IsMirrorEnabled(ctx))
+
+	// upgrade is applied at the plan height
+	happ.BeginBlocker(ctx.WithBlockHeight(plan.Height), abci.RequestBeginBlock{})
+	require.True(t, happ.DelegationKeeper.IsMirrorEnabled(ctx))
+	require.Equal(t, plan.Height, happ.UpgradeKeeper.GetDoneHeight(ctx, DelegationMirrorUpgrade))
+
+	_, found = happ.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestValidatorExitStatusUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// withdrawal delay param is missing before the upgrade
+	paramsStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(stakingTypes.DefaultParamspace), '/'))
+	paramsStore.Delete(stakingTypes.KeyWithdrawalDelay)
+	require.False(t, happ.StakingKeeper.IsValidatorExitTracked(ctx))
+
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+
+	// exits are not written before the upgrade, state stays as the old binary writes it
+	msg := stakingTypes.NewMsgValidatorExit(validator.Signer, 1, 10, hmTypes.HexToHeimdallHash("123"), 0, 10, 1)
+	result := staking.PostHandleMsgValidatorExit(ctx, happ.StakingKeeper, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected validator exit to be ok, got %v", result)
+	_, ok := happ.StakingKeeper.GetValidatorExit(ctx, validator.ID)
+	require.False(t, ok, "Exit should not be tracked before upgrade")
+
+	// upgrade backfills exit of the validator
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: ValidatorExitStatusUpgrade, Height: 1})
+	require.True(t, happ.StakingKeeper.IsValidatorExitTracked(ctx))
+
+	exit, ok := happ.StakingKeeper.GetValidatorExit(ctx, validator.ID)
+	require.True(t, ok, "Exit should be backfilled by upgrade")
+	require.Equal(t, stakingTypes.ValidatorStatusExiting, exit.Status)
+	require.Equal(t, uint64(10), exit.DeactivationEpoch)
+}
+
+func TestEndBlockerBeforeValidatorExitStatusUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// staking params added by upgrades are missing before them
+	paramsStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(stakingTypes.DefaultParamspace), '/'))
+	paramsStore.Delete(stakingTypes.KeyHistoryRetention)
+	paramsStore.Delete(stakingTypes.KeyHistoryPruneLimit)
+	paramsStore.Delete(stakingTypes.KeyWithdrawalDelay)
+	require.Equal(t, stakingTypes.DefaultParams(), happ.StakingKeeper.GetParams(ctx))
+
+	// exit queued in store is not moved along before the upgrade
+	happ.StakingKeeper.SetValidatorExit(ctx, stakingTypes.NewValidatorExit(1, 2, 1))
+	happ.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+
+	res := happ.EndBlocker(ctx, abci.RequestEndBlock{Height: 1})
+	_, ok := findEvent(res.Events, stakingTypes.EventTypeValidatorStatus)
+	require.False(t, ok, "Validator status event should not be emitted before upgrade")
+
+	exit, ok := happ.StakingKeeper.GetValidatorExit(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, stakingTypes.ValidatorStatusExiting, exit.Status)
+}
+
+func TestCheckpointIndexUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// checkpoint indices are not written before the upgrade
+	ctx.KVStore(happ.keys[checkpointTypes.StoreKey]).Delete(checkpoint.IndexEnabledKey)
+	require.False(t, happ.CheckpointKeeper.IsIndexEnabled(ctx))
+
+	// ack rotates proposer of validator set
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+	require.NoError(t, happ.StakingKeeper.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet([]*hmTypes.Validator{validator})))
+
+	proposer := validator.Signer
+	msg := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("123"), "")
+	result := checkpoint.PostHandleMsgCheckpoint(ctx, happ.CheckpointKeeper, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	ack := checkpointTypes.NewMsgCheckpointAck(proposer, 1, proposer, 0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("456"), 1, "")
+	result = checkpoint.PostHandleMsgCheckpointAck(ctx, happ.CheckpointKeeper, ack, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+	_, ok := happ.CheckpointKeeper.GetCheckpointNumberByBlock(ctx, 255)
+	require.False(t, ok, "Checkpoint should not be indexed before upgrade")
+	require.Empty(t, happ.CheckpointKeeper.GetAckTxs(ctx))
+	_, ok = happ.CheckpointKeeper.GetApproval(ctx, 1)
+	require.False(t, ok, "Approval should not be kept before upgrade")
+	require.Empty(t, happ.CheckpointKeeper.GetAllMetadata(ctx))
+
+	// upgrade indexes stored checkpoints by end block
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: CheckpointIndexUpgrade, Height: 1})
+	require.True(t, happ.CheckpointKeeper.IsIndexEnabled(ctx))
+
+	number, ok := happ.CheckpointKeeper.GetCheckpointNumberByBlock(ctx, 255)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), number)
+
+	// checkpoints acked after the upgrade are indexed along with approval and metadata
+	msg = checkpointTypes.NewMsgCheckpointBlock(proposer, 256, 511, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("123"), "")
+	result = checkpoint.PostHandleMsgCheckpoint(ctx, happ.CheckpointKeeper, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+	ack = checkpointTypes.NewMsgCheckpointAck(proposer, 2, proposer, 256, 511, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallHash("789"), 1, "")
+	result = checkpoint.PostHandleMsgCheckpointAck(ctx, happ.CheckpointKeeper, ack, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+	number, ok = happ.CheckpointKeeper.GetCheckpointNumberByAckTx(ctx, ack.TxHash, ack.LogIndex)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), number)
+	_, ok = happ.CheckpointKeeper.GetApproval(ctx, 2)
+	require.True(t, ok)
+	_, ok = happ.CheckpointKeeper.GetMetadata(ctx, 2)
+	require.True(t, ok)
+}
+
+func TestPowerScalingUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// power shift is missing before the upgrade
+	ctx.KVStore(happ.keys[stakingTypes.StoreKey]).Delete(staking.PowerShiftKey)
+	require.False(t, happ.StakingKeeper.IsPowerScalingEnabled(ctx))
+
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+
+	// stake is not stored and voting power is whole tokens before the upgrade, as the old binary writes it
+	amount := sdk.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(20), hmTypes.PowerReduction))
+	msg := stakingTypes.NewMsgStakeUpdate(validator.Signer, 1, amount, hmTypes.HexToHeimdallHash("123"), 0, 10, 2)
+	result := staking.PostHandleMsgStakeUpdate(ctx, happ.StakingKeeper, msg, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected stake update to be ok, got %v", result)
+
+	updated, ok := happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, "", updated.Stake)
+	require.Equal(t, int64(20), updated.VotingPower)
+	require.False(t, happ.StakingKeeper.IsPowerScalingEnabled(ctx))
+
+	// upgrade stores stake of existing validators
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: PowerScalingUpgrade, Height: 1})
+	require.True(t, happ.StakingKeeper.IsPowerScalingEnabled(ctx))
+
+	updated, _ = happ.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.Equal(t, amount.BigInt().String(), updated.Stake)
+	require.Equal(t, int64(20), updated.VotingPower)
+}
+
+func TestValidatorDescriptionUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// descriptions are rejected before the upgrade
+	ctx.KVStore(happ.keys[stakingTypes.StoreKey]).Delete(staking.DescriptionEnabledKey)
+	require.False(t, happ.StakingKeeper.IsDescriptionEnabled(ctx))
+
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+
+	msg := stakingTypes.NewMsgValidatorDescription(validator.Signer, 1, stakingTypes.NewDescription("validator-1", "", "", ""))
+	result := staking.HandleMsgValidatorDescription(ctx, msg, happ.StakingKeeper)
+	require.Equal(t, common.CodeDescriptionDisabled, result.Code)
+	_, ok := happ.StakingKeeper.GetValidatorDescription(ctx, validator.ID)
+	require.False(t, ok)
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: ValidatorDescriptionUpgrade, Height: 1})
+	require.True(t, happ.StakingKeeper.IsDescriptionEnabled(ctx))
+
+	result = staking.HandleMsgValidatorDescription(ctx, msg, happ.StakingKeeper)
+	require.True(t, result.IsOK(), "expected validator description to be ok, got %v", result)
+	description, ok := happ.StakingKeeper.GetValidatorDescription(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, msg.Description, description)
+}
+
+func TestChildChainsUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// child chain params are missing before the upgrade
+	chainmanagerStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(chainmanagerTypes.DefaultParamspace), '/'))
+	chainmanagerStore.Delete(chainmanagerTypes.KeyChildChains)
+	checkpointStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(checkpointTypes.DefaultParamspace), '/'))
+	checkpointStore.Delete(checkpointTypes.KeyChildChains)
+	require.False(t, happ.ChainKeeper.IsChildChainsEnabled(ctx))
+
+	borChainID := happ.ChainKeeper.GetParams(ctx).ChainParams.BorChainID
+	require.NotEmpty(t, borChainID)
+	require.Equal(t, checkpointTypes.DefaultParams().AvgCheckpointLength, happ.CheckpointKeeper.GetParams(ctx).AvgCheckpointLength)
+
+	// any bor chain id selects the primary sequence before the upgrade
+	keeper, ok := happ.CheckpointKeeper.ForChain(ctx, "5678")
+	require.True(t, ok)
+	require.Equal(t, "", keeper.BorChainID())
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: ChildChainsUpgrade, Height: 1})
+	require.True(t, happ.ChainKeeper.IsChildChainsEnabled(ctx))
+	require.Empty(t, happ.ChainKeeper.GetParams(ctx).ChildChains)
+
+	_, ok = happ.CheckpointKeeper.ForChain(ctx, "5678")
+	require.False(t, ok, "Unregistered chain should be rejected after upgrade")
+}
+
+func TestCheckpointAdjustProposalUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// direct adjust param is missing before the upgrade
+	checkpointStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(checkpointTypes.DefaultParamspace), '/'))
+	checkpointStore.Delete(checkpointTypes.KeyDirectAdjustEnabled)
+	require.True(t, happ.CheckpointKeeper.GetParams(ctx).DirectAdjustEnabled)
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: CheckpointAdjustProposalUpgrade, Height: 1})
+	require.True(t, checkpointStore.Has(checkpointTypes.KeyDirectAdjustEnabled))
+	require.True(t, happ.CheckpointKeeper.GetParams(ctx).DirectAdjustEnabled)
+}
+
+func TestDelegationMirrorUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// chains started before the upgrade have no mirror
+	ctx.KVStore(happ.keys[delegationTypes.StoreKey]).Delete(delegation.MirroredSinceKey)
+	require.False(t, happ.DelegationKeeper.IsMirrorEnabled(ctx))
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: DelegationMirrorUpgrade, Height: 1})
+	require.True(t, happ.DelegationKeeper.IsMirrorEnabled(ctx))
+	require.Equal(t, uint64(0), happ.DelegationKeeper.GetMirroredSince(ctx))
+}
+
+func TestValidatorHistoryUpgrade(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Unix(1000, 0)})
+
+	// history params are missing before the upgrade, other params keep their values
+	paramsStore := prefix.NewStore(ctx.KVStore(happ.keys[paramsTypes.StoreKey]), append([]byte(stakingTypes.DefaultParamspace), '/'))
+	paramsStore.Delete(stakingTypes.KeyHistoryRetention)
+	paramsStore.Delete(stakingTypes.KeyHistoryPruneLimit)
+	happ.subspaces[stakingTypes.ModuleName].Set(ctx, stakingTypes.KeyWithdrawalDelay, uint64(7))
+
+	privKey := secp256k1.GenPrivKey()
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(privKey.PubKey().Bytes()), hmTypes.BytesToHeimdallAddress(privKey.PubKey().Address().Bytes()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+
+	// no history is written and params are readable before the upgrade
+	require.False(t, happ.StakingKeeper.IsValidatorHistoryTracked(ctx))
+	happ.StakingKeeper.AddValidatorHistory(ctx, *validator, stakingTypes.HistoryEventJoin)
+	require.Empty(t, happ.StakingKeeper.GetAllValidatorHistory(ctx))
+	require.Equal(t, uint64(0), happ.StakingKeeper.GetValidatorHistoryCount(ctx))
+	require.Equal(t, stakingTypes.DefaultHistoryPruneLimit, happ.StakingKeeper.GetParams(ctx).HistoryPruneLimit)
+
+	happ.UpgradeKeeper.ApplyUpgrade(ctx, upgradeTypes.Plan{Name: ValidatorHistoryUpgrade, Height: 1})
+
+	params := happ.StakingKeeper.GetParams(ctx)
+	require.Equal(t, stakingTypes.DefaultHistoryRetention, params.HistoryRetention)
+	require.Equal(t, stakingTypes.DefaultHistoryPruneLimit, params.HistoryPruneLimit)
+	require.Equal(t, uint64(7), params.WithdrawalDelay, "Upgrade should not reset other params")
+
+	records := happ.StakingKeeper.GetValidatorHistory(ctx, validator.ID, 0, 0, 0, 0)
+	require.Len(t, records, 1)
+	require.Equal(t, stakingTypes.HistoryEventSnapshot, records[0].Event)
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// Invariants returns the invariants registered by all modules
+func (app *HeimdallApp) Invariants() *hmModule.InvariantRegistry {
+	return app.invariants
+}
+
+// CheckInvariants runs all invariants and returns the messages of the broken ones
+func (app *HeimdallApp) CheckInvariants(ctx sdk.Context) []string {
+	return app.invariants.CheckInvariants(ctx)
+}
+
+// AssertInvariants halts the node if any invariant is broken
+func (app *HeimdallApp) AssertInvariants(ctx sdk.Context) {
+	start := time.Now()
+
+	if broken := app.CheckInvariants(ctx); len(broken) != 0 {
+		panic(fmt.Errorf("invariants broken at height %d:\n%s", ctx.BlockHeight(), strings.Join(broken, "\n")))
+	}
+
+	logger.Info("Asserted all invariants", "height", ctx.BlockHeight(), "duration", time.Since(start))
+}
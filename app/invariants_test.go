@@ -0,0 +1,93 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	require.NotEmpty(t, happ.Invariants().Routes())
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	// an ack without a stored checkpoint breaks the checkpoint invariant
+	happ.CheckpointKeeper.UpdateACKCount(ctx)
+	broken := happ.CheckInvariants(ctx)
+	require.Len(t, broken, 1)
+	require.Contains(t, broken[0], "checkpoint")
+
+	require.Panics(t, func() {
+		happ.AssertInvariants(ctx)
+	})
+
+	// spans must be contiguous
+	happ.CheckpointKeeper.UpdateACKCountWithValue(ctx, 0)
+	require.NoError(t, happ.BorKeeper.AddNewRawSpan(ctx, hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 10}))
+	require.NoError(t, happ.BorKeeper.AddNewRawSpan(ctx, hmTypes.Span{ID: 2, StartBlock: 20, EndBlock: 30}))
+	broken = happ.CheckInvariants(ctx)
+	require.Len(t, broken, 1)
+	require.Contains(t, broken[0], "bor")
+}
+
+func TestCheckInvariantsDividendAccounts(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	user := hmTypes.BytesToHeimdallAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	_, err := happ.BankKeeper.AddCoins(ctx, user, sdk.NewCoins(sdk.NewCoin(authTypes.FeeToken, sdk.NewInt(100))))
+	require.NoError(t, err)
+
+	// fees withdrawn to dividend accounts are collected
+	res := topup.HandleMsgWithdrawFee(ctx, happ.TopupKeeper, topupTypes.NewMsgWithdrawFee(user, sdk.NewInt(60)))
+	require.True(t, res.IsOK())
+	fees, tracked := happ.TopupKeeper.GetFeesCollected(ctx)
+	require.True(t, tracked)
+	require.Equal(t, big.NewInt(60), fees)
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	// dividends added without collecting fees break the topup invariant
+	require.NoError(t, happ.TopupKeeper.AddFeeToDividendAccount(ctx, user, big.NewInt(1)))
+	broken := happ.CheckInvariants(ctx)
+	require.Len(t, broken, 1)
+	require.Contains(t, broken[0], "fees collected:           60")
+
+	// the sum is not checked on chains started before fees collected were tracked
+	ctx.KVStore(happ.keys[topupTypes.StoreKey]).Delete(topup.FeesCollectedKey)
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	res = topup.HandleMsgWithdrawFee(ctx, happ.TopupKeeper, topupTypes.NewMsgWithdrawFee(user, sdk.NewInt(0)))
+	require.True(t, res.IsOK())
+	_, tracked = happ.TopupKeeper.GetFeesCollected(ctx)
+	require.False(t, tracked)
+}
+
+func TestCheckInvariantsChildChain(t *testing.T) {
+	happ := Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{})
+
+	chainParams := happ.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChain{{BorChainID: "child", RootChainAddress: hmTypes.HexToHeimdallAddress("123")}}
+	happ.ChainKeeper.SetParams(ctx, chainParams)
+	require.Empty(t, happ.CheckInvariants(ctx))
+
+	// an ack of child chain without a stored checkpoint breaks the checkpoint invariant
+	childKeeper, ok := happ.CheckpointKeeper.ForChain(ctx, "child")
+	require.True(t, ok)
+	childKeeper.UpdateACKCount(ctx)
+	broken := happ.CheckInvariants(ctx)
+	require.Len(t, broken, 1)
+	require.Contains(t, broken[0], "bor chain child: ack count: 1, stored checkpoints: 0")
+}
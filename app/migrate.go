@@ -0,0 +1,75 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/maticnetwork/heimdall/bor"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/clerk"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmModule "github.com/maticnetwork/heimdall/types/module"
+)
+
+// GenesisMigrations holds the genesis migrations of every target version keyed by module name.
+// Each target version migrates genesis exported by the version before it.
+var GenesisMigrations = map[string]map[string]hmModule.GenesisMigration{
+	// v0.2 exports lack record times, span chain ids and staking params, and key signing infos by signer
+	"v0.3": {
+		borTypes.ModuleName:      bor.MigrateGenesis,
+		clerkTypes.ModuleName:    clerk.MigrateGenesis,
+		slashingTypes.ModuleName: slashing.MigrateGenesis,
+		stakingTypes.ModuleName:  staking.MigrateGenesis,
+	},
+}
+
+// MigrateGenesis migrates the app state to the target version and validates the result.
+// Modules missing in the app state get their default genesis.
+func MigrateGenesis(target string, appState GenesisState) (GenesisState, error) {
+	migrations, ok := GenesisMigrations[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown migration target version %s", target)
+	}
+
+	oldState := make(GenesisState, len(appState))
+	for name, state := range appState {
+		oldState[name] = state
+	}
+
+	for name, state := range ModuleBasics.DefaultGenesis() {
+		if _, ok := oldState[name]; !ok {
+			oldState[name] = state
+		}
+	}
+
+	names := make([]string, 0, len(migrations))
+	for name := range migrations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// every migration reads the state of the previous version
+	newState := make(GenesisState, len(oldState))
+	for name, state := range oldState {
+		newState[name] = state
+	}
+
+	for _, name := range names {
+		state, err := migrations[name](oldState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate %s genesis: %v", name, err)
+		}
+
+		newState[name] = state
+	}
+
+	if err := ModuleBasics.ValidateGenesis(newState); err != nil {
+		return nil, fmt.Errorf("migrated genesis is invalid: %v", err)
+	}
+
+	return newState, nil
+}
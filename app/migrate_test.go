@@ -0,0 +1,111 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+)
+
+// migratedModules are the modules with a genesis migration to v0.3
+var migratedModules = []string{
+	borTypes.ModuleName,
+	clerkTypes.ModuleName,
+	slashingTypes.ModuleName,
+	stakingTypes.ModuleName,
+}
+
+// readGenesisFixture reads the app state of a genesis file in testdata
+func readGenesisFixture(t *testing.T, name string) GenesisState {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	var genDoc struct {
+		AppState GenesisState `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &genDoc))
+	return genDoc.AppState
+}
+
+func TestMigrateGenesis(t *testing.T) {
+	appState := readGenesisFixture(t, "genesis-v0.2.json")
+	expected := readGenesisFixture(t, "genesis-v0.3.json")
+
+	newState, err := MigrateGenesis("v0.3", appState)
+	require.NoError(t, err)
+
+	// modules missing in the exported genesis get their default genesis
+	require.Contains(t, newState, topupTypes.ModuleName)
+
+	for _, name := range migratedModules {
+		require.JSONEq(t, string(expected[name]), string(newState[name]), "%s genesis", name)
+	}
+
+	// the migrated genesis is imported and exported again unchanged
+	happ := NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB())
+	stateBytes, err := codec.MarshalJSONIndent(happ.Codec(), newState)
+	require.NoError(t, err)
+	happ.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	happ.Commit()
+
+	exportedBytes, _, err := happ.ExportAppStateAndValidators()
+	require.NoError(t, err)
+
+	var exported GenesisState
+	require.NoError(t, json.Unmarshal(exportedBytes, &exported))
+	for _, name := range migratedModules {
+		require.JSONEq(t, string(expected[name]), string(exported[name]), "exported %s genesis", name)
+	}
+
+	_, err = MigrateGenesis("v0.0", appState)
+	require.Error(t, err)
+}
+
+func TestMigrateGenesisInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		module string
+		state  string
+	}{
+		{
+			name:   "conflicting records",
+			module: clerkTypes.ModuleName,
+			state:  `{"event_records":[{"id":"1","log_index":"0"},{"id":"1","log_index":"1"}]}`,
+		},
+		{
+			name:   "duplicate spans",
+			module: borTypes.ModuleName,
+			state:  `{"params":{"sprint_duration":"64","span_duration":"6400","producer_count":"4"},"spans":[{"span_id":"1","start_block":"0","end_block":"255"},{"span_id":"1","start_block":"256","end_block":"511"}]}`,
+		},
+		{
+			name:   "overlapping spans",
+			module: borTypes.ModuleName,
+			state:  `{"params":{"sprint_duration":"64","span_duration":"6400","producer_count":"4"},"spans":[{"span_id":"0","start_block":"0","end_block":"255"},{"span_id":"1","start_block":"255","end_block":"511"}]}`,
+		},
+		{
+			name:   "missed blocks keyed by signer",
+			module: slashingTypes.ModuleName,
+			state:  `{"params":{"signed_blocks_window":"100"},"missed_blocks":{"0xa61027be0b2e419ed7b1dc612efbeabcab41ac4e":[]}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		appState := readGenesisFixture(t, "genesis-v0.2.json")
+		appState[tc.module] = json.RawMessage(tc.state)
+
+		_, err := MigrateGenesis("v0.3", appState)
+		require.Error(t, err, tc.name)
+	}
+}
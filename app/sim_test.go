@@ -20,8 +20,10 @@ import (
 	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	paramTypes "github.com/maticnetwork/heimdall/params/types"
 	"github.com/maticnetwork/heimdall/simulation"
+	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // Get flags every time the simulator is run
@@ -57,6 +59,9 @@ func TestFullAppSimulation(t *testing.T) {
 	app := NewHeimdallApp(logger, db)
 	require.Equal(t, AppName, app.Name())
 
+	// assert invariants at the end of every block
+	app.invCheckPeriod = 1
+
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t, os.Stdout, app.BaseApp, AppStateFn(app.Codec(), app.SimulationManager()),
@@ -131,12 +136,33 @@ func TestAppImportExport(t *testing.T) {
 	ctxB := newApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 	newApp.mm.InitGenesis(ctxB, genesisState)
 
+	// genesis import keeps validators of current validator set only, drop validators
+	// which joined or left during simulation from cached store before comparing
+	stakingStore := ctxA.KVStore(app.keys[stakingTypes.StoreKey])
+	currentValSet := app.StakingKeeper.GetValidatorSet(ctxA)
+	currentIDs := make(map[hmTypes.ValidatorID]bool)
+	for _, validator := range currentValSet.Validators {
+		currentIDs[validator.ID] = true
+	}
+
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctxA) {
+		if currentValSet.HasAddress(validator.Signer.Bytes()) {
+			continue
+		}
+
+		stakingStore.Delete(staking.GetValidatorKey(validator.Signer.Bytes()))
+		if !currentIDs[validator.ID] {
+			stakingStore.Delete(staking.GetValidatorMapKey(validator.ID.Bytes()))
+		}
+	}
+
 	fmt.Printf("comparing stores...\n")
 
 	storeKeysPrefixes := []StoreKeysPrefixes{
 		{app.keys[baseapp.MainStoreKey], newApp.keys[baseapp.MainStoreKey], [][]byte{}},
 		{app.keys[authTypes.StoreKey], newApp.keys[authTypes.StoreKey], [][]byte{}},
-		{app.keys[stakingTypes.StoreKey], newApp.keys[stakingTypes.StoreKey], [][]byte{}},
+		// proposer priorities of validators are recomputed on genesis import
+		{app.keys[stakingTypes.StoreKey], newApp.keys[stakingTypes.StoreKey], [][]byte{staking.ValidatorsKey, staking.CurrentValidatorSetKey}},
 		{app.keys[supplyTypes.StoreKey], newApp.keys[supplyTypes.StoreKey], [][]byte{}},
 		{app.keys[paramTypes.StoreKey], newApp.keys[paramTypes.StoreKey], [][]byte{}},
 		{app.keys[govTypes.StoreKey], newApp.keys[govTypes.StoreKey], [][]byte{}},
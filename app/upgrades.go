@@ -0,0 +1,103 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	upgradeTypes "github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+const (
+	// CheckpointIndexUpgrade is the name of the upgrade which indexes existing checkpoints by end block
+	// and starts keeping approvals and metadata of checkpoints. Approvals and metadata of checkpoints
+	// proposed before are not backfilled.
+	CheckpointIndexUpgrade = "checkpoint-index"
+
+	// ChildChainsUpgrade is the name of the upgrade which adds the child chain params
+	ChildChainsUpgrade = "child-chains"
+
+	// CheckpointAdjustProposalUpgrade is the name of the upgrade which adds the direct checkpoint adjust param
+	CheckpointAdjustProposalUpgrade = "checkpoint-adjust-proposal"
+
+	// ValidatorHistoryUpgrade is the name of the upgrade which adds the validator history params
+	// and snapshots current validators into validator history
+	ValidatorHistoryUpgrade = "validator-history"
+
+	// PowerScalingUpgrade is the name of the upgrade which stores stake of existing validators
+	// and scales their voting power by power shift
+	PowerScalingUpgrade = "power-scaling"
+
+	// ValidatorExitStatusUpgrade is the name of the upgrade which adds the withdrawal delay param
+	// and tracks exits of validators which already requested exit
+	ValidatorExitStatusUpgrade = "validator-exit-status"
+
+	// ValidatorDescriptionUpgrade is the name of the upgrade which starts accepting validator descriptions
+	ValidatorDescriptionUpgrade = "validator-description"
+
+	// DelegationMirrorUpgrade is the name of the upgrade which starts mirroring delegation events.
+	// Positions opened before the first mirrored event are not backfilled.
+	DelegationMirrorUpgrade = "delegation-mirror"
+)
+
+// registerUpgradeHandlers registers the store migrations of every upgrade known
+// to this binary with the upgrade keeper. The name of a handler must match the
+// name of the plan scheduled through a SoftwareUpgradeProposal.
+func (app *HeimdallApp) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(CheckpointIndexUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		app.CheckpointKeeper.EnableIndex(ctx)
+		app.CheckpointKeeper.BuildEndBlockIndex(ctx)
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(ChildChainsUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		// no child chains are registered before the upgrade
+		app.subspaces[chainmanagerTypes.ModuleName].Set(ctx, chainmanagerTypes.KeyChildChains, []chainmanagerTypes.ChildChain{})
+		app.subspaces[checkpointTypes.ModuleName].Set(ctx, checkpointTypes.KeyChildChains, []checkpointTypes.ChildChainParams{})
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(CheckpointAdjustProposalUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		// adjust msgs stay allowed until disabled through a param change proposal
+		app.subspaces[checkpointTypes.ModuleName].Set(ctx, checkpointTypes.KeyDirectAdjustEnabled, checkpointTypes.DefaultDirectAdjustEnabled)
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(ValidatorHistoryUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		app.subspaces[stakingTypes.ModuleName].Set(ctx, stakingTypes.KeyHistoryRetention, stakingTypes.DefaultHistoryRetention)
+		app.subspaces[stakingTypes.ModuleName].Set(ctx, stakingTypes.KeyHistoryPruneLimit, stakingTypes.DefaultHistoryPruneLimit)
+
+		// history starts with state of every validator at upgrade height
+		app.StakingKeeper.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+			app.StakingKeeper.AddValidatorHistory(ctx, validator, stakingTypes.HistoryEventSnapshot)
+			return nil
+		})
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(PowerScalingUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		// voting power was whole tokens of stake before the upgrade
+		app.StakingKeeper.SetPowerShift(ctx, 0)
+		for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+			if validator.Stake == "" {
+				validator.SetStake(validator.GetStake(), 0)
+				if err := app.StakingKeeper.AddValidator(ctx, *validator); err != nil {
+					panic(err)
+				}
+			}
+		}
+
+		app.StakingKeeper.UpdatePowerShift(ctx)
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(ValidatorExitStatusUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		app.subspaces[stakingTypes.ModuleName].Set(ctx, stakingTypes.KeyWithdrawalDelay, stakingTypes.DefaultWithdrawalDelay)
+		app.StakingKeeper.BackfillValidatorExits(ctx)
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(ValidatorDescriptionUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		app.StakingKeeper.EnableDescription(ctx)
+	})
+
+	app.UpgradeKeeper.SetUpgradeHandler(DelegationMirrorUpgrade, func(ctx sdk.Context, plan upgradeTypes.Plan) {
+		app.DelegationKeeper.EnableMirror(ctx)
+	})
+}
@@ -0,0 +1,55 @@
+package bor
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all bor invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contiguous-spans", ContiguousSpansInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the bor module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ContiguousSpansInvariant(keeper)(ctx)
+	}
+}
+
+// ContiguousSpansInvariant checks that span ids are sequential and that every span
+// starts right after the end block of the previous one
+func ContiguousSpansInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		spans := keeper.GetAllSpans(ctx)
+		hmTypes.SortSpanByID(spans)
+
+		var msg string
+		var broken int
+
+		for i, span := range spans {
+			if span.StartBlock > span.EndBlock {
+				broken++
+				msg += fmt.Sprintf("\tspan %d starts at %d after its end block %d\n", span.ID, span.StartBlock, span.EndBlock)
+			}
+
+			if i == 0 {
+				continue
+			}
+
+			prev := spans[i-1]
+			if span.ID != prev.ID+1 || span.StartBlock != prev.EndBlock+1 {
+				broken++
+				msg += fmt.Sprintf("\tspan %d [%d, %d] does not follow span %d [%d, %d]\n",
+					span.ID, span.StartBlock, span.EndBlock, prev.ID, prev.StartBlock, prev.EndBlock)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contiguous spans",
+			fmt.Sprintf("%d span gaps or overlaps found\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,67 @@
+package bor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// spanV02 is a span as exported by v0.2, before spans had a bor chain id
+type spanV02 struct {
+	ID                uint64               `json:"span_id"`
+	StartBlock        uint64               `json:"start_block"`
+	EndBlock          uint64               `json:"end_block"`
+	ValidatorSet      hmTypes.ValidatorSet `json:"validator_set"`
+	SelectedProducers []hmTypes.Validator  `json:"selected_producers"`
+}
+
+// genesisStateV02 is the bor genesis as exported by v0.2
+type genesisStateV02 struct {
+	Params types.Params `json:"params"`
+	Spans  []spanV02    `json:"spans"`
+}
+
+// MigrateGenesis migrates bor genesis from v0.2 to v0.3. Spans get the bor chain id of the
+// chain manager params and are sorted by id. Duplicate and overlapping spans are rejected.
+func MigrateGenesis(appState map[string]json.RawMessage) (json.RawMessage, error) {
+	var oldState genesisStateV02
+	if err := types.ModuleCdc.UnmarshalJSON(appState[types.ModuleName], &oldState); err != nil {
+		return nil, err
+	}
+
+	var chainManagerState chainmanagerTypes.GenesisState
+	if err := chainmanagerTypes.ModuleCdc.UnmarshalJSON(appState[chainmanagerTypes.ModuleName], &chainManagerState); err != nil {
+		return nil, err
+	}
+
+	borChainID := chainManagerState.Params.ChainParams.BorChainID
+
+	spans := make([]*hmTypes.Span, 0, len(oldState.Spans))
+	for _, span := range oldState.Spans {
+		newSpan := hmTypes.NewSpan(span.ID, span.StartBlock, span.EndBlock, span.ValidatorSet, span.SelectedProducers, borChainID)
+		spans = append(spans, &newSpan)
+	}
+
+	hmTypes.SortSpanByID(spans)
+
+	for i, span := range spans {
+		if span.StartBlock > span.EndBlock {
+			return nil, fmt.Errorf("span %d ends before it starts", span.ID)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if prev := spans[i-1]; prev.ID == span.ID {
+			return nil, fmt.Errorf("duplicate span with id %d", span.ID)
+		} else if prev.EndBlock >= span.StartBlock {
+			return nil, fmt.Errorf("span %d overlaps span %d", span.ID, prev.ID)
+		}
+	}
+
+	return types.ModuleCdc.MarshalJSON(types.NewGenesisState(oldState.Params, spans))
+}
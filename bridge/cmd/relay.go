@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+)
+
+const (
+	validatorIDFlag = "id"
+	fromBlockFlag   = "from-block"
+	toBlockFlag     = "to-block"
+	dryRunFlag      = "dry-run"
+)
+
+// stakingEventTasks maps StakingInfo events to the bridge tasks sending them to heimdall
+var stakingEventTasks = map[string]string{
+	"Staked":       "sendValidatorJoinToHeimdall",
+	"StakeUpdate":  "sendStakeUpdateToHeimdall",
+	"SignerChange": "sendSignerChangeToHeimdall",
+	"UnstakeInit":  "sendUnstakeInitToHeimdall",
+}
+
+// relayStakingEventsCmd re-relays staking events on rootchain missing in heimdall
+var relayStakingEventsCmd = &cobra.Command{
+	Use:   "relay-staking-events",
+	Short: "Re-relay staking events on rootchain which are missing in heimdall",
+	Long:  "Compares validator nonces in heimdall with StakingInfo events on rootchain and sends the missing staking events found in the rootchain block range to the bridge queue, in nonce order",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		validatorID, _ := cmd.Flags().GetUint64(validatorIDFlag)
+		fromBlock, _ := cmd.Flags().GetUint64(fromBlockFlag)
+		toBlock, _ := cmd.Flags().GetUint64(toBlockFlag)
+		dryRun, _ := cmd.Flags().GetBool(dryRunFlag)
+
+		contractCaller, err := helper.NewContractCaller()
+		if err != nil {
+			return err
+		}
+
+		cliCtx := cliContext.NewCLIContext().WithCodec(app.MakeCodec())
+		gaps, err := util.GetNonceGaps(cliCtx, &contractCaller, validatorID, fromBlock, toBlock)
+		if err != nil {
+			return err
+		}
+
+		if len(gaps) == 0 {
+			fmt.Println("No nonce gaps found")
+			return nil
+		}
+
+		var queueConnector *queue.QueueConnector
+		if !dryRun {
+			queueConnector = queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+		}
+
+		relayed := 0
+		for _, gap := range gaps {
+			fmt.Printf("Validator %v: heimdall nonce %d, rootchain nonce %d\n", gap.ValidatorID, gap.HeimdallNonce, gap.RootChainNonce)
+			if len(gap.Events) < len(gap.MissingNonces) {
+				fmt.Printf("Validator %v: only %d of %d missing events found in block range, widen the range to relay the rest\n", gap.ValidatorID, len(gap.Events), len(gap.MissingNonces))
+			}
+
+			for _, event := range gap.Events {
+				signature, err := stakingEventTask(&contractCaller, event)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("Validator %v: nonce %d %s tx %s log %d\n", gap.ValidatorID, event.Nonce, event.Event, event.TxHash.String(), event.LogIndex)
+				if dryRun {
+					continue
+				}
+
+				// events are processed in nonce order, later nonces wait for earlier ones
+				eta := time.Now().Add(time.Duration(relayed) * util.TaskDelayBetweenEachVal)
+				signature.ETA = &eta
+				if _, err := queueConnector.Server.SendTask(signature); err != nil {
+					return err
+				}
+
+				relayed++
+			}
+		}
+
+		fmt.Printf("Relayed %d staking events\n", relayed)
+		return nil
+	},
+}
+
+// stakingEventTask builds bridge task of missing staking event from its rootchain log
+func stakingEventTask(contractCaller helper.IContractCaller, event stakingTypes.MissingStakingEvent) (*tasks.Signature, error) {
+	taskName, ok := stakingEventTasks[event.Event]
+	if !ok {
+		return nil, fmt.Errorf("unsupported staking event %s", event.Event)
+	}
+
+	receipt, err := contractCaller.GetMainTxReceipt(event.TxHash.EthHash())
+	if err != nil {
+		return nil, err
+	}
+
+	var vLog *ethTypes.Log
+	for _, l := range receipt.Logs {
+		if uint64(l.Index) == event.LogIndex {
+			vLog = l
+			break
+		}
+	}
+	if vLog == nil {
+		return nil, fmt.Errorf("log %d not found in tx %s", event.LogIndex, event.TxHash.String())
+	}
+
+	logBytes, err := json.Marshal(vLog)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := &tasks.Signature{
+		Name: taskName,
+		Args: []tasks.Arg{
+			{
+				Type:  "string",
+				Value: event.Event,
+			},
+			{
+				Type:  "string",
+				Value: string(logBytes),
+			},
+		},
+	}
+	signature.RetryCount = 3
+
+	return signature, nil
+}
+
+func init() {
+	relayStakingEventsCmd.Flags().Uint64(validatorIDFlag, 0, "Validator ID, 0 for all validators")
+	relayStakingEventsCmd.Flags().Uint64(fromBlockFlag, 0, "Rootchain block to scan for missing events from")
+	relayStakingEventsCmd.Flags().Uint64(toBlockFlag, 0, "Rootchain block to scan for missing events to, 0 for latest")
+	relayStakingEventsCmd.Flags().Bool(dryRunFlag, false, "Only print missing events")
+
+	rootCmd.AddCommand(relayStakingEventsCmd)
+}
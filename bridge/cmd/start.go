@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	"github.com/spf13/viper"
@@ -84,6 +86,16 @@ func GetStartCmd() *cobra.Command {
 				}
 			}()
 
+			// start prometheus server
+			if addr := helper.GetConfig().BridgePrometheusListenAddr; addr != "" {
+				go func() {
+					logger.Info("Starting prometheus server", "addr", addr)
+					if err := http.ListenAndServe(addr, promhttp.Handler()); err != nil {
+						logger.Error("GetStartCmd | prometheus server", "Error", err)
+					}
+				}()
+			}
+
 			// Start http client
 			err := _httpClient.Start()
 			if err != nil {
@@ -43,6 +43,7 @@ func TestBroadcastToHeimdall(t *testing.T) {
 				test.EndBlock,
 				test.RootHash,
 				test.AccountRootHash,
+				test.BorChainID,
 			)
 
 			err := _txBroadcaster.BroadcastToHeimdall(msg)
@@ -89,6 +89,15 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 					for _, event := range events {
 						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
 					}
+
+					// end block events, e.g. of executed gov proposals
+					events, err = helper.GetEndBlockEvents(hl.httpClient, int64(i))
+					if err != nil {
+						hl.Logger.Error("Error fetching end block events", "error", err)
+					}
+					for _, event := range events {
+						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
+					}
 				}
 
 				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
@@ -185,6 +194,8 @@ func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
 		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
+	case checkpointTypes.EventTypeCheckpointFlush:
+		hl.sendBlockTask("sendCheckpointAfterFlush", eventBytes, blockHeight)
 	case slashingTypes.EventTypeSlashLimit:
 		hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
 	case slashingTypes.EventTypeTickConfirm:
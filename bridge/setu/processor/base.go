@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 
@@ -26,6 +27,11 @@ type Processor interface {
 	Stop()
 }
 
+// HeimdallBroadcaster broadcasts msgs to heimdall
+type HeimdallBroadcaster interface {
+	BroadcastToHeimdall(msg sdk.Msg) error
+}
+
 type BaseProcessor struct {
 	Logger log.Logger
 	name   string
@@ -35,7 +41,7 @@ type BaseProcessor struct {
 	queueConnector *queue.QueueConnector
 
 	// tx broadcaster
-	txBroadcaster *broadcaster.TxBroadcaster
+	txBroadcaster HeimdallBroadcaster
 
 	// The "subclass" of BaseProcessor
 	impl Processor
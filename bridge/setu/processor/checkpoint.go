@@ -35,6 +35,10 @@ type CheckpointProcessor struct {
 
 	// Rootchain abi
 	rootchainAbi *abi.ABI
+
+	// checkpoint sizing
+	sizingPolicy  CheckpointSizingPolicy
+	sizingMetrics *SizingMetrics
 }
 
 // Result represents single req result
@@ -50,8 +54,20 @@ type CheckpointContext struct {
 
 // NewCheckpointProcessor - add rootchain abi to checkpoint processor
 func NewCheckpointProcessor(rootchainAbi *abi.ABI) *CheckpointProcessor {
+	sizingPolicy, err := NewCheckpointSizingPolicy(helper.GetConfig())
+	if err != nil {
+		panic(err)
+	}
+
+	sizingMetrics := NopSizingMetrics()
+	if helper.GetConfig().BridgePrometheusListenAddr != "" {
+		sizingMetrics = PrometheusSizingMetrics(MetricsNamespace)
+	}
+
 	checkpointProcessor := &CheckpointProcessor{
-		rootchainAbi: rootchainAbi,
+		rootchainAbi:  rootchainAbi,
+		sizingPolicy:  sizingPolicy,
+		sizingMetrics: sizingMetrics,
 	}
 	return checkpointProcessor
 }
@@ -59,6 +75,9 @@ func NewCheckpointProcessor(rootchainAbi *abi.ABI) *CheckpointProcessor {
 // Start - consumes messages from checkpoint queue and call processMsg
 func (cp *CheckpointProcessor) Start() error {
 	cp.Logger.Info("Starting")
+	// checkpoint sizing
+	cp.Logger.Info("Checkpoint sizing policy", "policy", cp.sizingPolicy.Name())
+	cp.sizingMetrics.Policy.With("policy", cp.sizingPolicy.Name()).Set(1)
 	// no-ack
 	ackCtx, cancelNoACKPolling := context.WithCancel(context.Background())
 	cp.cancelNoACKPolling = cancelNoACKPolling
@@ -79,6 +98,9 @@ func (cp *CheckpointProcessor) RegisterTasks() {
 	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
+	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAfterFlush", cp.sendCheckpointAfterFlush); err != nil {
+		cp.Logger.Error("RegisterTasks | sendCheckpointAfterFlush", "error", err)
+	}
 }
 
 func (cp *CheckpointProcessor) startPollingForNoAck(ctx context.Context, interval time.Duration) {
@@ -109,6 +131,49 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 	}
 
 	cp.Logger.Info("Processing new header", "headerNumber", header.Number)
+	return cp.proposeCheckpoint(header.Number.Uint64())
+}
+
+// sendCheckpointAfterFlush - handles checkpoint buffer flush event from heimdall.
+// Next checkpoint is re-evaluated right away instead of waiting for the next header.
+func (cp *CheckpointProcessor) sendCheckpointAfterFlush(eventBytes string, blockHeight int64) error {
+	cp.Logger.Info("Received sendCheckpointAfterFlush request", "eventBytes", eventBytes, "blockHeight", blockHeight)
+	var event = sdk.StringEvent{}
+	if err := json.Unmarshal([]byte(eventBytes), &event); err != nil {
+		cp.Logger.Error("Error unmarshalling event from heimdall", "error", err)
+		return err
+	}
+
+	var borChainID string
+	for _, attr := range event.Attributes {
+		if attr.Key == checkpointTypes.AttributeKeyBorChainID {
+			borChainID = attr.Value
+		}
+	}
+
+	checkpointContext, err := cp.getCheckpointContext()
+	if err != nil {
+		return err
+	}
+
+	// checkpoints of child chains are not proposed by this bridge
+	if _, ok := checkpointContext.ChainmanagerParams.GetChildChain(borChainID); ok {
+		cp.Logger.Info("Checkpoint buffer of child chain flushed. Ignoring", "borChainID", borChainID)
+		return nil
+	}
+
+	latestHeader, err := cp.contractConnector.GetMaticChainBlock(nil)
+	if err != nil {
+		cp.Logger.Error("Error fetching latest child block", "error", err)
+		return err
+	}
+
+	cp.Logger.Info("Checkpoint buffer flushed, re-evaluating next checkpoint", "headerNumber", latestHeader.Number)
+	return cp.proposeCheckpoint(latestHeader.Number.Uint64())
+}
+
+// proposeCheckpoint - proposes next expected checkpoint up to given child block, if i am the proposer
+func (cp *CheckpointProcessor) proposeCheckpoint(headerNumber uint64) (err error) {
 	var isProposer bool
 	if isProposer, err = util.IsProposer(cp.cliCtx); err != nil {
 		cp.Logger.Error("Error checking isProposer in HeaderBlock handler", "error", err)
@@ -125,9 +190,9 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 		// process latest confirmed child block only
 		chainmanagerParams := checkpointContext.ChainmanagerParams
 		cp.Logger.Debug("no of checkpoint confirmations required", "maticchainTxConfirmations", chainmanagerParams.MaticchainTxConfirmations)
-		latestConfirmedChildBlock := header.Number.Uint64() - chainmanagerParams.MaticchainTxConfirmations
+		latestConfirmedChildBlock := headerNumber - chainmanagerParams.MaticchainTxConfirmations
 		if latestConfirmedChildBlock <= 0 {
-			cp.Logger.Error("no of blocks on childchain is less than confirmations required", "childChainBlocks", header.Number.Uint64(), "confirmationsRequired", chainmanagerParams.MaticchainTxConfirmations)
+			cp.Logger.Error("no of blocks on childchain is less than confirmations required", "childChainBlocks", headerNumber, "confirmationsRequired", chainmanagerParams.MaticchainTxConfirmations)
 			return errors.New("no of blocks on childchain is less than confirmations required")
 		}
 
@@ -160,7 +225,7 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 			return err
 		}
 	} else {
-		cp.Logger.Info("I am not the proposer. skipping newheader", "headerNumber", header.Number)
+		cp.Logger.Info("I am not the proposer. skipping newheader", "headerNumber", headerNumber)
 		return
 	}
 
@@ -196,6 +261,7 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 	var startBlock uint64
 	var endBlock uint64
 	var txHash string
+	var borChainID string
 
 	for _, attr := range event.Attributes {
 		if attr.Key == checkpointTypes.AttributeKeyStartBlock {
@@ -207,6 +273,9 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		if attr.Key == hmTypes.AttributeKeyTxHash {
 			txHash = attr.Value
 		}
+		if attr.Key == checkpointTypes.AttributeKeyBorChainID {
+			borChainID = attr.Value
+		}
 	}
 
 	checkpointContext, err := cp.getCheckpointContext()
@@ -214,6 +283,12 @@ func (cp *CheckpointProcessor) sendCheckpointToRootchain(eventBytes string, bloc
 		return err
 	}
 
+	// checkpoints of child chains are submitted via cli/rest, not by this bridge
+	if _, ok := checkpointContext.ChainmanagerParams.GetChildChain(borChainID); ok {
+		cp.Logger.Info("Checkpoint of child chain confirmed. Ignoring", "borChainID", borChainID)
+		return nil
+	}
+
 	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, startBlock, endBlock)
 	if err != nil {
 		return err
@@ -284,6 +359,7 @@ func (cp *CheckpointProcessor) sendCheckpointAckToHeimdall(eventName string, che
 			event.Root,
 			hmTypes.BytesToHeimdallHash(log.TxHash.Bytes()),
 			uint64(log.Index),
+			"", // primary bor chain
 		)
 
 		// return broadcast to heimdall
@@ -359,53 +435,45 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 		return nil, err
 	}
 
-	// find next start/end
-	var start, end uint64
-	start = currentEnd
+	// find next start
+	start := currentEnd
 
 	// add 1 if start > 0
 	if start > 0 {
 		start = start + 1
 	}
 
-	// get diff
-	diff := latestChildBlock - start + 1
-	// process if diff > 0 (positive)
-	if diff > 0 {
-		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
-		if expectedDiff > 0 {
-			expectedDiff = expectedDiff - 1
-		}
-		// cap with max checkpoint length
-		if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
-			expectedDiff = checkpointParams.MaxCheckpointLength - 1
-		}
-		// get end result
-		end = expectedDiff + start
+	// pick next end with sizing policy
+	decision, err := cp.sizingPolicy.NextEnd(cp, SizingState{
+		Start:              start,
+		Latest:             latestChildBlock,
+		LastCheckpointTime: int64(lastCheckpointTime),
+		Now:                time.Now().UTC().Unix(),
+		Params:             checkpointParams,
+	})
+	if err != nil {
+		cp.Logger.Error("Error while sizing next checkpoint", "policy", cp.sizingPolicy.Name(), "error", err)
+		return nil, err
+	}
+	cp.recordSizingDecision(start, decision)
+
+	end := decision.End
+	if decision.Reason == SizingReasonForcePush {
+		cp.Logger.Info("Force push checkpoint",
+			"lastCheckpointTime", lastCheckpointTime,
+			"start", start,
+			"end", end,
+		)
+	} else {
 		cp.Logger.Debug("Calculating checkpoint eligibility",
+			"policy", cp.sizingPolicy.Name(),
+			"reason", decision.Reason,
 			"latest", latestChildBlock,
 			"start", start,
 			"end", end,
 		)
 	}
 
-	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
-		cp.Logger.Debug("Fetching last header block to calculate time")
-
-		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
-		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
-			end = latestChildBlock
-			cp.Logger.Info("Force push checkpoint",
-				"currentTime", currentTime,
-				"lastCheckpointTime", lastCheckpointTime,
-				"defaultForcePushInterval", defaultForcePushInterval,
-				"start", start,
-				"end", end,
-			)
-		}
-	}
 	// if end == 0 || start >= end {
 	// 	c.Logger.Info("Waiting for 256 blocks or invalid start end formation", "start", start, "end", end)
 	// 	return nil, errors.New("Invalid start end formation")
@@ -417,6 +485,54 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(checkpointContext *Checkpo
 	}), nil
 }
 
+// recordSizingDecision - reports checkpoint sizing decision in metrics
+func (cp *CheckpointProcessor) recordSizingDecision(start uint64, decision SizingDecision) {
+	cp.sizingMetrics.Decisions.With("policy", cp.sizingPolicy.Name(), "reason", decision.Reason).Add(1)
+
+	if decision.End > start {
+		cp.sizingMetrics.CheckpointLength.Set(float64(decision.End - start + 1))
+	}
+	if decision.TargetLength > 0 {
+		cp.sizingMetrics.TargetLength.Set(float64(decision.TargetLength))
+	}
+	if decision.BorBlockTime > 0 {
+		cp.sizingMetrics.BorBlockTime.Set(decision.BorBlockTime)
+	}
+	if decision.BaseFee != nil {
+		// in gwei
+		baseFee, _ := new(big.Float).Quo(new(big.Float).SetInt(decision.BaseFee), big.NewFloat(1e9)).Float64()
+		cp.sizingMetrics.BaseFee.Set(baseFee)
+	}
+}
+
+// BorBlockTime - returns average bor block time in seconds from start to end block
+func (cp *CheckpointProcessor) BorBlockTime(start uint64, end uint64) (float64, error) {
+	if end <= start {
+		return 0, errors.New("invalid block range for block time")
+	}
+
+	startHeader, err := cp.contractConnector.GetMaticChainBlock(big.NewInt(0).SetUint64(start))
+	if err != nil {
+		return 0, err
+	}
+
+	endHeader, err := cp.contractConnector.GetMaticChainBlock(big.NewInt(0).SetUint64(end))
+	if err != nil {
+		return 0, err
+	}
+
+	if endHeader.Time < startHeader.Time {
+		return 0, nil
+	}
+
+	return float64(endHeader.Time-startHeader.Time) / float64(end-start), nil
+}
+
+// MainChainBaseFee - returns base fee of latest main chain block
+func (cp *CheckpointProcessor) MainChainBaseFee() (*big.Int, error) {
+	return cp.contractConnector.GetMainChainBaseFee()
+}
+
 // sendCheckpointToHeimdall - creates checkpoint msg and broadcasts to heimdall
 func (cp *CheckpointProcessor) createAndSendCheckpointToHeimdall(checkpointContext *CheckpointContext, start uint64, end uint64) error {
 	cp.Logger.Debug("Initiating checkpoint to Heimdall", "start", start, "end", end)
@@ -506,6 +622,11 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(checkpointCont
 		return err
 	}
 
+	return cp.submitCheckpointToRootchain(checkpointContext, start, end, sideTxData, sigs)
+}
+
+// submitCheckpointToRootchain sends checkpoint side-tx data with votes to rootchain, if not sent already
+func (cp *CheckpointProcessor) submitCheckpointToRootchain(checkpointContext *CheckpointContext, start uint64, end uint64, sideTxData []byte, sigs [][3]*big.Int) error {
 	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, start, end)
 	if err != nil {
 		return err
@@ -631,6 +752,7 @@ func (cp *CheckpointProcessor) proposeCheckpointNoAck() (err error) {
 	// send NO ACK
 	msg := checkpointTypes.NewMsgCheckpointNoAck(
 		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		"", // primary bor chain
 	)
 
 	// return broadcast to heimdall
@@ -0,0 +1,210 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/helper/simulated"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// testBroadcaster collects msgs broadcasted to heimdall
+type testBroadcaster struct {
+	msgs []sdk.Msg
+}
+
+func (b *testBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
+	b.msgs = append(b.msgs, msg)
+	return nil
+}
+
+// testHeimdallServer serves heimdall rest endpoints used by checkpoint processor from app state
+func testHeimdallServer(t *testing.T, happ *app.HeimdallApp, ctx sdk.Context) *httptest.Server {
+	cliCtx := cliContext.NewCLIContext().WithCodec(happ.Codec())
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result interface{}
+		switch r.URL.Path {
+		case "/chainmanager/params":
+			result = happ.ChainKeeper.GetParams(ctx)
+		case "/checkpoints/params":
+			result = happ.CheckpointKeeper.GetParams(ctx)
+		case "/staking/proposer/1":
+			validatorSet := happ.StakingKeeper.GetValidatorSet(ctx)
+			result = []hmTypes.Validator{*validatorSet.GetProposer()}
+		case "/topup/dividend-account-root":
+			root, err := checkpointTypes.GetAccountRootHash(happ.TopupKeeper.GetAllDividendAccounts(ctx))
+			require.NoError(t, err)
+			result = hmTypes.BytesToHeimdallHash(root)
+		case "/checkpoints/buffer":
+			checkpoint, err := happ.CheckpointKeeper.GetCheckpointFromBuffer(ctx)
+			if err != nil || checkpoint == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			result = checkpoint
+		case "/checkpoints/latest":
+			checkpoint, err := happ.CheckpointKeeper.GetLastCheckpoint(ctx)
+			if err != nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			result = checkpoint
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		bz, err := json.Marshal(result)
+		require.NoError(t, err)
+		rest.PostProcessResponse(w, cliCtx, bz)
+	}))
+}
+
+// TestCheckpointProcessorE2E runs checkpoint processor against simulated rootchain and bor chains,
+// with its msgs delivered to checkpoint handlers of heimdall app
+func TestCheckpointProcessorE2E(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	viper.Set("log_level", "error")
+
+	// bridge key is the proposer
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	helper.SetTestPrivPubKey(secp256k1.GenPrivKey())
+	validator := hmTypes.NewValidator(1, 0, 0, 1, 10, hmTypes.NewPubKey(helper.GetPubKey().Bytes()), hmTypes.BytesToHeimdallAddress(helper.GetAddress()))
+	require.NoError(t, happ.StakingKeeper.AddValidator(ctx, *validator))
+	require.NoError(t, happ.StakingKeeper.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet([]*hmTypes.Validator{validator})))
+
+	happ.TopupKeeper.AddDividendAccount(ctx, hmTypes.DividendAccount{
+		User:      hmTypes.HexToHeimdallAddress("123"),
+		FeeAmount: big.NewInt(0).String(),
+	})
+
+	backend := simulated.NewBackend(300)
+	defer backend.Close()
+
+	contractCaller, err := backend.ContractCaller()
+	require.NoError(t, err)
+
+	// point chainmanager to simulated rootchain
+	chainParams := happ.ChainKeeper.GetParams(ctx)
+	chainParams.ChainParams.RootChainAddress = hmTypes.BytesToHeimdallAddress(simulated.RootChainAddress.Bytes())
+	happ.ChainKeeper.SetParams(ctx, chainParams)
+
+	server := testHeimdallServer(t, happ, ctx)
+	defer server.Close()
+
+	config := helper.GetDefaultHeimdallConfig()
+	config.HeimdallServerURL = server.URL
+	helper.SetTestConfig(config)
+
+	broadcaster := &testBroadcaster{}
+	cp := &CheckpointProcessor{
+		BaseProcessor: BaseProcessor{
+			Logger:            util.Logger().With("service", "processor", "module", "checkpoint"),
+			cliCtx:            cliContext.NewCLIContext().WithCodec(happ.Codec()),
+			contractConnector: contractCaller,
+			txBroadcaster:     broadcaster,
+		},
+		rootchainAbi:  &contractCaller.RootChainABI,
+		sizingPolicy:  StaticPolicy{},
+		sizingMetrics: NopSizingMetrics(),
+	}
+
+	handler := checkpoint.NewHandler(happ.CheckpointKeeper, &contractCaller)
+	sideHandler := checkpoint.NewSideTxHandler(happ.CheckpointKeeper, &contractCaller)
+	postHandler := checkpoint.NewPostTxHandler(happ.CheckpointKeeper, &contractCaller)
+	deliver := func(msg sdk.Msg) {
+		require.True(t, handler(ctx, msg).IsOK(), "expected %s to be ok", msg.Type())
+
+		result := sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+		res := postHandler(ctx, msg, abci.SideTxResultType_Yes)
+		require.True(t, res.IsOK(), "expected post tx of %s to be ok, got %v", msg.Type(), res)
+	}
+
+	// propose checkpoint on new bor header
+	latestHeader, err := contractCaller.GetMaticChainBlock(nil)
+	require.NoError(t, err)
+	headerBytes, err := latestHeader.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, cp.sendCheckpointToHeimdall(string(headerBytes)))
+
+	require.Len(t, broadcaster.msgs, 1)
+	msgCheckpoint, ok := broadcaster.msgs[0].(checkpointTypes.MsgCheckpoint)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), msgCheckpoint.StartBlock)
+	require.Equal(t, uint64(255), msgCheckpoint.EndBlock)
+	deliver(msgCheckpoint)
+
+	// buffered checkpoint is not proposed again
+	require.NoError(t, cp.sendCheckpointToHeimdall(string(headerBytes)))
+	require.Len(t, broadcaster.msgs, 1)
+
+	// submit checkpoint to rootchain, signatures are not verified by simulated rootchain
+	checkpointContext, err := cp.getCheckpointContext()
+	require.NoError(t, err)
+
+	sigs := [][3]*big.Int{{big.NewInt(1), big.NewInt(2), big.NewInt(27)}}
+	require.NoError(t, cp.submitCheckpointToRootchain(checkpointContext, msgCheckpoint.StartBlock, msgCheckpoint.EndBlock, msgCheckpoint.GetSideSignBytes(), sigs))
+
+	// checkpoint is not sent twice
+	shouldSend, err := cp.shouldSendCheckpoint(checkpointContext, msgCheckpoint.StartBlock, msgCheckpoint.EndBlock)
+	require.NoError(t, err)
+	require.False(t, shouldSend)
+
+	// ack checkpoint from rootchain event
+	logs, err := backend.MainChain.Client().FilterLogs(context.Background(), ethereum.FilterQuery{
+		Addresses: []common.Address{simulated.RootChainAddress},
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	logBytes, err := json.Marshal(logs[0])
+	require.NoError(t, err)
+	require.NoError(t, cp.sendCheckpointAckToHeimdall("NewHeaderBlock", string(logBytes)))
+
+	require.Len(t, broadcaster.msgs, 2)
+	msgCheckpointAck, ok := broadcaster.msgs[1].(checkpointTypes.MsgCheckpointAck)
+	require.True(t, ok)
+	require.Equal(t, msgCheckpoint.RootHash, msgCheckpointAck.RootHash)
+	deliver(msgCheckpointAck)
+
+	require.Equal(t, uint64(1), happ.CheckpointKeeper.GetACKCount(ctx))
+	lastCheckpoint, err := happ.CheckpointKeeper.GetLastCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, msgCheckpoint.EndBlock, lastCheckpoint.EndBlock)
+	require.Equal(t, validator.Signer, lastCheckpoint.Proposer)
+
+	// acked checkpoint is not acked again
+	require.NoError(t, cp.sendCheckpointAckToHeimdall("NewHeaderBlock", string(logBytes)))
+	require.Len(t, broadcaster.msgs, 2)
+
+	// next checkpoint starts after acked one
+	expected, err := cp.nextExpectedCheckpoint(checkpointContext, latestHeader.Number.Uint64()-chainParams.MaticchainTxConfirmations)
+	require.NoError(t, err)
+	require.Equal(t, msgCheckpoint.EndBlock+1, expected.newStart)
+}
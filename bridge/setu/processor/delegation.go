@@ -0,0 +1,145 @@
+package processor
+
+import (
+	"encoding/json"
+	"math/big"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	delegationTypes "github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// DelegationProcessor - process delegation related events
+type DelegationProcessor struct {
+	BaseProcessor
+	stakingInfoAbi *abi.ABI
+}
+
+// NewDelegationProcessor - add abi to delegation processor
+func NewDelegationProcessor(stakingInfoAbi *abi.ABI) *DelegationProcessor {
+	delegationProcessor := &DelegationProcessor{
+		stakingInfoAbi: stakingInfoAbi,
+	}
+	return delegationProcessor
+}
+
+// Start starts new block subscription
+func (dp *DelegationProcessor) Start() error {
+	dp.Logger.Info("Starting")
+	return nil
+}
+
+// RegisterTasks - Registers delegation related tasks with machinery
+func (dp *DelegationProcessor) RegisterTasks() {
+	dp.Logger.Info("Registering delegation related tasks")
+	if err := dp.queueConnector.Server.RegisterTask("sendDelegationEventToHeimdall", dp.sendDelegationEventToHeimdall); err != nil {
+		dp.Logger.Error("RegisterTasks | sendDelegationEventToHeimdall", "error", err)
+	}
+}
+
+// sendDelegationEventToHeimdall - relays share minted, share burned and rewards claimed events
+func (dp *DelegationProcessor) sendDelegationEventToHeimdall(eventName string, logBytes string) error {
+	var vLog = types.Log{}
+	if err := json.Unmarshal([]byte(logBytes), &vLog); err != nil {
+		dp.Logger.Error("Error while unmarshalling event from rootchain", "error", err)
+		return err
+	}
+
+	var msg sdk.Msg
+	var validatorID *big.Int
+	from := helper.GetFromAddress(dp.cliCtx)
+	txHash := hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes())
+
+	switch eventName {
+	case "ShareMinted":
+		event := new(stakinginfo.StakinginfoShareMinted)
+		if err := helper.UnpackLog(dp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+			dp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+			return nil
+		}
+		validatorID = event.ValidatorId
+		msg = delegationTypes.NewMsgShareMint(from, event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallAddress(event.User.Bytes()), sdk.NewIntFromBigInt(event.Amount), sdk.NewIntFromBigInt(event.Tokens), txHash, uint64(vLog.Index), vLog.BlockNumber)
+	case "ShareBurned":
+		event := new(stakinginfo.StakinginfoShareBurned)
+		if err := helper.UnpackLog(dp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+			dp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+			return nil
+		}
+		validatorID = event.ValidatorId
+		msg = delegationTypes.NewMsgShareBurn(from, event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallAddress(event.User.Bytes()), sdk.NewIntFromBigInt(event.Amount), sdk.NewIntFromBigInt(event.Tokens), txHash, uint64(vLog.Index), vLog.BlockNumber)
+	case "DelClaimRewards":
+		event := new(stakinginfo.StakinginfoDelClaimRewards)
+		if err := helper.UnpackLog(dp.stakingInfoAbi, event, eventName, &vLog); err != nil {
+			dp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
+			return nil
+		}
+		validatorID = event.ValidatorId
+		msg = delegationTypes.NewMsgClaimRewards(from, event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallAddress(event.User.Bytes()), sdk.NewIntFromBigInt(event.Rewards), txHash, uint64(vLog.Index), vLog.BlockNumber)
+	default:
+		dp.Logger.Error("Unknown delegation event", "name", eventName)
+		return nil
+	}
+
+	if isOld, _ := dp.isOldTx(dp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		dp.Logger.Info("Ignoring task to send delegation event to heimdall as already processed",
+			"event", eventName,
+			"validatorID", validatorID,
+			"txHash", txHash,
+			"logIndex", uint64(vLog.Index),
+			"blockNumber", vLog.BlockNumber,
+		)
+		return nil
+	}
+
+	dp.Logger.Info("✅ sending delegation event to heimdall",
+		"event", eventName,
+		"validatorID", validatorID,
+		"txHash", txHash,
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	// return broadcast to heimdall
+	if err := dp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		dp.Logger.Error("Error while broadcasting delegation msg to heimdall", "event", eventName, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// isOldTx  checks if tx is already processed or not
+func (dp *DelegationProcessor) isOldTx(cliCtx cliContext.CLIContext, txHash string, logIndex uint64) (bool, error) {
+	queryParam := map[string]interface{}{
+		"txhash":   txHash,
+		"logindex": logIndex,
+	}
+
+	endpoint := helper.GetHeimdallServerEndpoint(util.DelegationTxStatusURL)
+	url, err := util.CreateURLWithQuery(endpoint, queryParam)
+	if err != nil {
+		dp.Logger.Error("Error in creating url", "endpoint", endpoint, "error", err)
+		return false, err
+	}
+
+	res, err := helper.FetchFromAPI(cliCtx, url)
+	if err != nil {
+		dp.Logger.Error("Error fetching tx status", "url", url, "error", err)
+		return false, err
+	}
+
+	var status bool
+	if err := json.Unmarshal(res.Result, &status); err != nil {
+		dp.Logger.Error("Error unmarshalling tx status received from Heimdall Server", "error", err)
+		return false, err
+	}
+
+	return status, nil
+}
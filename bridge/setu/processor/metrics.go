@@ -0,0 +1,85 @@
+package processor
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsNamespace is the namespace of bridge metrics
+	MetricsNamespace = "heimdall"
+	// MetricsSubsystem is the subsystem label of bridge metrics
+	MetricsSubsystem = "bridge"
+)
+
+// SizingMetrics contains the metrics of checkpoint sizing
+type SizingMetrics struct {
+	// Sizing policy in use, labeled by policy name
+	Policy metrics.Gauge
+	// Sizing decisions, labeled by policy name and reason
+	Decisions metrics.Counter
+	// Number of blocks in last picked checkpoint range
+	CheckpointLength metrics.Gauge
+	// Checkpoint length targeted by interval policy
+	TargetLength metrics.Gauge
+	// Average bor block time in seconds
+	BorBlockTime metrics.Gauge
+	// Base fee of latest main chain block in gwei
+	BaseFee metrics.Gauge
+}
+
+// PrometheusSizingMetrics returns sizing metrics registered with the default prometheus registry
+func PrometheusSizingMetrics(namespace string) *SizingMetrics {
+	return &SizingMetrics{
+		Policy: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "checkpoint_sizing_policy",
+			Help:      "Checkpoint sizing policy in use.",
+		}, []string{"policy"}),
+		Decisions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "checkpoint_sizing_decisions",
+			Help:      "Checkpoint sizing decisions by reason.",
+		}, []string{"policy", "reason"}),
+		CheckpointLength: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "checkpoint_length",
+			Help:      "Number of blocks in last picked checkpoint range.",
+		}, nil),
+		TargetLength: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "checkpoint_target_length",
+			Help:      "Checkpoint length targeted by interval sizing policy.",
+		}, nil),
+		BorBlockTime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "bor_block_time_seconds",
+			Help:      "Average bor block time in seconds.",
+		}, nil),
+		BaseFee: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "main_chain_base_fee_gwei",
+			Help:      "Base fee of latest main chain block in gwei.",
+		}, nil),
+	}
+}
+
+// NopSizingMetrics returns sizing metrics which discard all values
+func NopSizingMetrics() *SizingMetrics {
+	return &SizingMetrics{
+		Policy:           discard.NewGauge(),
+		Decisions:        discard.NewCounter(),
+		CheckpointLength: discard.NewGauge(),
+		TargetLength:     discard.NewGauge(),
+		BorBlockTime:     discard.NewGauge(),
+		BaseFee:          discard.NewGauge(),
+	}
+}
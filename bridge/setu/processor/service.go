@@ -75,6 +75,10 @@ func NewProcessorService(
 	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
 	slashingProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "slashing", slashingProcessor)
 
+	// initialize delegation processor
+	delegationProcessor := NewDelegationProcessor(&contractCaller.StakingInfoABI)
+	delegationProcessor.BaseProcessor = *NewBaseProcessor(cdc, queueConnector, httpClient, txBroadcaster, "delegation", delegationProcessor)
+
 	//
 	// Select processors
 	//
@@ -91,6 +95,7 @@ func NewProcessorService(
 			feeProcessor,
 			spanProcessor,
 			slashingProcessor,
+			delegationProcessor,
 		)
 	} else {
 		for _, service := range onlyServices {
@@ -107,6 +112,8 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, spanProcessor)
 			case "slashing":
 				processorService.processors = append(processorService.processors, slashingProcessor)
+			case "delegation":
+				processorService.processors = append(processorService.processors, delegationProcessor)
 			}
 		}
 	}
@@ -0,0 +1,251 @@
+package processor
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// checkpoint sizing policies
+const (
+	StaticSizingPolicy   = "static"
+	IntervalSizingPolicy = "interval"
+	GasSizingPolicy      = "gas"
+)
+
+// checkpoint sizing decision reasons
+const (
+	SizingReasonWaiting        = "waiting"
+	SizingReasonAvgLength      = "avg-length"
+	SizingReasonMaxLength      = "max-length"
+	SizingReasonForcePush      = "force-push"
+	SizingReasonTargetLength   = "target-length"
+	SizingReasonTargetInterval = "target-interval"
+	SizingReasonHighBaseFee    = "high-base-fee"
+)
+
+// SizingState is the state the range of next checkpoint is picked from
+type SizingState struct {
+	Start              uint64 // first block of next checkpoint
+	Latest             uint64 // latest confirmed child block
+	LastCheckpointTime int64  // rootchain time of last checkpoint
+	Now                int64
+	Params             *checkpointTypes.Params
+}
+
+// available returns number of blocks available for next checkpoint
+func (s SizingState) available() uint64 {
+	if s.Latest < s.Start {
+		return 0
+	}
+	return s.Latest - s.Start + 1
+}
+
+// elapsed returns seconds since last checkpoint
+func (s SizingState) elapsed() int64 {
+	return s.Now - s.LastCheckpointTime
+}
+
+// SizingDecision is the range of next checkpoint picked by a sizing policy
+type SizingDecision struct {
+	End    uint64 // end block of next checkpoint, 0 to wait for more blocks
+	Reason string
+
+	TargetLength uint64   // checkpoint length targeted by interval policy
+	BorBlockTime float64  // average bor block time in seconds observed by interval policy
+	BaseFee      *big.Int // main chain base fee observed by gas policy
+}
+
+// SizingSource provides the chain data sizing policies are based on
+type SizingSource interface {
+	BorBlockTime(start uint64, end uint64) (float64, error)
+	MainChainBaseFee() (*big.Int, error)
+}
+
+// CheckpointSizingPolicy picks the range of next checkpoint
+type CheckpointSizingPolicy interface {
+	Name() string
+	NextEnd(source SizingSource, state SizingState) (SizingDecision, error)
+}
+
+// NewCheckpointSizingPolicy creates checkpoint sizing policy from bridge config
+func NewCheckpointSizingPolicy(config helper.Configuration) (CheckpointSizingPolicy, error) {
+	switch config.CheckpointSizingPolicy {
+	case StaticSizingPolicy, "":
+		return StaticPolicy{}, nil
+
+	case IntervalSizingPolicy:
+		targetInterval := config.CheckpointTargetInterval
+		if targetInterval <= 0 {
+			targetInterval = helper.DefaultCheckpointTargetInterval
+		}
+		return IntervalPolicy{TargetInterval: targetInterval}, nil
+
+	case GasSizingPolicy:
+		maxBaseFee := config.CheckpointMaxBaseFee
+		if maxBaseFee <= 0 {
+			maxBaseFee = helper.DefaultCheckpointMaxBaseFee
+		}
+		return GasPolicy{MaxBaseFee: big.NewInt(maxBaseFee), Fallback: StaticPolicy{}}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown checkpoint sizing policy %q", config.CheckpointSizingPolicy)
+	}
+}
+
+//
+// Static policy
+//
+
+// StaticPolicy sizes checkpoints to multiples of AvgCheckpointLength, capped at MaxCheckpointLength.
+// Remaining blocks are force pushed if no checkpoint was submitted for MaxCheckpointLength*2 seconds.
+type StaticPolicy struct{}
+
+// Name returns policy name
+func (p StaticPolicy) Name() string {
+	return StaticSizingPolicy
+}
+
+// NextEnd picks end block of next checkpoint
+func (p StaticPolicy) NextEnd(_ SizingSource, state SizingState) (SizingDecision, error) {
+	params := state.Params
+	decision := SizingDecision{Reason: SizingReasonWaiting}
+
+	diff := state.available()
+	if diff > 0 {
+		expectedDiff := diff - diff%params.AvgCheckpointLength
+		if expectedDiff > 0 {
+			expectedDiff = expectedDiff - 1
+			decision.Reason = SizingReasonAvgLength
+		}
+		// cap with max checkpoint length
+		if expectedDiff > params.MaxCheckpointLength-1 {
+			expectedDiff = params.MaxCheckpointLength - 1
+			decision.Reason = SizingReasonMaxLength
+		}
+		decision.End = expectedDiff + state.Start
+	}
+
+	// Handle when block producers go down
+	if decision.End == 0 || decision.End == state.Start || (0 < diff && diff < params.AvgCheckpointLength) {
+		forcePushInterval := params.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
+		if state.elapsed() > int64(forcePushInterval) {
+			decision.End = state.Latest
+			decision.Reason = SizingReasonForcePush
+		}
+	}
+
+	return decision, nil
+}
+
+//
+// Interval policy
+//
+
+// IntervalPolicy sizes checkpoints to cover TargetInterval of bor blocks at the observed bor block time,
+// capped at MaxCheckpointLength. Remaining blocks are pushed once TargetInterval passed since last checkpoint.
+type IntervalPolicy struct {
+	TargetInterval time.Duration
+}
+
+// Name returns policy name
+func (p IntervalPolicy) Name() string {
+	return IntervalSizingPolicy
+}
+
+// NextEnd picks end block of next checkpoint
+func (p IntervalPolicy) NextEnd(source SizingSource, state SizingState) (SizingDecision, error) {
+	params := state.Params
+
+	// block time needs at least two blocks
+	available := state.available()
+	if available < 2 {
+		return SizingDecision{Reason: SizingReasonWaiting}, nil
+	}
+
+	blockTime, err := source.BorBlockTime(state.Start, state.Latest)
+	if err != nil {
+		return SizingDecision{}, err
+	}
+
+	targetLength := params.MaxCheckpointLength
+	if blockTime > 0 {
+		if length := uint64(p.TargetInterval.Seconds() / blockTime); length < targetLength {
+			targetLength = length
+		}
+	}
+	if targetLength < 2 {
+		targetLength = 2
+	}
+
+	decision := SizingDecision{
+		Reason:       SizingReasonWaiting,
+		TargetLength: targetLength,
+		BorBlockTime: blockTime,
+	}
+
+	switch {
+	case available >= targetLength:
+		decision.End = state.Start + targetLength - 1
+		decision.Reason = SizingReasonTargetLength
+	case state.elapsed() >= int64(p.TargetInterval.Seconds()):
+		decision.End = state.Latest
+		decision.Reason = SizingReasonTargetInterval
+	}
+
+	return decision, nil
+}
+
+//
+// Gas policy
+//
+
+// GasPolicy waits for checkpoints of MaxCheckpointLength while main chain base fee is above MaxBaseFee,
+// for at most CheckpointBufferTime since last checkpoint. Otherwise checkpoints are sized by Fallback.
+type GasPolicy struct {
+	MaxBaseFee *big.Int
+	Fallback   CheckpointSizingPolicy
+}
+
+// Name returns policy name
+func (p GasPolicy) Name() string {
+	return GasSizingPolicy
+}
+
+// NextEnd picks end block of next checkpoint
+func (p GasPolicy) NextEnd(source SizingSource, state SizingState) (SizingDecision, error) {
+	params := state.Params
+
+	baseFee, err := source.MainChainBaseFee()
+	if err != nil {
+		// unknown base fee must not delay checkpoints
+		return p.Fallback.NextEnd(source, state)
+	}
+
+	if baseFee.Cmp(p.MaxBaseFee) > 0 {
+		switch {
+		case state.available() >= params.MaxCheckpointLength:
+			return SizingDecision{
+				End:     state.Start + params.MaxCheckpointLength - 1,
+				Reason:  SizingReasonMaxLength,
+				BaseFee: baseFee,
+			}, nil
+		case state.elapsed() < int64(params.CheckpointBufferTime.Seconds()):
+			return SizingDecision{
+				Reason:  SizingReasonHighBaseFee,
+				BaseFee: baseFee,
+			}, nil
+		}
+	}
+
+	decision, err := p.Fallback.NextEnd(source, state)
+	if err != nil {
+		return decision, err
+	}
+	decision.BaseFee = baseFee
+
+	return decision, nil
+}
@@ -0,0 +1,133 @@
+package processor
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// testSizingSource returns fixed block time and base fee
+type testSizingSource struct {
+	blockTime float64
+	baseFee   *big.Int
+}
+
+func (s testSizingSource) BorBlockTime(start uint64, end uint64) (float64, error) {
+	return s.blockTime, nil
+}
+
+func (s testSizingSource) MainChainBaseFee() (*big.Int, error) {
+	return s.baseFee, nil
+}
+
+func testSizingState(start uint64, latest uint64, elapsed int64) SizingState {
+	params := checkpointTypes.DefaultParams()
+	return SizingState{
+		Start:              start,
+		Latest:             latest,
+		LastCheckpointTime: 1000,
+		Now:                1000 + elapsed,
+		Params:             &params,
+	}
+}
+
+func TestNewCheckpointSizingPolicy(t *testing.T) {
+	for name, expected := range map[string]string{
+		"":                   StaticSizingPolicy,
+		StaticSizingPolicy:   StaticSizingPolicy,
+		IntervalSizingPolicy: IntervalSizingPolicy,
+		GasSizingPolicy:      GasSizingPolicy,
+	} {
+		policy, err := NewCheckpointSizingPolicy(helper.Configuration{CheckpointSizingPolicy: name})
+		require.NoError(t, err)
+		require.Equal(t, expected, policy.Name())
+	}
+
+	_, err := NewCheckpointSizingPolicy(helper.Configuration{CheckpointSizingPolicy: "unknown"})
+	require.Error(t, err)
+}
+
+func TestStaticPolicy(t *testing.T) {
+	policy := StaticPolicy{}
+	source := testSizingSource{}
+
+	decision, err := policy.NextEnd(source, testSizingState(256, 600, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(511), decision.End)
+	require.Equal(t, SizingReasonAvgLength, decision.Reason)
+
+	decision, err = policy.NextEnd(source, testSizingState(256, 5000, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(256+1024-1), decision.End)
+	require.Equal(t, SizingReasonMaxLength, decision.Reason)
+
+	decision, err = policy.NextEnd(source, testSizingState(256, 300, 60))
+	require.NoError(t, err)
+	require.Equal(t, SizingReasonWaiting, decision.Reason)
+
+	decision, err = policy.NextEnd(source, testSizingState(256, 300, 3000))
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), decision.End)
+	require.Equal(t, SizingReasonForcePush, decision.Reason)
+}
+
+func TestIntervalPolicy(t *testing.T) {
+	policy := IntervalPolicy{TargetInterval: 10 * time.Minute}
+	source := testSizingSource{blockTime: 2}
+
+	// 10 minutes of 2 second blocks
+	decision, err := policy.NextEnd(source, testSizingState(256, 1000, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), decision.TargetLength)
+	require.Equal(t, uint64(256+300-1), decision.End)
+	require.Equal(t, SizingReasonTargetLength, decision.Reason)
+
+	decision, err = policy.NextEnd(source, testSizingState(256, 400, 60))
+	require.NoError(t, err)
+	require.Equal(t, SizingReasonWaiting, decision.Reason)
+
+	// slow blocks, target interval passed
+	decision, err = policy.NextEnd(source, testSizingState(256, 400, 600))
+	require.NoError(t, err)
+	require.Equal(t, uint64(400), decision.End)
+	require.Equal(t, SizingReasonTargetInterval, decision.Reason)
+
+	// fast blocks, capped at max checkpoint length
+	decision, err = policy.NextEnd(testSizingSource{blockTime: 0.1}, testSizingState(256, 10000, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), decision.TargetLength)
+	require.Equal(t, uint64(256+1024-1), decision.End)
+}
+
+func TestGasPolicy(t *testing.T) {
+	policy := GasPolicy{MaxBaseFee: big.NewInt(100), Fallback: StaticPolicy{}}
+
+	// low base fee
+	decision, err := policy.NextEnd(testSizingSource{baseFee: big.NewInt(50)}, testSizingState(256, 600, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(511), decision.End)
+	require.Equal(t, big.NewInt(50), decision.BaseFee)
+
+	// high base fee, wait for larger range
+	highFee := testSizingSource{baseFee: big.NewInt(150)}
+	decision, err = policy.NextEnd(highFee, testSizingState(256, 600, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), decision.End)
+	require.Equal(t, SizingReasonHighBaseFee, decision.Reason)
+
+	decision, err = policy.NextEnd(highFee, testSizingState(256, 5000, 60))
+	require.NoError(t, err)
+	require.Equal(t, uint64(256+1024-1), decision.End)
+	require.Equal(t, SizingReasonMaxLength, decision.Reason)
+
+	// high base fee, waited for checkpoint buffer time
+	decision, err = policy.NextEnd(highFee, testSizingState(256, 600, 1000))
+	require.NoError(t, err)
+	require.Equal(t, uint64(511), decision.End)
+	require.Equal(t, SizingReasonAvgLength, decision.Reason)
+}
@@ -24,6 +24,7 @@ import (
 	chainManagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/types"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
@@ -46,11 +47,13 @@ const (
 	CurrentValidatorSetURL  = "staking/validator-set"
 	StakingTxStatusURL      = "/staking/isoldtx"
 	TopupTxStatusURL        = "/topup/isoldtx"
+	DelegationTxStatusURL   = "/delegation/isoldtx"
 	ClerkTxStatusURL        = "/clerk/isoldtx"
 	LatestSlashInfoBytesURL = "/slashing/latest_slash_info_bytes"
 	TickSlashInfoListURL    = "/slashing/tick_slash_infos"
 	SlashingTxStatusURL     = "/slashing/isoldtx"
 	SlashingTickCountURL    = "/slashing/tick-count"
+	ValidatorNoncesURL      = "/staking/validator-nonces"
 
 	TransactionTimeout      = 1 * time.Minute
 	CommitTimeout           = 2 * time.Minute
@@ -408,6 +411,89 @@ func GetValidatorNonce(cliCtx cliContext.CLIContext, validatorID uint64) (uint64
 	return validator.Nonce, result.Height, nil
 }
 
+// GetValidatorNonces returns heimdall nonces of all validators
+func GetValidatorNonces(cliCtx cliContext.CLIContext) ([]stakingTypes.ValidatorNonce, error) {
+	result, err := helper.FetchFromAPI(cliCtx, helper.GetHeimdallServerEndpoint(ValidatorNoncesURL))
+	if err != nil {
+		logger.Error("Error fetching validator nonces", "error", err)
+		return nil, err
+	}
+
+	var nonces []stakingTypes.ValidatorNonce
+	if err := json.Unmarshal(result.Result, &nonces); err != nil {
+		logger.Error("Error unmarshalling validator nonces", "error", err)
+		return nil, err
+	}
+
+	return nonces, nil
+}
+
+// GetNonceGaps returns staking events on rootchain missing in heimdall, zero validator ID for all validators.
+// Validators staked or updated on rootchain in the block range are checked along with the validators in heimdall,
+// zero to block is latest rootchain block.
+func GetNonceGaps(cliCtx cliContext.CLIContext, contractCaller helper.IContractCaller, validatorID uint64, fromBlock uint64, toBlock uint64) ([]stakingTypes.NonceGap, error) {
+	if toBlock == 0 {
+		latest, err := contractCaller.GetMainChainBlock(nil)
+		if err != nil {
+			return nil, err
+		}
+		toBlock = latest.Number.Uint64()
+	}
+
+	if fromBlock > toBlock || toBlock-fromBlock > stakingTypes.MaxNonceGapBlockRange {
+		return nil, fmt.Errorf("block range must be ordered and at most %d blocks", stakingTypes.MaxNonceGapBlockRange)
+	}
+
+	chainmanagerParams, err := GetChainmanagerParams(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingInfoAddress := chainmanagerParams.ChainParams.StakingInfoAddress.EthAddress()
+	stakingInfoInstance, err := contractCaller.GetStakingInfoInstance(stakingInfoAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	nonces, err := GetValidatorNonces(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := contractCaller.GetStakingEvents(stakingInfoAddress, fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	heimdallNonces := make(map[hmtypes.ValidatorID]uint64)
+	for _, nonce := range nonces {
+		heimdallNonces[nonce.ValidatorID] = nonce.Nonce
+	}
+
+	// validators in heimdall and validators with events on rootchain, a new validator has Staked event only on rootchain
+	rootChainNonces := make(map[hmtypes.ValidatorID]uint64)
+	if validatorID != 0 {
+		rootChainNonces[hmtypes.ValidatorID(validatorID)] = 0
+	} else {
+		for id := range heimdallNonces {
+			rootChainNonces[id] = 0
+		}
+		for _, event := range events {
+			rootChainNonces[hmtypes.ValidatorID(event.ValidatorID)] = 0
+		}
+	}
+
+	for id := range rootChainNonces {
+		nonce, err := contractCaller.GetValidatorNonce(id, stakingInfoInstance)
+		if err != nil {
+			return nil, err
+		}
+		rootChainNonces[id] = nonce
+	}
+
+	return stakingTypes.NewNonceGaps(heimdallNonces, rootChainNonces, events), nil
+}
+
 // GetlastestCheckpoint return last successful checkpoint
 func GetBlockHeight(cliCtx cliContext.CLIContext) int64 {
 	response, err := helper.FetchFromAPI(
@@ -55,8 +55,18 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the chainmanager module's parameters.
+// GetParams gets the chainmanager module's parameters. Child chains are left empty on chains
+// which did not run the child-chains upgrade yet.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramSpace.Get(ctx, types.KeyMainchainTxConfirmations, &params.MainchainTxConfirmations)
+	k.paramSpace.Get(ctx, types.KeyMaticchainTxConfirmations, &params.MaticchainTxConfirmations)
+	k.paramSpace.Get(ctx, types.KeyChainParams, &params.ChainParams)
+	k.paramSpace.GetIfExists(ctx, types.KeyChildChains, &params.ChildChains)
 	return
 }
+
+// IsChildChainsEnabled returns true if child chains can be registered. They can from genesis on new chains
+// and after the child-chains upgrade on existing ones.
+func (k Keeper) IsChildChainsEnabled(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyChildChains)
+}
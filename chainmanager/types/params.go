@@ -26,6 +26,7 @@ var (
 	KeyMainchainTxConfirmations  = []byte("MainchainTxConfirmations")
 	KeyMaticchainTxConfirmations = []byte("MaticchainTxConfirmations")
 	KeyChainParams               = []byte("ChainParams")
+	KeyChildChains               = []byte("ChildChains")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -59,11 +60,22 @@ func (cp ChainParams) String() string {
 		cp.BorChainID, cp.MaticTokenAddress, cp.StakingManagerAddress, cp.SlashManagerAddress, cp.RootChainAddress, cp.StakingInfoAddress, cp.StateSenderAddress, cp.StateReceiverAddress, cp.ValidatorSetAddress)
 }
 
+// ChildChain is a bor chain checkpointed by the same validators next to the primary bor chain
+type ChildChain struct {
+	BorChainID       string                  `json:"bor_chain_id" yaml:"bor_chain_id"`
+	RootChainAddress hmTypes.HeimdallAddress `json:"root_chain_address" yaml:"root_chain_address"`
+}
+
+func (cc ChildChain) String() string {
+	return fmt.Sprintf("BorChainID: %s RootChainAddress: %s", cc.BorChainID, cc.RootChainAddress)
+}
+
 // Params defines the parameters for the chainmanager module.
 type Params struct {
-	MainchainTxConfirmations  uint64      `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
-	MaticchainTxConfirmations uint64      `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
-	ChainParams               ChainParams `json:"chain_params" yaml:"chain_params"`
+	MainchainTxConfirmations  uint64       `json:"mainchain_tx_confirmations" yaml:"mainchain_tx_confirmations"`
+	MaticchainTxConfirmations uint64       `json:"maticchain_tx_confirmations" yaml:"maticchain_tx_confirmations"`
+	ChainParams               ChainParams  `json:"chain_params" yaml:"chain_params"`
+	ChildChains               []ChildChain `json:"child_chains" yaml:"child_chains"`
 }
 
 // NewParams creates a new Params object
@@ -83,6 +95,7 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyMainchainTxConfirmations, &p.MainchainTxConfirmations},
 		{KeyMaticchainTxConfirmations, &p.MaticchainTxConfirmations},
 		{KeyChainParams, &p.ChainParams},
+		{KeyChildChains, &p.ChildChains},
 	}
 }
 
@@ -100,9 +113,34 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("MainchainTxConfirmations: %d\n", p.MainchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("MaticchainTxConfirmations: %d\n", p.MaticchainTxConfirmations))
 	sb.WriteString(fmt.Sprintf("ChainParams: %s\n", p.ChainParams.String()))
+	sb.WriteString("ChildChains:\n")
+	for _, childChain := range p.ChildChains {
+		sb.WriteString(fmt.Sprintf("  %s\n", childChain.String()))
+	}
 	return sb.String()
 }
 
+// GetChildChain returns the registered child chain with bor chain id
+func (p Params) GetChildChain(borChainID string) (ChildChain, bool) {
+	for _, childChain := range p.ChildChains {
+		if childChain.BorChainID == borChainID {
+			return childChain, true
+		}
+	}
+
+	return ChildChain{}, false
+}
+
+// GetRootChainAddress returns the root chain contract of a bor chain. Chains which are
+// not registered as child chain are checkpointed to the root chain of the primary bor chain.
+func (p Params) GetRootChainAddress(borChainID string) hmTypes.HeimdallAddress {
+	if childChain, ok := p.GetChildChain(borChainID); ok {
+		return childChain.RootChainAddress
+	}
+
+	return p.ChainParams.RootChainAddress
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateHeimdallAddress("matic_token_address", p.ChainParams.MaticTokenAddress); err != nil {
@@ -137,6 +175,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	borChainIDs := map[string]bool{p.ChainParams.BorChainID: true}
+	for _, childChain := range p.ChildChains {
+		if childChain.BorChainID == "" || len(childChain.BorChainID) > 255 || borChainIDs[childChain.BorChainID] {
+			return fmt.Errorf("Invalid or duplicate bor chain id %s in child_chains", childChain.BorChainID)
+		}
+		borChainIDs[childChain.BorChainID] = true
+
+		if err := validateHeimdallAddress("root_chain_address", childChain.RootChainAddress); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
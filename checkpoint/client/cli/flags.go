@@ -9,7 +9,13 @@ const (
 	FlagAccountRootHash    = "account-root-hash"
 	FlagBorChainID         = "bor-chain-id"
 	FlagHeaderNumber       = "header"
+	FlagFromHeaderNumber   = "from-header"
+	FlagToHeaderNumber     = "to-header"
 	FlagCheckpointTxHash   = "txhash"
 	FlagCheckpointLogIndex = "log-index"
 	FlagAutoConfigure      = "auto-configure"
+	FlagValidatorID        = "validator-id"
+	FlagTitle              = "title"
+	FlagDescription        = "description"
+	FlagDeposit            = "deposit"
 )
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,8 +14,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	checkpointUtils "github.com/maticnetwork/heimdall/checkpoint/client/utils"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -37,6 +40,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetBlockProof(cdc),
+			GetCheckpointByBlock(cdc),
+			GetCheckpointByAckTx(cdc),
+			GetCheckpointSignatures(cdc),
+			GetCheckpointMetadata(cdc),
+			GetCheckpointStats(cdc),
+			GetAdjustments(cdc),
 		)...,
 	)
 
@@ -45,7 +55,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetQueryParams implements the params query command.
 func GetQueryParams(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "params",
 		Args:  cobra.NoArgs,
 		Short: "show the current checkpoint parameters information",
@@ -61,8 +71,13 @@ $ %s query checkpoint params
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			chainData, err := chainQueryData(cliCtx)
+			if err != nil {
+				return err
+			}
+
 			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
-			bz, _, err := cliCtx.QueryWithData(route, nil)
+			bz, _, err := cliCtx.QueryWithData(route, chainData)
 			if err != nil {
 				return err
 			}
@@ -74,6 +89,9 @@ $ %s query checkpoint params
 			return cliCtx.PrintOutput(params)
 		},
 	}
+
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	return cmd
 }
 
 // GetCheckpointBuffer get checkpoint present in buffer
@@ -84,7 +102,12 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+			chainData, err := chainQueryData(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), chainData)
 			if err != nil {
 				return err
 			}
@@ -98,6 +121,7 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
 	return cmd
 }
 
@@ -109,7 +133,12 @@ func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), nil)
+			chainData, err := chainQueryData(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastNoAck), chainData)
 			if err != nil {
 				return err
 			}
@@ -128,6 +157,7 @@ func GetLastNoACK(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
 	return cmd
 }
 
@@ -141,7 +171,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 			headerNumber := viper.GetUint64(FlagHeaderNumber)
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainCheckpointParams(headerNumber, viper.GetString(FlagBorChainID)))
 			if err != nil {
 				return err
 			}
@@ -158,6 +188,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("GetHeaderFromIndex | MarkFlagRequired | FlagHeaderNumber", "Error", err)
 	}
@@ -173,7 +204,12 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+			chainData, err := chainQueryData(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), chainData)
 			if err != nil {
 				return err
 			}
@@ -192,5 +228,318 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	return cmd
+}
+
+// GetBlockProof get merkle proof of a bor block within its checkpoint
+func GetBlockProof(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "block-proof [block-number]",
+		Args:  cobra.ExactArgs(1),
+		Short: "get merkle proof of a bor block within the checkpoint covering it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Find the checkpoint covering a bor block and get the merkle proof of the block header within the checkpoint root hash.
+
+Example:
+$ %s query checkpoint block-proof 1000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			blockNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlockProof), queryParams)
+			if err != nil {
+				return err
+			}
+
+			var blockProof types.BlockProof
+			if err := json.Unmarshal(res, &blockProof); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(blockProof)
+		},
+	}
+}
+
+// GetCheckpointByBlock get checkpoint covering a bor block
+func GetCheckpointByBlock(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "checkpoint-by-block [block-number]",
+		Args:  cobra.ExactArgs(1),
+		Short: "get checkpoint covering a bor block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the checkpoint, along with its number, covering a bor block.
+
+Example:
+$ %s query checkpoint checkpoint-by-block 1000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			blockNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorBlockParams(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCheckpointByAckTx get checkpoint acked by a rootchain tx
+func GetCheckpointByAckTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-by-ack-tx",
+		Args:  cobra.NoArgs,
+		Short: "get checkpoint acked by a rootchain tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the checkpoint, along with its number, acked by a rootchain tx hash and log index.
+
+Example:
+$ %s query checkpoint checkpoint-by-ack-tx --txhash=<checkpoint-txhash> --log-index=0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txHashStr := viper.GetString(FlagCheckpointTxHash)
+			if txHashStr == "" {
+				return fmt.Errorf("checkpoint tx hash cannot be empty")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryAckTxParams(hmTypes.HexToHeimdallHash(txHashStr), viper.GetUint64(FlagCheckpointLogIndex)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByAckTx), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
+	cmd.Flags().Uint64(FlagCheckpointLogIndex, 0, "--log-index=<log-index>")
+	if err := cmd.MarkFlagRequired(FlagCheckpointTxHash); err != nil {
+		logger.Error("GetCheckpointByAckTx | MarkFlagRequired | FlagCheckpointTxHash", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointSignatures get side-tx signatures of an acked checkpoint
+func GetCheckpointSignatures(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signatures",
+		Args:  cobra.NoArgs,
+		Short: "get side-tx signatures of an acked checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the side-tx data and signatures which approved a checkpoint, ready to be submitted to the rootchain contract.
+
+Example:
+$ %s query checkpoint signatures --header=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			signatures, err := checkpointUtils.GetCheckpointSignatures(cliCtx, viper.GetUint64(FlagHeaderNumber))
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(signatures, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
+		logger.Error("GetCheckpointSignatures | MarkFlagRequired | FlagHeaderNumber", "Error", err)
+	}
+
 	return cmd
 }
+
+// GetCheckpointMetadata get metadata of an acked checkpoint
+func GetCheckpointMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata",
+		Args:  cobra.NoArgs,
+		Short: "get metadata of an acked checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the heights at which a checkpoint was proposed and acked, its time in buffer and the buffer timeouts and no-acks before it.
+
+Example:
+$ %s query checkpoint metadata --header=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryChainCheckpointParams(viper.GetUint64(FlagHeaderNumber), viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			// fetch metadata
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryMetadata), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
+		logger.Error("GetCheckpointMetadata | MarkFlagRequired | FlagHeaderNumber", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCheckpointStats get aggregate stats of acked checkpoints
+func GetCheckpointStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Args:  cobra.NoArgs,
+		Short: "get aggregate stats of acked checkpoints",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get checkpoint interval, block span, proposer distribution, time in buffer, buffer timeouts
+and no-acks over a window of acked checkpoints. The latest %v checkpoints are used by default.
+
+Example:
+$ %s query checkpoint stats --from-header=100 --to-header=200
+`,
+				types.DefaultStatsWindow,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStatsParams(
+				viper.GetUint64(FlagFromHeaderNumber),
+				viper.GetUint64(FlagToHeaderNumber),
+				viper.GetString(FlagBorChainID),
+			))
+			if err != nil {
+				return err
+			}
+
+			// fetch stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStats), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromHeaderNumber, 0, "--from-header=<header-number>, first checkpoint of the window")
+	cmd.Flags().Uint64(FlagToHeaderNumber, 0, "--to-header=<header-number>, last checkpoint of the window, if left blank the latest checkpoint is used")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+
+	return cmd
+}
+
+// GetAdjustments get all adjustments of acked checkpoints
+func GetAdjustments(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adjustments",
+		Args:  cobra.NoArgs,
+		Short: "get all adjustments of acked checkpoints",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get every checkpoint adjustment ever applied, with the original and adjusted checkpoint
+and whether it was applied by an adjust msg or an adjust proposal.
+
+Example:
+$ %s query checkpoint adjustments
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainData, err := chainQueryData(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAdjustments), chainData)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	return cmd
+}
+
+// chainQueryData returns query data for the bor chain in bor-chain-id flag, nil for the primary bor chain
+func chainQueryData(cliCtx context.CLIContext) ([]byte, error) {
+	borChainID := viper.GetString(FlagBorChainID)
+	if borChainID == "" {
+		return nil, nil
+	}
+
+	return cliCtx.Codec.MarshalJSON(types.NewQueryBorChainID(borChainID))
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -18,8 +19,10 @@ import (
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	types "github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
 )
 
 var logger = helper.Logger.With("module", "checkpoint/client/cli")
@@ -105,6 +108,7 @@ func SendCheckpointAdjust(cdc *codec.Codec) *cobra.Command {
 				proposer,
 				from,
 				hmTypes.HexToHeimdallHash(rootHashStr),
+				viper.GetString(FlagBorChainID),
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -115,6 +119,7 @@ func SendCheckpointAdjust(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagStartBlock, "", "--start-block=<start-block-number>")
 	cmd.Flags().String(FlagEndBlock, "", "--end-block=<end-block-number>")
 	cmd.Flags().StringP(FlagRootHash, "r", "", "--root-hash=<root-hash>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
 
 	cmd.MarkFlagRequired(FlagHeaderNumber)
 	cmd.MarkFlagRequired(FlagRootHash)
@@ -293,9 +298,10 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				return errors.New("Transaction is not confirmed yet. Please wait for sometime and try again")
 			}
 
-			// decode new header block event
+			// decode new header block event of the root chain of bor chain
+			borChainID := viper.GetString(FlagBorChainID)
 			res, err := contractCallerObj.DecodeNewHeaderBlockEvent(
-				chainmanagerParams.ChainParams.RootChainAddress.EthAddress(),
+				chainmanagerParams.GetRootChainAddress(borChainID).EthAddress(),
 				receipt,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
 			)
@@ -313,6 +319,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				res.Root,
 				txHash,
 				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
+				borChainID,
 			)
 
 			// msg
@@ -324,6 +331,7 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
 	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
 	cmd.Flags().String(FlagCheckpointLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
 
 	if err := cmd.MarkFlagRequired(FlagHeaderNumber); err != nil {
 		logger.Error("SendCheckpointACKTx | MarkFlagRequired | FlagHeaderNumber", "Error", err)
@@ -355,6 +363,7 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 			// create new checkpoint no-ack
 			msg := types.NewMsgCheckpointNoAck(
 				proposer,
+				viper.GetString(FlagBorChainID),
 			)
 
 			// broadcast messages
@@ -363,5 +372,144 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	return cmd
+}
+
+// GetCmdSubmitAdjustProposal implements a command handler for submitting a checkpoint adjust proposal transaction.
+func GetCmdSubmitAdjustProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-adjust",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to adjust an acked checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to adjust the end block, root hash and proposer of an acked checkpoint
+along with an initial deposit. The adjusted checkpoint must keep its start block and stay in
+continuity with the next checkpoint.
+
+Example:
+$ %s tx gov submit-proposal checkpoint-adjust --header=10 --start-block=2560 --end-block=2815 --root-hash=<root-hash> --proposer=<proposer-address> --title="Adjust checkpoint" --description="Match rootchain header" --deposit="1000000000000000000matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCheckpointAdjustProposal(
+				viper.GetString(FlagTitle),
+				viper.GetString(FlagDescription),
+				viper.GetUint64(FlagHeaderNumber),
+				hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress)),
+				viper.GetUint64(FlagStartBlock),
+				viper.GetUint64(FlagEndBlock),
+				hmTypes.HexToHeimdallHash(viper.GetString(FlagRootHash)),
+				viper.GetString(FlagBorChainID),
+			)
+
+			// create submit proposal
+			from := helper.GetFromAddress(cliCtx)
+			msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagHeaderNumber, 0, "--header=<header-index>")
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<start-block-number>")
+	cmd.Flags().Uint64(FlagEndBlock, 0, "--end-block=<end-block-number>")
+	cmd.Flags().StringP(FlagRootHash, "r", "", "--root-hash=<root-hash>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	for _, flag := range []string{FlagHeaderNumber, FlagProposerAddress, FlagStartBlock, FlagEndBlock, FlagRootHash, FlagValidatorID} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			logger.Error("GetCmdSubmitAdjustProposal | MarkFlagRequired | "+flag, "Error", err)
+		}
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitBufferFlushProposal implements a command handler for submitting a checkpoint buffer flush proposal transaction.
+func GetCmdSubmitBufferFlushProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-buffer-flush",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to flush the checkpoint in buffer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to flush the checkpoint in buffer along with an initial deposit, e.g. when
+the rootchain already moved on and the buffered checkpoint can never be acked. Proposer and start block
+must match the checkpoint in buffer when the proposal passes.
+
+Example:
+$ %s tx gov submit-proposal checkpoint-buffer-flush --proposer=<proposer-address> --start-block=2560 --title="Flush checkpoint buffer" --description="Rootchain moved on" --deposit="1000000000000000000matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Valid validator ID required")
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCheckpointBufferFlushProposal(
+				viper.GetString(FlagTitle),
+				viper.GetString(FlagDescription),
+				hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress)),
+				viper.GetUint64(FlagStartBlock),
+				viper.GetString(FlagBorChainID),
+			)
+
+			// create submit proposal
+			from := helper.GetFromAddress(cliCtx)
+			msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<start-block-number>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>, if left blank the primary bor chain is used")
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+
+	for _, flag := range []string{FlagProposerAddress, FlagStartBlock, FlagValidatorID} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			logger.Error("GetCmdSubmitBufferFlushProposal | MarkFlagRequired | "+flag, "Error", err)
+		}
+	}
+
 	return cmd
 }
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/maticnetwork/heimdall/checkpoint/client/cli"
+	"github.com/maticnetwork/heimdall/checkpoint/client/rest"
+	govclient "github.com/maticnetwork/heimdall/gov/client"
+)
+
+// checkpoint proposal handlers
+var (
+	AdjustProposalHandler      = govclient.NewProposalHandler(cli.GetCmdSubmitAdjustProposal, rest.AdjustProposalRESTHandler)
+	BufferFlushProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBufferFlushProposal, rest.BufferFlushProposalRESTHandler)
+)
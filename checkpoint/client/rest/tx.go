@@ -9,6 +9,8 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/rest"
 )
@@ -48,13 +50,46 @@ type (
 		RootHash    hmTypes.HeimdallHash    `json:"root_Hash"`
 		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
 		LogIndex    uint64                  `json:"log_index"`
+		BorChainID  string                  `json:"bor_chain_id"`
+	}
+
+	// AdjustProposalReq defines a checkpoint adjust proposal request body
+	AdjustProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		HeaderBlock uint64                  `json:"header_block" yaml:"header_block"`
+		StartBlock  uint64                  `json:"start_block" yaml:"start_block"`
+		EndBlock    uint64                  `json:"end_block" yaml:"end_block"`
+		RootHash    hmTypes.HeimdallHash    `json:"root_hash" yaml:"root_hash"`
+		Checkpoint  hmTypes.HeimdallAddress `json:"checkpoint_proposer" yaml:"checkpoint_proposer"`
+		BorChainID  string                  `json:"bor_chain_id" yaml:"bor_chain_id"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+	}
+
+	// BufferFlushProposalReq defines a checkpoint buffer flush proposal request body
+	BufferFlushProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                  `json:"title" yaml:"title"`
+		Description string                  `json:"description" yaml:"description"`
+		StartBlock  uint64                  `json:"start_block" yaml:"start_block"`
+		Checkpoint  hmTypes.HeimdallAddress `json:"checkpoint_proposer" yaml:"checkpoint_proposer"`
+		BorChainID  string                  `json:"bor_chain_id" yaml:"bor_chain_id"`
+		Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+		Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
 	HeaderNoACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		Proposer hmTypes.HeimdallAddress `json:"proposer"`
+		Proposer   hmTypes.HeimdallAddress `json:"proposer"`
+		BorChainID string                  `json:"bor_chain_id"`
 	}
 )
 
@@ -107,6 +142,7 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.RootHash,
 			req.TxHash,
 			req.LogIndex,
+			req.BorChainID,
 		)
 
 		// send response
@@ -129,9 +165,89 @@ func newCheckpointNoACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		// draft a message and send response
 		msg := types.NewMsgCheckpointNoAck(
 			req.Proposer,
+			req.BorChainID,
 		)
 
 		// send response
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// AdjustProposalRESTHandler returns the checkpoint adjust proposal REST handler with a given sub-route.
+func AdjustProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "checkpoint_adjust",
+		Handler:  postAdjustProposalHandler(cliCtx),
+	}
+}
+
+func postAdjustProposalHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AdjustProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCheckpointAdjustProposal(
+			req.Title,
+			req.Description,
+			req.HeaderBlock,
+			req.Checkpoint,
+			req.StartBlock,
+			req.EndBlock,
+			req.RootHash,
+			req.BorChainID,
+		)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// BufferFlushProposalRESTHandler returns the checkpoint buffer flush proposal REST handler with a given sub-route.
+func BufferFlushProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "checkpoint_buffer_flush",
+		Handler:  postBufferFlushProposalHandler(cliCtx),
+	}
+}
+
+func postBufferFlushProposalHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BufferFlushProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCheckpointBufferFlushProposal(
+			req.Title,
+			req.Description,
+			req.Checkpoint,
+			req.StartBlock,
+			req.BorChainID,
+		)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
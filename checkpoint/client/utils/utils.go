@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 30 // should be consistent with tendermint/tendermint/rpc/core/pipe.go:19
+)
+
+// CheckpointSignatures is the payload to submit an acked checkpoint to the rootchain contract
+type CheckpointSignatures struct {
+	Number     uint64               `json:"number"`
+	StartBlock uint64               `json:"start_block"`
+	EndBlock   uint64               `json:"end_block"`
+	Height     int64                `json:"height"`
+	TxHash     hmTypes.HeimdallHash `json:"tx_hash"`
+	SideTxData hmTypes.HexBytes     `json:"side_tx_data"`
+	Sigs       [][3]*big.Int        `json:"sigs"`
+}
+
+// sideTxDelay is the number of blocks after which the side-tx of a tx is processed
+const sideTxDelay = 2
+
+// GetCheckpointSignatures finds the heimdall tx which approved the checkpoint and collects
+// the side-tx signatures of the approval from the precommits of the approval height.
+// The checkpoint is either acked or the next one, waiting for ack in buffer. Checkpoints
+// acked before approvals were recorded are looked up in past checkpoint txs.
+func GetCheckpointSignatures(cliCtx context.CLIContext, number uint64) (*CheckpointSignatures, error) {
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApproval), queryParams)
+	if err != nil {
+		approvals, searchErr := searchApprovals(cliCtx, queryParams)
+		if searchErr != nil {
+			return nil, searchErr
+		}
+
+		// only the checkpoint tx which got +2/3 yes votes has signatures
+		for _, approval := range approvals {
+			signatures, err := getApprovalSignatures(cliCtx, number, approval)
+			if err == nil && len(signatures.Sigs) != 0 {
+				return signatures, nil
+			}
+		}
+
+		return nil, fmt.Errorf("no approval found for checkpoint %v", number)
+	}
+
+	var approval types.Approval
+	if err := json.Unmarshal(res, &approval); err != nil {
+		return nil, err
+	}
+
+	return getApprovalSignatures(cliCtx, number, approval)
+}
+
+// searchApprovals returns possible approvals of an acked checkpoint from past checkpoint txs with
+// the same block range and root hash, assuming their side-tx was processed without delay
+func searchApprovals(cliCtx context.CLIContext, queryParams []byte) ([]types.Approval, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpoint), queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint hmTypes.Checkpoint
+	if err := json.Unmarshal(res, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	events := []string{
+		fmt.Sprintf("%s.%s='%d'", types.EventTypeCheckpoint, types.AttributeKeyStartBlock, checkpoint.StartBlock),
+		fmt.Sprintf("%s.%s='%d'", types.EventTypeCheckpoint, types.AttributeKeyEndBlock, checkpoint.EndBlock),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeCheckpoint, types.AttributeKeyRootHash, checkpoint.RootHash.String()),
+	}
+
+	searchResult, err := helper.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]types.Approval, 0, len(searchResult.Txs))
+	for _, tx := range searchResult.Txs {
+		approvals = append(approvals, types.NewApproval(tx.Height+sideTxDelay, hmTypes.HexToHeimdallHash(tx.TxHash)))
+	}
+
+	return approvals, nil
+}
+
+// getApprovalSignatures collects the side-tx signatures of an approval
+func getApprovalSignatures(cliCtx context.CLIContext, number uint64, approval types.Approval) (*CheckpointSignatures, error) {
+	// side-tx data of approved checkpoint tx
+	tx, err := helper.QueryTxWithProof(cliCtx, approval.TxHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	stdTx, err := helper.GetTxDecoder(authTypes.ModuleCdc)(tx.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, ok := stdTx.GetMsgs()[0].(types.MsgCheckpoint)
+	if !ok {
+		return nil, errors.New("approval tx is not a checkpoint tx")
+	}
+
+	sideTxData := msg.GetSideSignBytes()
+
+	// side-tx sigs from precommits
+	block, err := helper.GetBlock(cliCtx, approval.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := helper.GetSideTxSigs(tx.Tx.Hash(), sideTxData, block.Block.LastCommit.Precommits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckpointSignatures{
+		Number:     number,
+		StartBlock: msg.StartBlock,
+		EndBlock:   msg.EndBlock,
+		Height:     approval.Height,
+		TxHash:     approval.TxHash,
+		SideTxData: sideTxData,
+		Sigs:       sigs,
+	}, nil
+}
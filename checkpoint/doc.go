@@ -10,4 +10,9 @@ Sending checkpoint is a 2 phase process.
 3. Once this `MsgCheckpoint` is deemed valid, the bridge collects all the votes and sends the checkpoint to etheruem chain smart contract.
 4. As soon as this transaction on etheruem chain goes through we start with phase 2 of checkpoint submisssion process on heimdall
 5. We send another transaction called `MsgCheckpointAck`: Here the tranaction basically claims that the checkpoint earlier submitted has been processed on the ethereum chain
+
+Checkpoints of child bor chains registered in chainmanager `child_chains` params follow the same process on their own
+checkpoint buffer, ack count and root chain contract, selected by `bor_chain_id` of the messages. Unknown bor chain ids are rejected.
+The bridge proposes, submits and acks checkpoints of the primary bor chain only, checkpoints of child chains are sent
+via cli/rest with `--bor-chain-id`. Acks of child chains do not rotate the checkpoint proposer.
 */
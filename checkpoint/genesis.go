@@ -2,6 +2,7 @@ package checkpoint
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,38 +13,101 @@ import (
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	keeper.EnableIndex(ctx)
 
+	// primary sequence has the same state as child chain ones
+	initSequence(ctx, keeper, types.ChildChainState{
+		BufferedCheckpoint: data.BufferedCheckpoint,
+		LastNoACK:          data.LastNoACK,
+		AckCount:           data.AckCount,
+		Checkpoints:        data.Checkpoints,
+		AckTxs:             data.AckTxs,
+		Adjustments:        data.Adjustments,
+		ApprovedAdjusts:    data.ApprovedAdjusts,
+		Approvals:          data.Approvals,
+		BufferApproval:     data.BufferApproval,
+		Metadata:           data.Metadata,
+		Activity:           data.Activity,
+	})
+
+	// checkpoint sequences of child chains
+	for _, childChain := range data.ChildChains {
+		chainKeeper, ok := keeper.ForChain(ctx, childChain.BorChainID)
+		if !ok || chainKeeper.BorChainID() == "" {
+			panic(fmt.Errorf("child chain %v is not registered in chainmanager", childChain.BorChainID))
+		}
+
+		initSequence(ctx, chainKeeper, childChain)
+	}
+}
+
+// initSequence sets the state of a checkpoint sequence
+func initSequence(ctx sdk.Context, keeper Keeper, state types.ChildChainState) {
 	// Set last no-ack
-	if data.LastNoACK > 0 {
-		keeper.SetLastNoAck(ctx, data.LastNoACK)
+	if state.LastNoACK > 0 {
+		keeper.SetLastNoAck(ctx, state.LastNoACK)
 	}
 
 	// Add finalised checkpoints to state
-	if len(data.Checkpoints) != 0 {
+	if len(state.Checkpoints) != 0 {
 		// check if we are provided all the headers
-		if int(data.AckCount) != len(data.Checkpoints) {
+		if int(state.AckCount) != len(state.Checkpoints) {
 			panic(errors.New("Incorrect state in state-dump , Please Check "))
 		}
 		// sort headers before loading to state
-		data.Checkpoints = hmTypes.SortHeaders(data.Checkpoints)
+		checkpoints := hmTypes.SortHeaders(state.Checkpoints)
 		// load checkpoints to state
-		for i, checkpoint := range data.Checkpoints {
+		for i, checkpoint := range checkpoints {
 			checkpointIndex := uint64(i) + 1
 			if err := keeper.AddCheckpoint(ctx, checkpointIndex, checkpoint); err != nil {
 				keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "error", err)
 			}
+			keeper.SetEndBlockIndex(ctx, checkpoint.EndBlock, checkpointIndex)
 		}
 	}
 
+	// Index checkpoints by ack tx
+	for _, ackTx := range state.AckTxs {
+		keeper.SetAckTxIndex(ctx, ackTx)
+	}
+
+	// Audit trail of adjusted checkpoints
+	for _, adjustment := range state.Adjustments {
+		keeper.SetAdjustment(ctx, adjustment)
+	}
+
+	// Adjust proposals approved by governance which were not applied yet
+	for _, proposal := range state.ApprovedAdjusts {
+		keeper.SetApprovedAdjust(ctx, proposal)
+	}
+
+	// Approvals of acked checkpoints
+	for _, approval := range state.Approvals {
+		keeper.SetApproval(ctx, approval.Number, approval.Approval)
+	}
+
+	// Lifecycle of acked checkpoints and activity since last ack
+	for _, metadata := range state.Metadata {
+		keeper.SetMetadata(ctx, metadata)
+	}
+
+	if state.Activity != (types.CheckpointActivity{}) {
+		keeper.SetActivity(ctx, state.Activity)
+	}
+
 	// Add checkpoint in buffer
-	if data.BufferedCheckpoint != nil {
-		if err := keeper.SetCheckpointBuffer(ctx, *data.BufferedCheckpoint); err != nil {
+	if state.BufferedCheckpoint != nil {
+		if err := keeper.SetCheckpointBuffer(ctx, *state.BufferedCheckpoint); err != nil {
 			keeper.Logger(ctx).Error("InitGenesis | SetCheckpointBuffer", "error", err)
 		}
+
+		if state.BufferApproval != nil {
+			keeper.SetBufferApproval(ctx, *state.BufferApproval)
+		}
 	}
 
 	// Set initial ack count
-	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+	keeper.UpdateACKCountWithValue(ctx, state.AckCount)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -51,11 +115,49 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
+		keeper.GetAckTxs(ctx),
 	)
+	genesis.Adjustments = keeper.GetAdjustments(ctx)
+	genesis.ApprovedAdjusts = keeper.GetApprovedAdjusts(ctx)
+	genesis.Approvals = keeper.GetApprovals(ctx)
+	genesis.BufferApproval = bufferApproval(ctx, keeper)
+	genesis.Metadata = keeper.GetAllMetadata(ctx)
+	genesis.Activity = keeper.GetActivity(ctx)
+
+	// checkpoint sequences of child chains
+	for _, childChain := range keeper.ck.GetParams(ctx).ChildChains {
+		chainKeeper, _ := keeper.ForChain(ctx, childChain.BorChainID)
+		bufferedCheckpoint, _ := chainKeeper.GetCheckpointFromBuffer(ctx)
+		genesis.ChildChains = append(genesis.ChildChains, types.ChildChainState{
+			BorChainID:         childChain.BorChainID,
+			BufferedCheckpoint: bufferedCheckpoint,
+			LastNoACK:          chainKeeper.GetLastNoAck(ctx),
+			AckCount:           chainKeeper.GetACKCount(ctx),
+			Checkpoints:        hmTypes.SortHeaders(chainKeeper.GetCheckpoints(ctx)),
+			AckTxs:             chainKeeper.GetAckTxs(ctx),
+			Adjustments:        chainKeeper.GetAdjustments(ctx),
+			ApprovedAdjusts:    chainKeeper.GetApprovedAdjusts(ctx),
+			Approvals:          chainKeeper.GetApprovals(ctx),
+			BufferApproval:     bufferApproval(ctx, chainKeeper),
+			Metadata:           chainKeeper.GetAllMetadata(ctx),
+			Activity:           chainKeeper.GetActivity(ctx),
+		})
+	}
+
+	return genesis
+}
+
+// bufferApproval returns approval of checkpoint in buffer, if any
+func bufferApproval(ctx sdk.Context, keeper Keeper) *types.Approval {
+	if approval, found := keeper.GetBufferApproval(ctx); found {
+		return &approval
+	}
+
+	return nil
 }
@@ -7,8 +7,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	"github.com/stretchr/testify/require"
@@ -45,7 +47,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
 	timestamp := uint64(time.Now().Unix())
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 
 	bufferedCheckpoint := hmTypes.CreateBlock(
 		startBlock,
@@ -69,8 +71,21 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		uint64(lastNoACK),
 		uint64(ackCount),
 		checkpoints,
+		[]types.AckTx{types.NewAckTx(1, hmTypes.HexToHeimdallHash("456"), 2)},
 	)
 
+	genesisState.Adjustments = []types.Adjustment{
+		{ID: 1, Number: 1, Original: bufferedCheckpoint, Adjusted: bufferedCheckpoint, Height: 10, Source: types.AdjustmentSourceGov, Proposal: "Adjust"},
+	}
+	genesisState.ApprovedAdjusts = []types.CheckpointAdjustProposal{
+		types.NewCheckpointAdjustProposal("Adjust", "Match rootchain header", 1, proposerAddress, startBlock, 200, rootHash, ""),
+	}
+	genesisState.Approvals = []types.ApprovalWithNumber{
+		{Number: 1, Approval: types.NewApproval(20, hmTypes.HexToHeimdallHash("789"))},
+	}
+	bufferApproval := types.NewApproval(30, hmTypes.HexToHeimdallHash("abc"))
+	genesisState.BufferApproval = &bufferApproval
+
 	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
 
 	actualParams := checkpoint.ExportGenesis(ctx, app.CheckpointKeeper)
@@ -80,5 +95,93 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.Equal(t, genesisState.LastNoACK, actualParams.LastNoACK)
 	require.Equal(t, genesisState.Params, actualParams.Params)
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
+	require.Equal(t, genesisState.AckTxs, actualParams.AckTxs)
+	require.Equal(t, genesisState.Adjustments, actualParams.Adjustments)
+	require.Equal(t, genesisState.ApprovedAdjusts, actualParams.ApprovedAdjusts)
+	require.Equal(t, genesisState.Approvals, actualParams.Approvals)
+	require.Equal(t, genesisState.BufferApproval, actualParams.BufferApproval)
+}
+
+func (suite *GenesisTestSuite) TestInitExportChildChainGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChain{
+		{BorChainID: "5678", RootChainAddress: hmTypes.HexToHeimdallAddress("456")},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	checkpoint1 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "5678", uint64(time.Now().Unix()))
+	checkpoint2 := hmTypes.CreateBlock(256, 511, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "5678", uint64(time.Now().Unix()))
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), nil, 0, 0, nil, nil)
+	genesisState.ChildChains = []types.ChildChainState{
+		{
+			BorChainID:         "5678",
+			BufferedCheckpoint: &checkpoint2,
+			LastNoACK:          10,
+			AckCount:           1,
+			Checkpoints:        []hmTypes.Checkpoint{checkpoint1},
+			AckTxs:             []types.AckTx{types.NewAckTx(1, hmTypes.HexToHeimdallHash("789"), 2)},
+			Approvals:          []types.ApprovalWithNumber{{Number: 1, Approval: types.NewApproval(20, hmTypes.HexToHeimdallHash("abc"))}},
+			BufferApproval:     &types.Approval{Height: 30, TxHash: hmTypes.HexToHeimdallHash("def")},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
+	require.Equal(t, uint64(0), app.CheckpointKeeper.GetACKCount(ctx), "Primary ack count should not change")
+
+	actualParams := checkpoint.ExportGenesis(ctx, app.CheckpointKeeper)
+	require.Equal(t, genesisState.ChildChains, actualParams.ChildChains)
+}
+
+// TestExportImportGenesisMetadata test metadata and activity of checkpoint sequences survive export and import
+func (suite *GenesisTestSuite) TestExportImportGenesisMetadata() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChain{
+		{BorChainID: "5678", RootChainAddress: hmTypes.HexToHeimdallAddress("456")},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	checkpoint1 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), helper.DefaultBorChainID, 1000)
+	childCheckpoint1 := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("456"), hmTypes.HexToHeimdallAddress("123"), "5678", 1000)
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), nil, 0, 1, []hmTypes.Checkpoint{checkpoint1}, nil)
+	genesisState.ChildChains = []types.ChildChainState{
+		{BorChainID: "5678", AckCount: 1, Checkpoints: []hmTypes.Checkpoint{childCheckpoint1}},
+	}
+	checkpoint.InitGenesis(ctx, app.CheckpointKeeper, genesisState)
+
+	app.CheckpointKeeper.SetMetadata(ctx, types.NewCheckpointMetadata(1, 10, 20, 1100, hmTypes.HexToHeimdallHash("abc"), 100, types.CheckpointActivity{BufferTimeouts: 1, NoAcks: 2}))
+	app.CheckpointKeeper.SetActivity(ctx, types.CheckpointActivity{BufferTimeouts: 3, NoAcks: 1})
+
+	childKeeper, ok := app.CheckpointKeeper.ForChain(ctx, "5678")
+	require.True(t, ok)
+	childKeeper.SetMetadata(ctx, types.NewCheckpointMetadata(1, 11, 21, 1200, hmTypes.HexToHeimdallHash("def"), 200, types.CheckpointActivity{}))
+	childKeeper.SetActivity(ctx, types.CheckpointActivity{BufferTimeouts: 5})
+
+	exported := checkpoint.ExportGenesis(ctx, app.CheckpointKeeper)
+	require.Len(t, exported.Metadata, 1)
+	require.Equal(t, types.CheckpointActivity{BufferTimeouts: 3, NoAcks: 1}, exported.Activity)
+	require.Len(t, exported.ChildChains, 1)
+	require.Len(t, exported.ChildChains[0].Metadata, 1)
+	require.Equal(t, types.CheckpointActivity{BufferTimeouts: 5}, exported.ChildChains[0].Activity)
+
+	// import through genesis json into a new app
+	var imported types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(types.ModuleCdc.MustMarshalJSON(exported), &imported)
+	require.NoError(t, types.ValidateGenesis(imported))
+
+	newApp, newCtx, _ := createTestApp(true)
+	newApp.ChainKeeper.SetParams(newCtx, chainParams)
+	checkpoint.InitGenesis(newCtx, newApp.CheckpointKeeper, imported)
+
+	require.Equal(t, exported, checkpoint.ExportGenesis(newCtx, newApp.CheckpointKeeper))
 
+	metadata, found := newApp.CheckpointKeeper.GetMetadata(newCtx, 1)
+	require.True(t, found)
+	require.Equal(t, exported.Metadata[0], metadata)
 }
@@ -34,8 +34,21 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 // handleMsgCheckpointAdjust adjusts checkpoint
 func handleMsgCheckpointAdjust(ctx sdk.Context, msg types.MsgCheckpointAdjust, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
+	// direct adjustments may be disabled in favour of adjust proposals
+	if _, approved := approvedAdjust(ctx, k, msg); !approved && !k.GetParams(ctx).DirectAdjustEnabled {
+		logger.Error("Direct checkpoint adjustment is disabled, adjust through governance")
+		return common.ErrDirectAdjustDisabled(k.Codespace()).Result()
+	}
+
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if checkpointBuffer != nil {
 		logger.Error("checkpoint buffer exists", "error", err)
@@ -72,10 +85,17 @@ func handleMsgCheckpointAdjust(ctx sdk.Context, msg types.MsgCheckpointAdjust, k
 
 // handleMsgCheckpoint Validates checkpoint transaction
 func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	timeStamp := uint64(ctx.BlockTime().Unix())
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx)
 
 	//
 	// Check checkpoint buffer
@@ -88,6 +108,12 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		if checkpointBuffer.TimeStamp == 0 || ((timeStamp > checkpointBuffer.TimeStamp) && timeStamp-checkpointBuffer.TimeStamp >= checkpointBufferTime) {
 			logger.Debug("Checkpoint has been timed out. Flushing buffer.", "checkpointTimestamp", timeStamp, "prevCheckpointTimestamp", checkpointBuffer.TimeStamp)
 			k.FlushCheckpointBuffer(ctx)
+
+			if k.IsIndexEnabled(ctx) {
+				activity := k.GetActivity(ctx)
+				activity.BufferTimeouts++
+				k.SetActivity(ctx, activity)
+			}
 		} else {
 			expiryTime := checkpointBuffer.TimeStamp + checkpointBufferTime
 			logger.Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
@@ -189,6 +215,13 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 
 // handleMsgCheckpointAck Validates if checkpoint submitted on chain is valid
 func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	// Get last checkpoint from buffer
@@ -231,13 +264,20 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 
 // Handles checkpoint no-ack transaction
 func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k Keeper) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	// Get current block time
 	currentTime := ctx.BlockTime()
 
 	// Get buffer time from params
-	bufferTime := k.GetParams(ctx).CheckpointBufferTime
+	bufferTime := k.GetChainParams(ctx).CheckpointBufferTime
 
 	// Fetch last checkpoint from store
 	// TODO figure out how to handle this error
@@ -264,6 +304,12 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	k.SetLastNoAck(ctx, newLastNoAck)
 	logger.Debug("Last No-ACK time set", "lastNoAck", newLastNoAck)
 
+	if k.IsIndexEnabled(ctx) {
+		activity := k.GetActivity(ctx)
+		activity.NoAcks++
+		k.SetActivity(ctx, activity)
+	}
+
 	//
 	// Update to new proposer
 	//
@@ -15,6 +15,7 @@ import (
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
@@ -62,7 +63,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpoint() {
 	topupKeeper := app.TopupKeeper
 	start := uint64(0)
 	maxSize := uint64(256)
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 	params := keeper.GetParams(ctx)
 	dividendAccount := hmTypes.DividendAccount{
 		User:      hmTypes.HexToHeimdallAddress("123"),
@@ -242,6 +243,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAfterBufferTimeOut() {
 	// send new checkpoint which should replace old one
 	got := suite.SendCheckpoint(header)
 	require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
+	require.Equal(t, uint64(1), keeper.GetActivity(suite.ctx).BufferTimeouts, "Buffer timeout should be recorded")
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointExistInBuffer() {
@@ -327,6 +329,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 		result := suite.handler(ctx, msgCheckpointAck)
 		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
@@ -344,6 +347,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
@@ -360,6 +364,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("9887"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		got := suite.handler(ctx, msgCheckpointAck)
@@ -408,6 +413,7 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAck() {
 	require.True(t, result.IsOK(), "expected send-NoAck to be ok, got %v", got)
 	ackCount := keeper.GetACKCount(ctx)
 	require.Equal(t, uint64(0), uint64(ackCount), "Should not update state")
+	require.Equal(t, uint64(1), keeper.GetActivity(suite.ctx).NoAcks, "No-ack should be recorded")
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointNoAckBeforeBufferTimeout() {
@@ -456,7 +462,7 @@ func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sd
 	require.NoError(t, err)
 	accountRoot := hmTypes.BytesToHeimdallHash(accRootHash)
 
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 	// create checkpoint msg
 	msgCheckpoint := types.NewMsgCheckpointBlock(
 		header.Proposer,
@@ -479,7 +485,7 @@ func (suite *HandlerTestSuite) SendCheckpoint(header hmTypes.Checkpoint) (res sd
 
 func (suite *HandlerTestSuite) SendNoAck() (res sdk.Result) {
 	_, _, ctx := suite.T(), suite.app, suite.ctx
-	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"))
+	msgNoAck := types.NewMsgCheckpointNoAck(hmTypes.HexToHeimdallAddress("123"), "")
 
 	result := suite.handler(ctx, msgNoAck)
 	sideResult := suite.sideHandler(ctx, msgNoAck)
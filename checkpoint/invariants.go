@@ -0,0 +1,65 @@
+package checkpoint
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ack-count", ACKCountInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ACKCountInvariant(keeper)(ctx)
+	}
+}
+
+// ACKCountInvariant checks, for the primary bor chain and every child chain, that the ack count
+// matches the number of stored checkpoints and that every acknowledged checkpoint number has a checkpoint
+func ACKCountInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		borChainIDs := []string{""}
+		for _, childChain := range keeper.ck.GetParams(ctx).ChildChains {
+			borChainIDs = append(borChainIDs, childChain.BorChainID)
+		}
+
+		var msg strings.Builder
+		broken := false
+		for _, borChainID := range borChainIDs {
+			chainKeeper, ok := keeper.ForChain(ctx, borChainID)
+			if !ok {
+				continue
+			}
+
+			ackCount := chainKeeper.GetACKCount(ctx)
+			checkpoints := uint64(len(chainKeeper.GetCheckpoints(ctx)))
+
+			var missing []uint64
+			for number := uint64(1); number <= ackCount; number++ {
+				if _, err := chainKeeper.GetCheckpointByNumber(ctx, number); err != nil {
+					missing = append(missing, number)
+				}
+			}
+
+			if ackCount != checkpoints || len(missing) != 0 {
+				broken = true
+			}
+
+			name := borChainID
+			if name == "" {
+				name = "primary"
+			}
+			msg.WriteString(fmt.Sprintf("	bor chain %s: ack count: %d, stored checkpoints: %d, missing checkpoints: %v\n",
+				name, ackCount, checkpoints, missing))
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "ack count", msg.String()), broken
+	}
+}
@@ -1,10 +1,14 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -23,6 +27,16 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	EndBlockIndexKey    = []byte{0x15} // prefix key to store checkpoint number by end block
+	AckTxIndexKey       = []byte{0x16} // prefix key to store checkpoint number by ack tx hash and log index
+	BufferApprovalKey   = []byte{0x17} // key to store approval of checkpoint in buffer
+	ApprovalKey         = []byte{0x18} // prefix key to store approval by checkpoint number
+	ChildChainKey       = []byte{0x19} // prefix key to store checkpoint sequences of child chains
+	ActivityKey         = []byte{0x1A} // key to store buffer timeouts and no-acks since last ack
+	MetadataKey         = []byte{0x1B} // prefix key to store checkpoint metadata by number
+	AdjustmentKey       = []byte{0x1C} // prefix key to store checkpoint adjustments by id
+	ApprovedAdjustKey   = []byte{0x1D} // prefix key to store adjust proposals approved by governance by checkpoint number
+	IndexEnabledKey     = []byte{0x1E} // key to mark checkpoint indices are written
 )
 
 // ModuleCommunicator manages different module interaction
@@ -45,6 +59,9 @@ type Keeper struct {
 
 	// module communicator
 	moduleCommunicator ModuleCommunicator
+
+	// bor chain of the checkpoint sequence, empty for primary bor chain
+	borChainID string
 }
 
 // NewKeeper create new keeper
@@ -69,6 +86,45 @@ func NewKeeper(
 	return keeper
 }
 
+// ForChain returns a keeper for the checkpoint sequence of a bor chain. Empty bor chain id and the id of
+// the primary bor chain select the primary sequence. Returns false for chains not registered as child chain.
+// Before child chains are enabled every bor chain id selects the primary sequence, as it did before.
+func (k Keeper) ForChain(ctx sdk.Context, borChainID string) (Keeper, bool) {
+	k.borChainID = ""
+
+	if !k.ck.IsChildChainsEnabled(ctx) {
+		return k, true
+	}
+
+	chainmanagerParams := k.ck.GetParams(ctx)
+	if borChainID == "" || borChainID == chainmanagerParams.ChainParams.BorChainID {
+		return k, true
+	}
+
+	if _, ok := chainmanagerParams.GetChildChain(borChainID); !ok {
+		return k, false
+	}
+
+	k.borChainID = borChainID
+	return k, true
+}
+
+// BorChainID returns the child chain of the keeper, empty for primary bor chain
+func (k Keeper) BorChainID() string {
+	return k.borChainID
+}
+
+// store returns the store of the checkpoint sequence. The sequence of the primary bor chain
+// is stored at the root of the module store, child chains under their own prefix.
+func (k Keeper) store(ctx sdk.Context) sdk.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	if k.borChainID == "" {
+		return store
+	}
+
+	return prefix.NewStore(store, GetChildChainKey(k.borChainID))
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
@@ -101,7 +157,7 @@ func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, checkpoint hmTypes.Checkpo
 
 // addCheckpoint adds checkpoint to store
 func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.Checkpoint) error {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// create Checkpoint block and marshall
 	out, err := k.cdc.MarshalBinaryBare(checkpoint)
@@ -118,7 +174,7 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, checkpoint hmTypes.C
 
 // GetCheckpointByNumber to get checkpoint by checkpoint number
 func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	checkpointKey := GetCheckpointKey(number)
 	var _checkpoint hmTypes.Checkpoint
 
@@ -136,7 +192,7 @@ func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.
 
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// create headers
 	var checkpoints []hmTypes.Checkpoint
@@ -160,9 +216,41 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return checkpoints, nil
 }
 
+// GetCheckpointByBlock returns the checkpoint covering the bor block and its number.
+// It looks up the end block index and falls back to a binary search over the block
+// ranges of stored checkpoints 1..TotalACKs if the block is not indexed.
+func (k *Keeper) GetCheckpointByBlock(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.Checkpoint, error) {
+	// use end block index if the block is indexed
+	if number, ok := k.GetCheckpointNumberByBlock(ctx, blockNumber); ok {
+		if checkpoint, err := k.GetCheckpointByNumber(ctx, number); err == nil && checkpoint.StartBlock <= blockNumber {
+			return number, checkpoint, nil
+		}
+	}
+
+	low, high := uint64(1), k.GetACKCount(ctx)
+	for low <= high {
+		number := low + (high-low)/2
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return 0, checkpoint, err
+		}
+
+		switch {
+		case blockNumber < checkpoint.StartBlock:
+			high = number - 1
+		case blockNumber > checkpoint.EndBlock:
+			low = number + 1
+		default:
+			return number, checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.Checkpoint{}, fmt.Errorf("no checkpoint found for block %v", blockNumber)
+}
+
 // GetLastCheckpoint gets last checkpoint, checkpoint number = TotalACKs
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	acksCount := k.GetACKCount(ctx)
 
 	lastCheckpointKey := acksCount
@@ -191,21 +279,57 @@ func GetCheckpointKey(checkpointNumber uint64) []byte {
 	return append(CheckpointKey, checkpointNumberBytes...)
 }
 
+// GetEndBlockIndexKey appends prefix to end block
+func GetEndBlockIndexKey(endBlock uint64) []byte {
+	return append(EndBlockIndexKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetChildChainKey appends prefix to length prefixed bor chain id
+func GetChildChainKey(borChainID string) []byte {
+	return append(append(ChildChainKey, byte(len(borChainID))), borChainID...)
+}
+
+// GetApprovalKey appends prefix to checkpoint number
+func GetApprovalKey(number uint64) []byte {
+	return append(ApprovalKey, sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetMetadataKey appends prefix to checkpoint number
+func GetMetadataKey(number uint64) []byte {
+	return append(MetadataKey, sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetAdjustmentKey appends prefix to adjustment id
+func GetAdjustmentKey(id uint64) []byte {
+	return append(AdjustmentKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetApprovedAdjustKey appends prefix to checkpoint number
+func GetApprovedAdjustKey(number uint64) []byte {
+	return append(ApprovedAdjustKey, sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetAckTxIndexKey appends prefix to ack tx hash and log index
+func GetAckTxIndexKey(txHash hmTypes.HeimdallHash, logIndex uint64) []byte {
+	return append(append(AckTxIndexKey, txHash.Bytes()...), sdk.Uint64ToBigEndian(logIndex)...)
+}
+
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	return store.Has(key)
 }
 
 // FlushCheckpointBuffer flushes Checkpoint Buffer
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	store.Delete(BufferCheckpointKey)
+	store.Delete(BufferApprovalKey)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
 func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// checkpoint block header
 	var checkpoint hmTypes.Checkpoint
@@ -221,7 +345,7 @@ func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.Checkpoint,
 
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// convert timestamp to bytes
 	value := []byte(strconv.FormatUint(timestamp, 10))
 	// set no-ack
@@ -230,7 +354,7 @@ func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
 
 // GetLastNoAck returns last no ack
 func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if ack count is there
 	if store.Has(LastNoACKKey) {
 		// get current ACK count
@@ -244,7 +368,7 @@ func (k *Keeper) GetLastNoAck(ctx sdk.Context) uint64 {
 
 // GetCheckpoints get checkpoint all checkpoints
 func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// get checkpoint header iterator
 	iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
 	defer iterator.Close()
@@ -268,7 +392,7 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []hmTypes.Checkpoint {
 
 // GetACKCount returns current ACK count
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 	// check if ack count is there
 	if store.Has(ACKCountKey) {
 		// get current ACK count
@@ -285,7 +409,7 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 
 // UpdateACKCountWithValue updates ACK with value
 func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
@@ -296,7 +420,7 @@ func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
 
 // UpdateACKCount updates ACK count by 1
 func (k Keeper) UpdateACKCount(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.store(ctx)
 
 	// get current ACK Count
 	ACKCount := k.GetACKCount(ctx)
@@ -308,6 +432,398 @@ func (k Keeper) UpdateACKCount(ctx sdk.Context) {
 	store.Set(ACKCountKey, ACKs)
 }
 
+//
+// Checkpoint indices
+//
+
+// IsIndexEnabled returns true once checkpoint indices are written, which starts at genesis or
+// with checkpoint index upgrade. The mark is shared by all checkpoint sequences.
+func (k Keeper) IsIndexEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(IndexEnabledKey)
+}
+
+// EnableIndex starts writing checkpoint indices
+func (k Keeper) EnableIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(IndexEnabledKey, DefaultValue)
+}
+
+// SetEndBlockIndex indexes checkpoint number by its end block
+func (k Keeper) SetEndBlockIndex(ctx sdk.Context, endBlock uint64, number uint64) {
+	store := k.store(ctx)
+	store.Set(GetEndBlockIndexKey(endBlock), []byte(strconv.FormatUint(number, 10)))
+}
+
+// GetCheckpointNumberByBlock returns the number of the checkpoint with the lowest end block not below block number
+func (k Keeper) GetCheckpointNumberByBlock(ctx sdk.Context, blockNumber uint64) (uint64, bool) {
+	store := k.store(ctx)
+	iterator := store.Iterator(GetEndBlockIndexKey(blockNumber), sdk.PrefixEndBytes(EndBlockIndexKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	number, err := strconv.ParseUint(string(iterator.Value()), 10, 64)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to parse checkpoint number from end block index", "error", err)
+		return 0, false
+	}
+
+	return number, true
+}
+
+// SetAckTxIndex indexes checkpoint number by the hash and log index of its ack tx on rootchain
+func (k Keeper) SetAckTxIndex(ctx sdk.Context, ackTx types.AckTx) {
+	store := k.store(ctx)
+	store.Set(GetAckTxIndexKey(ackTx.TxHash, ackTx.LogIndex), []byte(strconv.FormatUint(ackTx.Number, 10)))
+}
+
+// GetCheckpointNumberByAckTx returns the number of the checkpoint acked by the rootchain tx hash and log index
+func (k Keeper) GetCheckpointNumberByAckTx(ctx sdk.Context, txHash hmTypes.HeimdallHash, logIndex uint64) (uint64, bool) {
+	store := k.store(ctx)
+	key := GetAckTxIndexKey(txHash, logIndex)
+	if !store.Has(key) {
+		return 0, false
+	}
+
+	number, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to parse checkpoint number from ack tx index", "error", err)
+		return 0, false
+	}
+
+	return number, true
+}
+
+// GetAckTxs returns all indexed ack txs
+func (k Keeper) GetAckTxs(ctx sdk.Context) (ackTxs []types.AckTx) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, AckTxIndexKey)
+	defer iterator.Close()
+
+	hashLength := len(hmTypes.ZeroHeimdallHash)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(AckTxIndexKey):]
+		number, err := strconv.ParseUint(string(iterator.Value()), 10, 64)
+		if err != nil || len(key) != hashLength+8 {
+			k.Logger(ctx).Error("Invalid ack tx index entry", "key", iterator.Key())
+			continue
+		}
+
+		ackTxs = append(ackTxs, types.NewAckTx(
+			number,
+			hmTypes.BytesToHeimdallHash(key[:hashLength]),
+			binary.BigEndian.Uint64(key[hashLength:]),
+		))
+	}
+
+	return ackTxs
+}
+
+// BuildEndBlockIndex indexes all stored checkpoints by end block. Ack txs of checkpoints
+// are not part of the stored checkpoints, so only acks handled later are indexed by ack tx.
+func (k Keeper) BuildEndBlockIndex(ctx sdk.Context) {
+	ackCount := k.GetACKCount(ctx)
+	for number := uint64(1); number <= ackCount; number++ {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			k.Logger(ctx).Error("Unable to index checkpoint", "checkpointNumber", number, "error", err)
+			continue
+		}
+
+		k.SetEndBlockIndex(ctx, checkpoint.EndBlock, number)
+	}
+
+	k.Logger(ctx).Info("Built checkpoint end block index", "checkpoints", ackCount)
+}
+
+//
+// Checkpoint approvals
+//
+
+// SetBufferApproval sets approval of checkpoint in buffer, it is removed when buffer is flushed
+func (k Keeper) SetBufferApproval(ctx sdk.Context, approval types.Approval) {
+	store := k.store(ctx)
+	store.Set(BufferApprovalKey, k.cdc.MustMarshalBinaryBare(approval))
+}
+
+// GetBufferApproval returns approval of checkpoint in buffer
+func (k Keeper) GetBufferApproval(ctx sdk.Context) (approval types.Approval, found bool) {
+	store := k.store(ctx)
+	if !store.Has(BufferApprovalKey) {
+		return approval, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(store.Get(BufferApprovalKey), &approval)
+	return approval, true
+}
+
+// SetApproval sets approval of an acked checkpoint
+func (k Keeper) SetApproval(ctx sdk.Context, number uint64, approval types.Approval) {
+	store := k.store(ctx)
+	store.Set(GetApprovalKey(number), k.cdc.MustMarshalBinaryBare(approval))
+}
+
+// GetApproval returns approval of an acked checkpoint
+func (k Keeper) GetApproval(ctx sdk.Context, number uint64) (approval types.Approval, found bool) {
+	store := k.store(ctx)
+	key := GetApprovalKey(number)
+	if !store.Has(key) {
+		return approval, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &approval)
+	return approval, true
+}
+
+// GetApprovals returns approvals of all acked checkpoints
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []types.ApprovalWithNumber) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, ApprovalKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.Approval
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &approval)
+		approvals = append(approvals, types.ApprovalWithNumber{
+			Number:   binary.BigEndian.Uint64(iterator.Key()[len(ApprovalKey):]),
+			Approval: approval,
+		})
+	}
+
+	return approvals
+}
+
+//
+// Checkpoint adjustments
+//
+
+// AdjustCheckpoint replaces an acked checkpoint with the adjusted one, moves its end block index
+// and records the adjustment with its source in the audit trail
+func (k Keeper) AdjustCheckpoint(ctx sdk.Context, number uint64, adjusted hmTypes.Checkpoint, adjustment types.Adjustment) (types.Adjustment, error) {
+	original, err := k.GetCheckpointByNumber(ctx, number)
+	if err != nil {
+		return adjustment, err
+	}
+
+	if err := k.AddCheckpoint(ctx, number, adjusted); err != nil {
+		return adjustment, err
+	}
+
+	// end block index
+	if k.IsIndexEnabled(ctx) {
+		store := k.store(ctx)
+		store.Delete(GetEndBlockIndexKey(original.EndBlock))
+		k.SetEndBlockIndex(ctx, adjusted.EndBlock, number)
+	}
+
+	// audit trail
+	adjustment.ID = k.nextAdjustmentID(ctx)
+	adjustment.Number = number
+	adjustment.Original = original
+	adjustment.Adjusted = adjusted
+	adjustment.Height = ctx.BlockHeight()
+	k.SetAdjustment(ctx, adjustment)
+
+	return adjustment, nil
+}
+
+// SetAdjustment sets a checkpoint adjustment
+func (k Keeper) SetAdjustment(ctx sdk.Context, adjustment types.Adjustment) {
+	store := k.store(ctx)
+	store.Set(GetAdjustmentKey(adjustment.ID), k.cdc.MustMarshalBinaryBare(adjustment))
+}
+
+// GetAdjustments returns all checkpoint adjustments ordered by id
+func (k Keeper) GetAdjustments(ctx sdk.Context) (adjustments []types.Adjustment) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, AdjustmentKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var adjustment types.Adjustment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &adjustment)
+		adjustments = append(adjustments, adjustment)
+	}
+
+	return adjustments
+}
+
+// SetApprovedAdjust sets the adjust proposal approved for a checkpoint, replacing an earlier one
+func (k Keeper) SetApprovedAdjust(ctx sdk.Context, proposal types.CheckpointAdjustProposal) {
+	store := k.store(ctx)
+	store.Set(GetApprovedAdjustKey(proposal.HeaderIndex), k.cdc.MustMarshalBinaryBare(proposal))
+}
+
+// GetApprovedAdjust returns the adjust proposal approved for a checkpoint
+func (k Keeper) GetApprovedAdjust(ctx sdk.Context, number uint64) (proposal types.CheckpointAdjustProposal, found bool) {
+	store := k.store(ctx)
+	bz := store.Get(GetApprovedAdjustKey(number))
+	if bz == nil {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// DeleteApprovedAdjust deletes the adjust proposal approved for a checkpoint
+func (k Keeper) DeleteApprovedAdjust(ctx sdk.Context, number uint64) {
+	store := k.store(ctx)
+	store.Delete(GetApprovedAdjustKey(number))
+}
+
+// GetApprovedAdjusts returns adjust proposals approved by governance which were not applied yet,
+// ordered by checkpoint number
+func (k Keeper) GetApprovedAdjusts(ctx sdk.Context) (proposals []types.CheckpointAdjustProposal) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, ApprovedAdjustKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.CheckpointAdjustProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
+
+// nextAdjustmentID returns the id following the id of the last adjustment
+func (k Keeper) nextAdjustmentID(ctx sdk.Context) uint64 {
+	store := k.store(ctx)
+	iterator := sdk.KVStoreReversePrefixIterator(store, AdjustmentKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(iterator.Key()[len(AdjustmentKey):]) + 1
+}
+
+//
+// Checkpoint analytics
+//
+
+// GetActivity returns the buffer timeouts and no-acks since the last ack
+func (k Keeper) GetActivity(ctx sdk.Context) (activity types.CheckpointActivity) {
+	store := k.store(ctx)
+	if store.Has(ActivityKey) {
+		k.cdc.MustUnmarshalBinaryBare(store.Get(ActivityKey), &activity)
+	}
+
+	return activity
+}
+
+// SetActivity sets the buffer timeouts and no-acks since the last ack
+func (k Keeper) SetActivity(ctx sdk.Context, activity types.CheckpointActivity) {
+	store := k.store(ctx)
+	store.Set(ActivityKey, k.cdc.MustMarshalBinaryBare(activity))
+}
+
+// ResetActivity resets the buffer timeouts and no-acks, it is called on ack
+func (k Keeper) ResetActivity(ctx sdk.Context) {
+	store := k.store(ctx)
+	store.Delete(ActivityKey)
+}
+
+// SetMetadata sets metadata of an acked checkpoint
+func (k Keeper) SetMetadata(ctx sdk.Context, metadata types.CheckpointMetadata) {
+	store := k.store(ctx)
+	store.Set(GetMetadataKey(metadata.Number), k.cdc.MustMarshalBinaryBare(metadata))
+}
+
+// GetMetadata returns metadata of an acked checkpoint
+func (k Keeper) GetMetadata(ctx sdk.Context, number uint64) (metadata types.CheckpointMetadata, found bool) {
+	store := k.store(ctx)
+	key := GetMetadataKey(number)
+	if !store.Has(key) {
+		return metadata, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &metadata)
+	return metadata, true
+}
+
+// GetAllMetadata returns metadata of all acked checkpoints
+func (k Keeper) GetAllMetadata(ctx sdk.Context) (metadata []types.CheckpointMetadata) {
+	store := k.store(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, MetadataKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.CheckpointMetadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &m)
+		metadata = append(metadata, m)
+	}
+
+	return metadata
+}
+
+// GetCheckpointStats returns aggregate statistics of the acked checkpoints from number from to to
+func (k Keeper) GetCheckpointStats(ctx sdk.Context, from uint64, to uint64) (types.CheckpointStats, error) {
+	stats := types.CheckpointStats{From: from, To: to}
+	if from == 0 || from > to {
+		return stats, fmt.Errorf("invalid checkpoint window %v to %v", from, to)
+	}
+
+	// previous checkpoint for interval of the first one
+	var prevTime uint64
+	if prev, err := k.GetCheckpointByNumber(ctx, from-1); err == nil {
+		prevTime = prev.TimeStamp
+	}
+
+	proposers := make(map[string]int)
+	for number := from; number <= to; number++ {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			return stats, err
+		}
+
+		if number == from {
+			stats.StartTime = checkpoint.TimeStamp
+		}
+		stats.EndTime = checkpoint.TimeStamp
+
+		if prevTime != 0 && checkpoint.TimeStamp >= prevTime {
+			stats.Interval.Add(checkpoint.TimeStamp - prevTime)
+		}
+		prevTime = checkpoint.TimeStamp
+
+		stats.BlockSpan.Add(checkpoint.EndBlock - checkpoint.StartBlock + 1)
+
+		if i, ok := proposers[checkpoint.Proposer.String()]; ok {
+			stats.Proposers[i].Count++
+		} else {
+			proposers[checkpoint.Proposer.String()] = len(stats.Proposers)
+			stats.Proposers = append(stats.Proposers, types.ProposerStats{Proposer: checkpoint.Proposer, Count: 1})
+		}
+
+		metadata, found := k.GetMetadata(ctx, number)
+		if !found {
+			continue
+		}
+
+		stats.Recorded++
+		stats.BufferTime.Add(metadata.BufferTime)
+		stats.BufferTimeouts += metadata.BufferTimeouts
+		stats.NoAcks += metadata.NoAcks
+		if metadata.AfterNoAck {
+			stats.AfterNoAck++
+		}
+	}
+
+	// most active proposers first
+	sort.SliceStable(stats.Proposers, func(i, j int) bool {
+		return stats.Proposers[i].Count > stats.Proposers[j].Count
+	})
+
+	return stats, nil
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -316,8 +832,21 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. Child chain params are left empty on chains
+// which did not run the child-chains upgrade yet, direct adjustments stay allowed on chains
+// which did not run the checkpoint-adjust-proposal upgrade yet.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	k.paramSpace.Get(ctx, types.KeyCheckpointBufferTime, &params.CheckpointBufferTime)
+	k.paramSpace.Get(ctx, types.KeyAvgCheckpointLength, &params.AvgCheckpointLength)
+	k.paramSpace.Get(ctx, types.KeyMaxCheckpointLength, &params.MaxCheckpointLength)
+	k.paramSpace.Get(ctx, types.KeyChildBlockInterval, &params.ChildBlockInterval)
+	k.paramSpace.GetIfExists(ctx, types.KeyChildChains, &params.ChildChains)
+	params.DirectAdjustEnabled = types.DefaultDirectAdjustEnabled
+	k.paramSpace.GetIfExists(ctx, types.KeyDirectAdjustEnabled, &params.DirectAdjustEnabled)
 	return
 }
+
+// GetChainParams gets the checkpoint params of the keeper's bor chain
+func (k Keeper) GetChainParams(ctx sdk.Context) types.Params {
+	return k.GetParams(ctx).ForChain(k.borChainID)
+}
@@ -6,7 +6,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
 	"github.com/stretchr/testify/require"
@@ -38,7 +41,7 @@ func (suite *KeeperTestSuite) TestAddCheckpoint() {
 	rootHash := hmTypes.HexToHeimdallHash("123")
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
 	timestamp := uint64(time.Now().Unix())
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 
 	Checkpoint := hmTypes.CreateBlock(
 		startBlock,
@@ -78,7 +81,7 @@ func (suite *KeeperTestSuite) TestGetCheckpointList() {
 		rootHash := hmTypes.HexToHeimdallHash("123")
 		proposerAddress := hmTypes.HexToHeimdallAddress("123")
 		timestamp := uint64(time.Now().Unix()) + uint64(i)
-		borChainId := "1234"
+		borChainId := helper.DefaultBorChainID
 
 		Checkpoint := hmTypes.CreateBlock(
 			startBlock,
@@ -114,3 +117,200 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestGetCheckpointByBlock() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	_, _, err := keeper.GetCheckpointByBlock(ctx, 0)
+	require.Error(t, err, "No checkpoint should be found without acks")
+
+	count := uint64(5)
+	for i := uint64(0); i < count; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			i*256,
+			i*256+255,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			helper.DefaultBorChainID,
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, i+1, checkpoint)
+		require.NoError(t, err)
+	}
+	keeper.UpdateACKCountWithValue(ctx, count)
+
+	for _, blockNumber := range []uint64{0, 255, 256, 700, 1279} {
+		number, checkpoint, err := keeper.GetCheckpointByBlock(ctx, blockNumber)
+		require.NoError(t, err)
+		require.Equal(t, blockNumber/256+1, number)
+		require.True(t, checkpoint.StartBlock <= blockNumber && blockNumber <= checkpoint.EndBlock)
+	}
+
+	_, _, err = keeper.GetCheckpointByBlock(ctx, 1280)
+	require.Error(t, err, "Block after last checkpoint should not be found")
+}
+
+func (suite *KeeperTestSuite) TestCheckpointIndices() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	count := uint64(3)
+	for i := uint64(0); i < count; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			i*256,
+			i*256+255,
+			hmTypes.HexToHeimdallHash("123"),
+			hmTypes.HexToHeimdallAddress("123"),
+			helper.DefaultBorChainID,
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, i+1, checkpoint)
+		require.NoError(t, err)
+	}
+	keeper.UpdateACKCountWithValue(ctx, count)
+
+	_, ok := keeper.GetCheckpointNumberByBlock(ctx, 0)
+	require.False(t, ok, "Checkpoints should not be indexed before building index")
+
+	keeper.BuildEndBlockIndex(ctx)
+
+	for blockNumber, expected := range map[uint64]uint64{0: 1, 255: 1, 256: 2, 767: 3} {
+		number, ok := keeper.GetCheckpointNumberByBlock(ctx, blockNumber)
+		require.True(t, ok)
+		require.Equal(t, expected, number)
+	}
+
+	_, ok = keeper.GetCheckpointNumberByBlock(ctx, 768)
+	require.False(t, ok, "Block after last checkpoint should not be indexed")
+
+	ackTx := types.NewAckTx(2, hmTypes.HexToHeimdallHash("456"), 3)
+	keeper.SetAckTxIndex(ctx, ackTx)
+
+	number, ok := keeper.GetCheckpointNumberByAckTx(ctx, ackTx.TxHash, ackTx.LogIndex)
+	require.True(t, ok)
+	require.Equal(t, ackTx.Number, number)
+
+	_, ok = keeper.GetCheckpointNumberByAckTx(ctx, ackTx.TxHash, ackTx.LogIndex+1)
+	require.False(t, ok, "Ack tx with different log index should not be indexed")
+
+	require.Equal(t, []types.AckTx{ackTx}, keeper.GetAckTxs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestCheckpointApprovals() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	_, found := keeper.GetBufferApproval(ctx)
+	require.False(t, found)
+
+	approval := types.NewApproval(10, hmTypes.HexToHeimdallHash("123"))
+	keeper.SetBufferApproval(ctx, approval)
+
+	result, found := keeper.GetBufferApproval(ctx)
+	require.True(t, found)
+	require.Equal(t, approval, result)
+
+	keeper.SetApproval(ctx, 1, approval)
+	keeper.FlushCheckpointBuffer(ctx)
+
+	_, found = keeper.GetBufferApproval(ctx)
+	require.False(t, found, "Buffer approval should be flushed with buffer")
+
+	result, found = keeper.GetApproval(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, approval, result)
+
+	_, found = keeper.GetApproval(ctx, 2)
+	require.False(t, found)
+}
+
+func (suite *KeeperTestSuite) TestChildChainSequence() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	_, ok := keeper.ForChain(ctx, "5678")
+	require.False(t, ok, "Unregistered chain should be rejected")
+
+	// primary bor chain by empty or its own id
+	primaryKeeper, ok := keeper.ForChain(ctx, "")
+	require.True(t, ok)
+	require.Equal(t, "", primaryKeeper.BorChainID())
+	primaryKeeper, ok = keeper.ForChain(ctx, app.ChainKeeper.GetParams(ctx).ChainParams.BorChainID)
+	require.True(t, ok)
+	require.Equal(t, "", primaryKeeper.BorChainID())
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []chainmanagerTypes.ChildChain{
+		{BorChainID: "5678", RootChainAddress: hmTypes.HexToHeimdallAddress("456")},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	childKeeper, ok := keeper.ForChain(ctx, "5678")
+	require.True(t, ok)
+	require.Equal(t, "5678", childKeeper.BorChainID())
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "5678", uint64(time.Now().Unix()))
+	err := childKeeper.AddCheckpoint(ctx, 1, checkpoint)
+	require.NoError(t, err)
+	childKeeper.UpdateACKCount(ctx)
+
+	require.Equal(t, uint64(1), childKeeper.GetACKCount(ctx))
+	require.Equal(t, uint64(0), keeper.GetACKCount(ctx), "Primary ack count should not change")
+
+	result, err := childKeeper.GetCheckpointByNumber(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint, result)
+
+	_, err = keeper.GetCheckpointByNumber(ctx, 1)
+	require.Error(t, err, "Child checkpoint should not be in primary sequence")
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointStats() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	proposers := []hmTypes.HeimdallAddress{
+		hmTypes.HexToHeimdallAddress("123"),
+		hmTypes.HexToHeimdallAddress("456"),
+		hmTypes.HexToHeimdallAddress("456"),
+	}
+
+	// checkpoints proposed 100, 110 and 130 seconds, spanning 256, 128 and 64 blocks
+	timestamps := []uint64{100, 110, 130}
+	spans := []uint64{256, 128, 64}
+	startBlock := uint64(0)
+	for i := range proposers {
+		checkpoint := hmTypes.CreateBlock(startBlock, startBlock+spans[i]-1, hmTypes.HexToHeimdallHash("123"), proposers[i], "1234", timestamps[i])
+		err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint)
+		require.NoError(t, err)
+		startBlock += spans[i]
+	}
+
+	// metadata only for the last two checkpoints
+	keeper.SetMetadata(ctx, types.NewCheckpointMetadata(2, 10, 12, 115, hmTypes.HexToHeimdallHash("1"), 5, types.CheckpointActivity{}))
+	keeper.SetMetadata(ctx, types.NewCheckpointMetadata(3, 20, 22, 145, hmTypes.HexToHeimdallHash("2"), 15, types.CheckpointActivity{BufferTimeouts: 1, NoAcks: 2}))
+
+	stats, err := keeper.GetCheckpointStats(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), stats.StartTime)
+	require.Equal(t, uint64(130), stats.EndTime)
+	require.Equal(t, []uint64{10, 20, 15}, []uint64{stats.Interval.Min, stats.Interval.Max, stats.Interval.Avg})
+	require.Equal(t, []uint64{64, 256, 149}, []uint64{stats.BlockSpan.Min, stats.BlockSpan.Max, stats.BlockSpan.Avg})
+	require.Equal(t, []types.ProposerStats{{Proposer: proposers[1], Count: 2}, {Proposer: proposers[0], Count: 1}}, stats.Proposers)
+
+	require.Equal(t, uint64(2), stats.Recorded)
+	require.Equal(t, uint64(15), stats.BufferTime.Max)
+	require.Equal(t, uint64(10), stats.BufferTime.Avg)
+	require.Equal(t, uint64(1), stats.BufferTimeouts)
+	require.Equal(t, uint64(2), stats.NoAcks)
+	require.Equal(t, uint64(1), stats.AfterNoAck)
+
+	// interval of first checkpoint in window is from previous checkpoint
+	stats, err = keeper.GetCheckpointStats(ctx, 3, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), stats.Interval.Avg)
+
+	_, err = keeper.GetCheckpointStats(ctx, 2, 4)
+	require.Error(t, err, "Window beyond acked checkpoints should fail")
+}
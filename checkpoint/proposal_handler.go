@@ -0,0 +1,152 @@
+package checkpoint
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+)
+
+// NewProposalHandler creates a governance handler to manage checkpoint proposal types.
+func NewProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case types.CheckpointAdjustProposal:
+			return handleCheckpointAdjustProposal(ctx, k, c)
+		case types.CheckpointBufferFlushProposal:
+			return handleCheckpointBufferFlushProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized checkpoint proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleCheckpointAdjustProposal approves the adjustment of an acked checkpoint. Governance votes cannot
+// check the rootchain header, so the checkpoint is adjusted by a matching MsgCheckpointAdjust, which is
+// verified against the rootchain by side-tx. The adjusted checkpoint must keep its start block and stay
+// in continuity with the next checkpoint.
+func handleCheckpointAdjustProposal(ctx sdk.Context, k Keeper, p types.CheckpointAdjustProposal) sdk.Error {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, p.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", p.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace())
+	}
+
+	logger := k.Logger(ctx)
+
+	checkpointObj, err := k.GetCheckpointByNumber(ctx, p.HeaderIndex)
+	if err != nil {
+		logger.Error("Unable to get checkpoint from db", "error", err)
+		return common.ErrNoCheckpointFound(k.Codespace())
+	}
+
+	if checkpointObj.EndBlock == p.EndBlock && checkpointObj.StartBlock == p.StartBlock && bytes.Equal(checkpointObj.RootHash.Bytes(), p.RootHash.Bytes()) && bytes.Equal(checkpointObj.Proposer.Bytes(), p.Proposer.Bytes()) {
+		logger.Error("Same Checkpoint in DB")
+		return common.ErrCheckpointAlreadyExists(k.Codespace())
+	}
+
+	if checkpointObj.StartBlock != p.StartBlock {
+		logger.Error("Adjusted checkpoint must keep start block", "start", checkpointObj.StartBlock, "adjustedStart", p.StartBlock)
+		return common.ErrBadBlockDetails(k.Codespace())
+	}
+
+	if next, err := k.GetCheckpointByNumber(ctx, p.HeaderIndex+1); err == nil && next.StartBlock != p.EndBlock+1 {
+		logger.Error("Adjusted checkpoint not in continuity with next checkpoint", "nextStart", next.StartBlock, "adjustedEnd", p.EndBlock)
+		return common.ErrDisCountinuousCheckpoint(k.Codespace())
+	}
+
+	k.SetApprovedAdjust(ctx, p)
+	logger.Info("Checkpoint adjustment approved through governance", "checkpointNumber", p.HeaderIndex, "endBlock", p.EndBlock, "rootHash", p.RootHash)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCheckpointAdjustApprove,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(p.HeaderIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(p.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(p.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyProposer, p.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyRootHash, p.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyBorChainID, p.BorChainID),
+	))
+
+	return nil
+}
+
+// approvedAdjust returns the adjust proposal approving the adjustment of the msg, if any
+func approvedAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust) (types.CheckpointAdjustProposal, bool) {
+	proposal, found := k.GetApprovedAdjust(ctx, msg.HeaderIndex)
+	if !found || !proposal.Matches(msg) {
+		return proposal, false
+	}
+
+	return proposal, true
+}
+
+// handleCheckpointBufferFlushProposal flushes the checkpoint in buffer without waiting for buffer timeout,
+// if it is from the proposer and start block of the proposal. Flush event makes bridges propose the next
+// checkpoint right away.
+func handleCheckpointBufferFlushProposal(ctx sdk.Context, k Keeper, p types.CheckpointBufferFlushProposal) sdk.Error {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, p.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", p.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace())
+	}
+
+	logger := k.Logger(ctx)
+
+	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
+	if err != nil || checkpointBuffer == nil {
+		logger.Error("No checkpoint in buffer to flush", "proposer", p.Proposer, "startBlock", p.StartBlock)
+		return common.ErrNoCheckpointBufferFound(k.Codespace())
+	}
+
+	// buffer may have been replaced since submission, any checkpoint of the proposer at the start block is flushed
+	if checkpointBuffer.StartBlock != p.StartBlock || !bytes.Equal(checkpointBuffer.Proposer.Bytes(), p.Proposer.Bytes()) {
+		logger.Error("Checkpoint in buffer is not the one of proposal",
+			"proposer", checkpointBuffer.Proposer,
+			"startBlock", checkpointBuffer.StartBlock,
+			"endBlock", checkpointBuffer.EndBlock,
+		)
+		return common.ErrBadBlockDetails(k.Codespace())
+	}
+
+	k.FlushCheckpointBuffer(ctx)
+	logger.Info("Checkpoint buffer flushed through governance", "startBlock", checkpointBuffer.StartBlock, "endBlock", checkpointBuffer.EndBlock, "rootHash", checkpointBuffer.RootHash)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCheckpointFlush,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProposer, checkpointBuffer.Proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(checkpointBuffer.StartBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(checkpointBuffer.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootHash, checkpointBuffer.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyBorChainID, p.BorChainID),
+	))
+
+	return nil
+}
+
+// adjustmentEvent returns the event of an applied checkpoint adjustment
+func adjustmentEvent(adjustment types.Adjustment) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeCheckpointAdjust,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyAdjustment, strconv.FormatUint(adjustment.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeySource, adjustment.Source),
+		sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(adjustment.Number, 10)),
+		sdk.NewAttribute(types.AttributeKeyOriginalEnd, strconv.FormatUint(adjustment.Original.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyOriginalRoot, adjustment.Original.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(adjustment.Adjusted.EndBlock, 10)),
+		sdk.NewAttribute(types.AttributeKeyRootHash, adjustment.Adjusted.RootHash.String()),
+		sdk.NewAttribute(types.AttributeKeyProposer, adjustment.Adjusted.Proposer.String()),
+	)
+}
@@ -0,0 +1,145 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+type ProposalHandlerTestSuite struct {
+	suite.Suite
+
+	app     *app.HeimdallApp
+	ctx     sdk.Context
+	handler govTypes.Handler
+}
+
+func (suite *ProposalHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = createTestApp(false)
+	suite.handler = checkpoint.NewProposalHandler(suite.app.CheckpointKeeper)
+
+	// two acked checkpoints
+	keeper := suite.app.CheckpointKeeper
+	for i, end := range []uint64{255, 511} {
+		checkpoint := hmTypes.CreateBlock(end-255, end, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)
+		require.NoError(suite.T(), keeper.AddCheckpoint(suite.ctx, uint64(i)+1, checkpoint))
+		keeper.SetEndBlockIndex(suite.ctx, end, uint64(i)+1)
+		keeper.UpdateACKCount(suite.ctx)
+	}
+}
+
+func TestProposalHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalHandlerTestSuite))
+}
+
+func (suite *ProposalHandlerTestSuite) TestCheckpointAdjustProposal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	original, err := keeper.GetCheckpointByNumber(ctx, 2)
+	require.NoError(t, err)
+
+	proposal := types.NewCheckpointAdjustProposal("Adjust", "Match rootchain header", 2, hmTypes.HexToHeimdallAddress("456"), 256, 300, hmTypes.HexToHeimdallHash("456"), "")
+	require.NoError(t, proposal.ValidateBasic())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, suite.handler(ctx, proposal))
+
+	// proposal approves the adjustment, the checkpoint is adjusted by an adjust msg verified on rootchain
+	approved, found := keeper.GetApprovedAdjust(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, proposal, approved)
+
+	checkpoint, err := keeper.GetCheckpointByNumber(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, original, checkpoint)
+	require.Empty(t, keeper.GetAdjustments(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCheckpointAdjustApprove, events[0].Type)
+
+	// same checkpoint
+	same := types.NewCheckpointAdjustProposal("Adjust", "Same checkpoint", 2, original.Proposer, original.StartBlock, original.EndBlock, original.RootHash, "")
+	sdkErr := suite.handler(ctx, same)
+	require.Error(t, sdkErr)
+	require.Equal(t, common.CodeCheckpointAlreadyExists, sdkErr.Code())
+}
+
+func (suite *ProposalHandlerTestSuite) TestCheckpointAdjustProposalSafetyRails() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// start block must not change
+	proposal := types.NewCheckpointAdjustProposal("Adjust", "Move start", 2, hmTypes.HexToHeimdallAddress("456"), 100, 511, hmTypes.HexToHeimdallHash("456"), "")
+	err := suite.handler(ctx, proposal)
+	require.Error(t, err)
+	require.Equal(t, common.CodeInvalidBlockInput, err.Code())
+
+	// end block must be in continuity with next checkpoint
+	proposal = types.NewCheckpointAdjustProposal("Adjust", "Break continuity", 1, hmTypes.HexToHeimdallAddress("456"), 0, 200, hmTypes.HexToHeimdallHash("456"), "")
+	err = suite.handler(ctx, proposal)
+	require.Error(t, err)
+	require.Equal(t, common.CodeDisCountinuousCheckpoint, err.Code())
+
+	// unknown checkpoint
+	proposal = types.NewCheckpointAdjustProposal("Adjust", "Unknown", 3, hmTypes.HexToHeimdallAddress("456"), 512, 767, hmTypes.HexToHeimdallHash("456"), "")
+	err = suite.handler(ctx, proposal)
+	require.Error(t, err)
+	require.Equal(t, common.CodeNoCheckpoint, err.Code())
+
+	require.Empty(t, keeper.GetApprovedAdjusts(ctx))
+}
+
+func (suite *ProposalHandlerTestSuite) TestCheckpointBufferFlushProposal() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	// no checkpoint in buffer
+	proposal := types.NewCheckpointBufferFlushProposal("Flush", "Rootchain moved on", hmTypes.HexToHeimdallAddress("123"), 512, "")
+	require.NoError(t, proposal.ValidateBasic())
+	err := suite.handler(ctx, proposal)
+	require.Error(t, err)
+	require.Equal(t, common.CodeNoCheckpointBuffer, err.Code())
+
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, hmTypes.CreateBlock(512, 767, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 1)))
+	keeper.SetBufferApproval(ctx, types.NewApproval(10, hmTypes.HexToHeimdallHash("456")))
+
+	// checkpoint in buffer is not the one of proposal
+	for _, other := range []types.CheckpointBufferFlushProposal{
+		types.NewCheckpointBufferFlushProposal("Flush", "Other proposer", hmTypes.HexToHeimdallAddress("456"), 512, ""),
+		types.NewCheckpointBufferFlushProposal("Flush", "Other start block", hmTypes.HexToHeimdallAddress("123"), 768, ""),
+	} {
+		err = suite.handler(ctx, other)
+		require.Error(t, err)
+		require.Equal(t, common.CodeInvalidBlockInput, err.Code())
+	}
+
+	// checkpoint in buffer was proposed again with another end block and root hash since proposal was submitted
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, hmTypes.CreateBlock(512, 800, hmTypes.HexToHeimdallHash("789"), hmTypes.HexToHeimdallAddress("123"), "1234", 2)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, suite.handler(ctx, proposal))
+
+	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
+	require.Nil(t, bufferedCheckpoint)
+
+	_, found := keeper.GetBufferApproval(ctx)
+	require.False(t, found, "Approval should be flushed with buffer")
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCheckpointFlush, events[0].Type)
+
+	// acked checkpoints are untouched
+	require.Equal(t, uint64(2), keeper.GetACKCount(ctx))
+}
@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -32,14 +33,52 @@ func NewQuerier(keeper Keeper, stakingKeeper staking.Keeper, topupKeeper topup.K
 			return handleQueryCheckpointList(ctx, req, keeper)
 		case types.QueryNextCheckpoint:
 			return handleQueryNextCheckpoint(ctx, req, keeper, stakingKeeper, topupKeeper, contractCaller)
+		case types.QueryBlockProof:
+			return handleQueryBlockProof(ctx, req, keeper, contractCaller)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
+		case types.QueryCheckpointByAckTx:
+			return handleQueryCheckpointByAckTx(ctx, req, keeper)
+		case types.QueryApproval:
+			return handleQueryApproval(ctx, req, keeper)
+		case types.QueryMetadata:
+			return handleQueryMetadata(ctx, req, keeper)
+		case types.QueryStats:
+			return handleQueryStats(ctx, req, keeper)
+		case types.QueryAdjustments:
+			return handleQueryAdjustments(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
 	}
 }
 
+// chainKeeper returns the keeper of the bor chain given in query data, of the primary bor chain without query data
+func chainKeeper(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (Keeper, sdk.Error) {
+	if len(req.Data) == 0 {
+		return keeper, nil
+	}
+
+	var params types.QueryBorChainID
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return keeper, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	chainKeeper, ok := keeper.ForChain(ctx, params.BorChainID)
+	if !ok {
+		return keeper, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	return chainKeeper, nil
+}
+
 func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	bz, err := json.Marshal(keeper.GetParams(ctx))
+	keeper, sdkErr := chainKeeper(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := json.Marshal(keeper.GetChainParams(ctx))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -47,6 +86,11 @@ func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 }
 
 func handleQueryAckCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	keeper, sdkErr := chainKeeper(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
 	bz, err := json.Marshal(keeper.GetACKCount(ctx))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -60,6 +104,10 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
+	keeper, ok := keeper.ForChain(ctx, params.BorChainID)
+	if !ok {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
 	res, err := keeper.GetCheckpointByNumber(ctx, params.Number)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", params.Number), err.Error()))
@@ -73,6 +121,11 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 }
 
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	keeper, sdkErr := chainKeeper(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
 	res, err := keeper.GetCheckpointFromBuffer(ctx)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
@@ -82,7 +135,22 @@ func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper
 		return nil, common.ErrNoCheckpointBufferFound(keeper.Codespace())
 	}
 
-	bz, err := json.Marshal(res)
+	// expiry after which a new checkpoint replaces the buffer
+	expiryTime := res.TimeStamp + uint64(keeper.GetChainParams(ctx).CheckpointBufferTime.Seconds())
+	buffered := types.BufferedCheckpoint{
+		Checkpoint: *res,
+		ExpiryTime: expiryTime,
+		Expired:    res.TimeStamp == 0 || uint64(ctx.BlockTime().Unix()) >= expiryTime,
+		VoteStatus: types.VoteStatusUnknown,
+	}
+
+	if approval, found := keeper.GetBufferApproval(ctx); found {
+		buffered.ApprovalHeight = approval.Height
+		buffered.ApprovalTxHash = approval.TxHash
+		buffered.VoteStatus = types.VoteStatusApproved
+	}
+
+	bz, err := json.Marshal(buffered)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -90,6 +158,11 @@ func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper
 }
 
 func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	keeper, sdkErr := chainKeeper(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
 	// get last no ack
 	res := keeper.GetLastNoAck(ctx)
 	// sed result
@@ -118,17 +191,185 @@ func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBlock(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint for block %v", params.BlockNumber), err.Error()))
+	}
+
+	bz, err := json.Marshal(types.CheckpointWithNumber{Number: number, Checkpoint: checkpoint})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointByAckTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAckTxParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	number, ok := keeper.GetCheckpointNumberByAckTx(ctx, params.TxHash, params.LogIndex)
+	if !ok {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	checkpoint, err := keeper.GetCheckpointByNumber(ctx, number)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", number), err.Error()))
+	}
+
+	bz, err := json.Marshal(types.CheckpointWithNumber{Number: number, Checkpoint: checkpoint})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryApproval(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	keeper, ok := keeper.ForChain(ctx, params.BorChainID)
+	if !ok {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	// approval of next checkpoint is with checkpoint in buffer until ack
+	approval, found := keeper.GetApproval(ctx, params.Number)
+	if params.Number == keeper.GetACKCount(ctx)+1 {
+		approval, found = keeper.GetBufferApproval(ctx)
+	}
+
+	if !found {
+		return nil, sdk.ErrInternal(fmt.Sprintf("no approval found for checkpoint %v", params.Number))
+	}
+
+	bz, err := json.Marshal(approval)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryMetadata(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	keeper, ok := keeper.ForChain(ctx, params.BorChainID)
+	if !ok {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+	metadata, found := keeper.GetMetadata(ctx, params.Number)
+	if !found {
+		return nil, sdk.ErrInternal(fmt.Sprintf("no metadata found for checkpoint %v", params.Number))
+	}
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	keeper, ok := keeper.ForChain(ctx, params.BorChainID)
+	if !ok {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+
+	// latest checkpoints by default
+	ackCount := keeper.GetACKCount(ctx)
+	if ackCount == 0 {
+		return nil, common.ErrNoCheckpointFound(keeper.Codespace())
+	}
+
+	to := params.To
+	if to == 0 || to > ackCount {
+		to = ackCount
+	}
+
+	from := params.From
+	if from == 0 {
+		from = 1
+		if to > types.DefaultStatsWindow {
+			from = to - types.DefaultStatsWindow + 1
+		}
+	}
+
+	if from > to || to-from+1 > types.MaxStatsWindow {
+		return nil, sdk.ErrInternal(fmt.Sprintf("invalid checkpoint window %v to %v, window must be at most %v checkpoints", from, to, types.MaxStatsWindow))
+	}
+
+	stats, err := keeper.GetCheckpointStats(ctx, from, to)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not compute checkpoint stats", err.Error()))
+	}
+
+	bz, err := json.Marshal(stats)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAdjustments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	keeper, sdkErr := chainKeeper(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	adjustments := keeper.GetAdjustments(ctx)
+	if adjustments == nil {
+		adjustments = []types.Adjustment{}
+	}
+
+	bz, err := json.Marshal(adjustments)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, sk staking.Keeper, tk topup.Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
 	var queryParams types.QueryBorChainID
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse query params: %s", err))
 	}
 
+	// bor data of child chains is read from their own bor node
+	keeper, ok := keeper.ForChain(ctx, queryParams.BorChainID)
+	if !ok {
+		return nil, common.ErrInvalidBorChainID(keeper.Codespace())
+	}
+	if keeper.BorChainID() != "" {
+		childChainCaller, err := contractCaller.GetChildChainCaller(keeper.BorChainID())
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get contract caller of child chain", err.Error()))
+		}
+		contractCaller = childChainCaller
+	}
+
 	// get validator set
 	validatorSet := sk.GetValidatorSet(ctx)
 	proposer := validatorSet.GetProposer()
 	ackCount := keeper.GetACKCount(ctx)
-	params := keeper.GetParams(ctx)
+	params := keeper.GetChainParams(ctx)
 
 	var start uint64
 
@@ -168,3 +409,51 @@ func handleQueryNextCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+func handleQueryBlockProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, contractCaller helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QueryBorBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// find checkpoint covering the block
+	number, checkpoint, err := keeper.GetCheckpointByBlock(ctx, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint for block %v", params.BlockNumber), err.Error()))
+	}
+
+	headers, err := contractCaller.GetBlockHeaders(checkpoint.StartBlock, checkpoint.EndBlock, keeper.GetParams(ctx).MaxCheckpointLength)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch headers for start:%v end:%v", checkpoint.StartBlock, checkpoint.EndBlock), err.Error()))
+	}
+
+	index := params.BlockNumber - checkpoint.StartBlock
+	leaf, proof, root, err := types.GetHeadersProof(headers, index)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not generate block proof", err.Error()))
+	}
+
+	// headers must hash to the root hash of the checkpoint
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, sdk.ErrInternal(fmt.Sprintf("root hash of headers %v does not match checkpoint %v root hash %v", hmTypes.BytesToHeimdallHash(root), number, checkpoint.RootHash))
+	}
+
+	blockProof := types.BlockProof{
+		CheckpointNumber: number,
+		BlockNumber:      params.BlockNumber,
+		StartBlock:       checkpoint.StartBlock,
+		EndBlock:         checkpoint.EndBlock,
+		Index:            index,
+		Leaf:             hmTypes.BytesToHeimdallHash(leaf),
+		RootHash:         checkpoint.RootHash,
+	}
+	for _, sibling := range proof {
+		blockProof.Proof = append(blockProof.Proof, hmTypes.BytesToHeimdallHash(sibling))
+	}
+
+	bz, err := json.Marshal(blockProof)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
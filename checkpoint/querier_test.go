@@ -10,10 +10,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
@@ -117,7 +120,7 @@ func (suite *QuerierTestSuite) TestQueryCheckpoint() {
 	rootHash := hmTypes.HexToHeimdallHash("123")
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
 	timestamp := uint64(time.Now().Unix())
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 
 	checkpointBlock := hmTypes.CreateBlock(
 		startBlock,
@@ -158,7 +161,7 @@ func (suite *QuerierTestSuite) TestQueryCheckpointBuffer() {
 	rootHash := hmTypes.HexToHeimdallHash("123")
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
 	timestamp := uint64(time.Now().Unix())
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 
 	checkpointBlock := hmTypes.CreateBlock(
 		startBlock,
@@ -183,6 +186,23 @@ func (suite *QuerierTestSuite) TestQueryCheckpointBuffer() {
 	json.Unmarshal(res, &checkpoint)
 
 	require.Equal(t, checkpoint, checkpointBlock)
+
+	var buffered types.BufferedCheckpoint
+	require.NoError(t, json.Unmarshal(res, &buffered))
+	require.Equal(t, checkpointBlock, buffered.Checkpoint)
+	require.Equal(t, timestamp+uint64(app.CheckpointKeeper.GetParams(ctx).CheckpointBufferTime.Seconds()), buffered.ExpiryTime)
+	require.Equal(t, types.VoteStatusUnknown, buffered.VoteStatus)
+
+	// approval of buffered checkpoint
+	app.CheckpointKeeper.SetBufferApproval(ctx, types.NewApproval(10, hmTypes.HexToHeimdallHash("456")))
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &buffered))
+	require.Equal(t, int64(10), buffered.ApprovalHeight)
+	require.Equal(t, hmTypes.HexToHeimdallHash("456"), buffered.ApprovalTxHash)
+	require.Equal(t, types.VoteStatusApproved, buffered.VoteStatus)
+	require.Equal(t, uint64(ctx.BlockTime().Unix()) >= buffered.ExpiryTime, buffered.Expired)
 }
 
 func (suite *QuerierTestSuite) TestQueryLastNoAck() {
@@ -227,7 +247,7 @@ func (suite *QuerierTestSuite) TestQueryCheckpointList() {
 		rootHash := hmTypes.HexToHeimdallHash("123")
 		proposerAddress := hmTypes.HexToHeimdallAddress("123")
 		timestamp := uint64(time.Now().Unix()) + uint64(i)
-		borChainId := "1234"
+		borChainId := helper.DefaultBorChainID
 
 		checkpoint := hmTypes.CreateBlock(
 			startBlock,
@@ -275,7 +295,7 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	rootHash := hmTypes.HexToHeimdallHash("123")
 	proposerAddress := hmTypes.HexToHeimdallAddress("123")
 	timestamp := uint64(time.Now().Unix())
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 
 	checkpointBlock := hmTypes.CreateBlock(
 		startBlock,
@@ -308,3 +328,197 @@ func (suite *QuerierTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, actualRes.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, actualRes.BorChainID)
 }
+
+func (suite *QuerierTestSuite) TestQueryBlockProof() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	startBlock := uint64(256)
+	endBlock := uint64(261)
+
+	var headers []*ethTypes.Header
+	for i := startBlock; i <= endBlock; i++ {
+		headers = append(headers, &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        1000 + i,
+			TxHash:      ethcmn.BigToHash(new(big.Int).SetUint64(i)),
+			ReceiptHash: ethcmn.BigToHash(new(big.Int).SetUint64(i * 2)),
+		})
+	}
+
+	_, _, rootHash, err := types.GetHeadersProof(headers, 0)
+	require.NoError(t, err)
+
+	checkpointBlock := hmTypes.CreateBlock(
+		startBlock,
+		endBlock,
+		hmTypes.BytesToHeimdallHash(rootHash),
+		hmTypes.HexToHeimdallAddress("123"),
+		helper.DefaultBorChainID,
+		uint64(time.Now().Unix()),
+	)
+	app.CheckpointKeeper.AddCheckpoint(ctx, 1, hmTypes.CreateBlock(0, startBlock-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", uint64(time.Now().Unix())))
+	app.CheckpointKeeper.AddCheckpoint(ctx, 2, checkpointBlock)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2)
+
+	suite.contractCaller.On("GetBlockHeaders", startBlock, endBlock, uint64(1024)).Return(headers, nil)
+
+	path := []string{types.QueryBlockProof}
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlockProof)
+
+	blockNumber := uint64(259)
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(blockNumber)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var blockProof types.BlockProof
+	require.NoError(t, json.Unmarshal(res, &blockProof))
+	require.Equal(t, uint64(2), blockProof.CheckpointNumber)
+	require.Equal(t, blockNumber-startBlock, blockProof.Index)
+	require.Equal(t, checkpointBlock.RootHash, blockProof.RootHash)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(helper.GetHeaderLeaf(headers[blockProof.Index])), blockProof.Leaf)
+	require.Len(t, blockProof.Proof, 3)
+
+	var proof [][]byte
+	for _, sibling := range blockProof.Proof {
+		proof = append(proof, sibling.Bytes())
+	}
+	require.True(t, types.VerifyHeaderProof(blockProof.Leaf.Bytes(), blockProof.Index, proof, blockProof.RootHash.Bytes()))
+
+	// block not covered by any checkpoint
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(endBlock + 1))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func (suite *QuerierTestSuite) TestQueryCheckpointByIndex() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	checkpointBlock := hmTypes.CreateBlock(
+		0,
+		255,
+		hmTypes.HexToHeimdallHash("123"),
+		hmTypes.HexToHeimdallAddress("123"),
+		helper.DefaultBorChainID,
+		uint64(time.Now().Unix()),
+	)
+	app.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpointBlock)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+	app.CheckpointKeeper.SetEndBlockIndex(ctx, checkpointBlock.EndBlock, 1)
+
+	ackTx := types.NewAckTx(1, hmTypes.HexToHeimdallHash("456"), 2)
+	app.CheckpointKeeper.SetAckTxIndex(ctx, ackTx)
+
+	// by block
+	path := []string{types.QueryCheckpointByBlock}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(100)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var checkpoint types.CheckpointWithNumber
+	require.NoError(t, json.Unmarshal(res, &checkpoint))
+	require.Equal(t, uint64(1), checkpoint.Number)
+	require.Equal(t, checkpointBlock, checkpoint.Checkpoint)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryBorBlockParams(256))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// by ack tx
+	path = []string{types.QueryCheckpointByAckTx}
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByAckTx),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryAckTxParams(ackTx.TxHash, ackTx.LogIndex)),
+	}
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	checkpoint = types.CheckpointWithNumber{}
+	require.NoError(t, json.Unmarshal(res, &checkpoint))
+	require.Equal(t, uint64(1), checkpoint.Number)
+	require.Equal(t, checkpointBlock, checkpoint.Checkpoint)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryAckTxParams(ackTx.TxHash, 0))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func (suite *QuerierTestSuite) TestQueryApproval() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryApproval}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApproval),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCheckpointParams(1)),
+	}
+	res, err := querier(ctx, path, req)
+	require.Error(t, err, "Approval should not be found without checkpoint")
+	require.Nil(t, res)
+
+	// next checkpoint in buffer
+	bufferApproval := types.NewApproval(12, hmTypes.HexToHeimdallHash("456"))
+	app.CheckpointKeeper.SetBufferApproval(ctx, bufferApproval)
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var approval types.Approval
+	require.NoError(t, json.Unmarshal(res, &approval))
+	require.Equal(t, bufferApproval, approval)
+
+	// acked checkpoint
+	ackedApproval := types.NewApproval(10, hmTypes.HexToHeimdallHash("123"))
+	app.CheckpointKeeper.SetApproval(ctx, 1, ackedApproval)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	approval = types.Approval{}
+	require.NoError(t, json.Unmarshal(res, &approval))
+	require.Equal(t, ackedApproval, approval)
+}
+
+func (suite *QuerierTestSuite) TestQueryStats() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryStats}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStats),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryStatsParams(0, 0, "")),
+	}
+	res, err := querier(ctx, path, req)
+	require.Error(t, err, "Stats should not be found without checkpoint")
+	require.Nil(t, res)
+
+	for i := uint64(1); i <= 3; i++ {
+		checkpoint := hmTypes.CreateBlock((i-1)*256, i*256-1, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "1234", 100*i)
+		require.NoError(t, app.CheckpointKeeper.AddCheckpoint(ctx, i, checkpoint))
+		app.CheckpointKeeper.UpdateACKCount(ctx)
+	}
+
+	// latest checkpoints by default
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var stats types.CheckpointStats
+	require.NoError(t, json.Unmarshal(res, &stats))
+	require.Equal(t, uint64(1), stats.From)
+	require.Equal(t, uint64(3), stats.To)
+	require.Equal(t, uint64(100), stats.Interval.Avg)
+	require.Equal(t, uint64(256), stats.BlockSpan.Avg)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryStatsParams(3, 2, ""))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err, "Invalid window should fail")
+	require.Nil(t, res)
+}
@@ -36,9 +36,16 @@ func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.S
 
 // SideHandleMsgCheckpointAdjust handles MsgCheckpointAdjust message for external call
 func SideHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBorChainID)
+	}
+
 	logger := k.Logger(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
-	params := k.GetParams(ctx)
+	rootChainAddress := k.ck.GetParams(ctx).GetRootChainAddress(msg.BorChainID)
+	params := k.GetChainParams(ctx)
 
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if checkpointBuffer != nil {
@@ -52,7 +59,7 @@ func SideHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 		return common.ErrorSideTx(k.Codespace(), common.CodeNoCheckpoint)
 	}
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress.EthAddress())
 	if err != nil {
 		logger.Error("Unable to fetch rootchain contract instance", "error", err)
 		return common.ErrorSideTx(k.Codespace(), common.CodeOldCheckpoint)
@@ -80,13 +87,30 @@ func SideHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 
 // SideHandleMsgCheckpoint handles MsgCheckpoint message for external call
 func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBorChainID)
+	}
+
 	// get params
-	params := k.GetParams(ctx)
+	params := k.GetChainParams(ctx)
 	maticTxConfirmations := k.ck.GetParams(ctx).MaticchainTxConfirmations
 
 	// logger
 	logger := k.Logger(ctx)
 
+	// bor data of child chains is read from their own bor node
+	if k.BorChainID() != "" {
+		childChainCaller, err := contractCaller.GetChildChainCaller(k.BorChainID())
+		if err != nil {
+			logger.Error("Unable to get contract caller of child chain", "error", err, "borChainID", k.BorChainID())
+			return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBlockInput)
+		}
+		contractCaller = childChainCaller
+	}
+
 	// validate checkpoint
 	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash, params.MaxCheckpointLength, contractCaller, maticTxConfirmations)
 	if err != nil {
@@ -113,16 +137,23 @@ func SideHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 
 // SideHandleMsgCheckpointAck handles MsgCheckpointAck message for external call
 func SideHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidBorChainID)
+	}
+
 	logger := k.Logger(ctx)
 
-	params := k.GetParams(ctx)
-	chainParams := k.ck.GetParams(ctx).ChainParams
+	params := k.GetChainParams(ctx)
+	rootChainAddress := k.ck.GetParams(ctx).GetRootChainAddress(msg.BorChainID)
 
 	//
 	// Validate data from root chain
 	//
 
-	rootChainInstance, err := contractCaller.GetRootChainInstance(chainParams.RootChainAddress.EthAddress())
+	rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress.EthAddress())
 	if err != nil {
 		logger.Error("Unable to fetch rootchain contract instance", "error", err)
 		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidACK)
@@ -174,6 +205,13 @@ func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.P
 
 // PostHandleMsgCheckpointAdjust handles msg checkpoint adjust
 func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAdjust, sideTxResult abci.SideTxResultType, contractCaller helper.IContractCaller) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	// Skip handler if checkpoint-adjust is not approved
@@ -182,6 +220,13 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
+	// direct adjustments may have been disabled since the msg was handled
+	proposal, approved := approvedAdjust(ctx, k, msg)
+	if !approved && !k.GetParams(ctx).DirectAdjustEnabled {
+		logger.Error("Direct checkpoint adjustment is disabled, adjust through governance")
+		return common.ErrDirectAdjustDisabled(k.Codespace()).Result()
+	}
+
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if checkpointBuffer != nil {
 		logger.Error("checkpoint buffer exists", "error", err)
@@ -207,15 +252,30 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 
 	logger.Info("New checkpoint details: EndBlock -", checkpointObj.EndBlock, ", RootHash -", msg.RootHash, " Proposer -", checkpointObj.Proposer)
 
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := hmTypes.BytesToHeimdallHash(tmTypes.Tx(txBytes).Hash())
+
 	//
 	// Update checkpoint state
 	//
 
-	// Add checkpoint to store
-	if err := k.AddCheckpoint(ctx, msg.HeaderIndex, checkpointObj); err != nil {
+	source := types.NewMsgAdjustment(msg.From, hash)
+	if approved {
+		source = types.NewGovAdjustment(proposal.Title, msg.From, hash)
+	}
+
+	// Add checkpoint to store along with the adjustment
+	adjustment, err := k.AdjustCheckpoint(ctx, msg.HeaderIndex, checkpointObj, source)
+	if err != nil {
 		logger.Error("Error while adding checkpoint into store", "checkpointNumber", msg.HeaderIndex)
 		return sdk.ErrInternal("Failed to add checkpoint into store").Result()
 	}
+
+	// adjustments approved by an adjust proposal are applied once
+	if approved {
+		k.DeleteApprovedAdjust(ctx, msg.HeaderIndex)
+	}
 	logger.Debug("Checkpoint updated to store", "checkpointNumber", msg.HeaderIndex)
 
 	// Emit event for checkpoints
@@ -231,6 +291,7 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 		),
+		adjustmentEvent(adjustment),
 	})
 
 	return sdk.Result{
@@ -240,6 +301,13 @@ func PostHandleMsgCheckpointAdjust(ctx sdk.Context, k Keeper, msg types.MsgCheck
 
 // PostHandleMsgCheckpoint handles msg checkpoint
 func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint, sideTxResult abci.SideTxResultType) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	// Skip handler if checkpoint is not approved
@@ -284,7 +352,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 		logger.Debug("Checkpoint already exists in buffer")
 
 		// get checkpoint buffer time from params
-		params := k.GetParams(ctx)
+		params := k.GetChainParams(ctx)
 		expiryTime := checkpointBuffer.TimeStamp + uint64(params.CheckpointBufferTime.Seconds())
 
 		// return with error (ack is required)
@@ -313,6 +381,11 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
 
+	// Save approval, side-tx sigs are in the precommits of the current block
+	if k.IsIndexEnabled(ctx) {
+		k.SetBufferApproval(ctx, types.NewApproval(ctx.BlockHeight(), hmTypes.BytesToHeimdallHash(hash)))
+	}
+
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -326,6 +399,7 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
 			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 			sdk.NewAttribute(types.AttributeKeyAccountHash, msg.AccountRootHash.String()),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
 		),
 	})
 
@@ -336,6 +410,13 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k Keeper, msg types.MsgCheckpoint,
 
 // PostHandleMsgCheckpointAck handles msg checkpoint ack
 func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpointAck, sideTxResult abci.SideTxResultType) sdk.Result {
+	// checkpoint sequence of the bor chain
+	k, ok := k.ForChain(ctx, msg.BorChainID)
+	if !ok {
+		k.Logger(ctx).Error("Unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrInvalidBorChainID(k.Codespace()).Result()
+	}
+
 	logger := k.Logger(ctx)
 
 	// Skip handler if checkpoint-ack is not approved
@@ -389,6 +470,41 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	}
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number)
 
+	// Index checkpoint by end block and ack tx
+	if k.IsIndexEnabled(ctx) {
+		k.SetEndBlockIndex(ctx, checkpointObj.EndBlock, msg.Number)
+		k.SetAckTxIndex(ctx, types.NewAckTx(msg.Number, msg.TxHash, msg.LogIndex))
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// Keep approval of acked checkpoint
+	approval, found := k.GetBufferApproval(ctx)
+	if found && k.IsIndexEnabled(ctx) {
+		k.SetApproval(ctx, msg.Number, approval)
+	}
+
+	// Record checkpoint metadata along with buffer timeouts and no-acks since previous ack
+	if k.IsIndexEnabled(ctx) {
+		ackedTime := uint64(ctx.BlockTime().Unix())
+		var bufferTime uint64
+		if ackedTime > checkpointObj.TimeStamp {
+			bufferTime = ackedTime - checkpointObj.TimeStamp
+		}
+		k.SetMetadata(ctx, types.NewCheckpointMetadata(
+			msg.Number,
+			approval.Height,
+			ctx.BlockHeight(),
+			ackedTime,
+			hmTypes.BytesToHeimdallHash(hash),
+			bufferTime,
+			k.GetActivity(ctx),
+		))
+		k.ResetActivity(ctx)
+	}
+
 	// Flush buffer
 	k.FlushCheckpointBuffer(ctx)
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack")
@@ -397,12 +513,10 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k Keeper, msg types.MsgCheckpoi
 	k.UpdateACKCount(ctx)
 	logger.Info("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
 
-	// Increment accum (selects new proposer)
-	k.sk.IncrementAccum(ctx, 1)
-
-	// TX bytes
-	txBytes := ctx.TxBytes()
-	hash := tmTypes.Tx(txBytes).Hash()
+	// Increment accum (selects new proposer), proposer rotates on acks of primary bor chain only
+	if k.BorChainID() == "" {
+		k.sk.IncrementAccum(ctx, 1)
+	}
 
 	// Emit event for checkpoints
 	ctx.EventManager().EmitEvents(sdk.Events{
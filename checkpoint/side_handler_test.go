@@ -16,6 +16,7 @@ import (
 	"github.com/maticnetwork/heimdall/common"
 	errs "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -99,6 +100,141 @@ func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSuccess() {
 	require.Equal(t, responseCheckpoint.EndBlock, uint64(512))
 	require.Equal(t, responseCheckpoint.Proposer, hmTypes.HexToHeimdallAddress("456"))
 	require.Equal(t, responseCheckpoint.RootHash, hmTypes.HexToHeimdallHash("456"))
+
+	adjustments := keeper.GetAdjustments(ctx)
+	require.Len(t, adjustments, 1)
+	require.Equal(t, types.AdjustmentSourceMsg, adjustments[0].Source)
+	require.Equal(t, checkpoint, adjustments[0].Original)
+	require.Equal(t, responseCheckpoint, adjustments[0].Adjusted)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustDisabled() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.DirectAdjustEnabled = false
+	keeper.SetParams(ctx, params)
+
+	checkpoint := hmTypes.CreateBlock(0, 256, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "testchainid", 1)
+	keeper.AddCheckpoint(ctx, 1, checkpoint)
+
+	checkpointAdjust := types.MsgCheckpointAdjust{
+		HeaderIndex: 1,
+		Proposer:    hmTypes.HexToHeimdallAddress("456"),
+		StartBlock:  0,
+		EndBlock:    512,
+		RootHash:    hmTypes.HexToHeimdallHash("456"),
+	}
+
+	result := suite.handler(ctx, checkpointAdjust)
+	require.False(t, result.IsOK(), "Direct adjust should be rejected")
+	require.Equal(t, common.CodeDirectAdjustDisabled, result.Code)
+
+	result = suite.postHandler(ctx, checkpointAdjust, abci.SideTxResultType_Yes)
+	require.False(t, result.IsOK(), "Direct adjust should be rejected")
+
+	responseCheckpoint, _ := keeper.GetCheckpointByNumber(ctx, 1)
+	require.Equal(t, checkpoint, responseCheckpoint)
+	require.Empty(t, keeper.GetAdjustments(ctx))
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustApproved() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.DirectAdjustEnabled = false
+	keeper.SetParams(ctx, params)
+
+	checkpoint := hmTypes.CreateBlock(0, 256, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "testchainid", 1)
+	keeper.AddCheckpoint(ctx, 1, checkpoint)
+
+	proposal := types.NewCheckpointAdjustProposal("Adjust", "Match rootchain header", 1, hmTypes.HexToHeimdallAddress("456"), 0, 512, hmTypes.HexToHeimdallHash("456"), "")
+	keeper.SetApprovedAdjust(ctx, proposal)
+
+	checkpointAdjust := types.MsgCheckpointAdjust{
+		HeaderIndex: 1,
+		Proposer:    hmTypes.HexToHeimdallAddress("456"),
+		From:        hmTypes.HexToHeimdallAddress("789"),
+		StartBlock:  0,
+		EndBlock:    512,
+		RootHash:    hmTypes.HexToHeimdallHash("456"),
+	}
+	rootchainInstance := &rootchain.Rootchain{}
+	suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
+	suite.contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything).Return(borCommon.HexToHash("456"), uint64(0), uint64(512), uint64(1), hmTypes.HexToHeimdallAddress("456"), nil)
+
+	// msg not matching the approved adjustment is rejected
+	unapproved := checkpointAdjust
+	unapproved.EndBlock = 511
+	result := suite.handler(ctx, unapproved)
+	require.Equal(t, common.CodeDirectAdjustDisabled, result.Code)
+
+	result = suite.handler(ctx, checkpointAdjust)
+	require.True(t, result.IsOK(), "Approved adjust should be accepted")
+
+	sideResult := suite.sideHandler(ctx, checkpointAdjust)
+	require.Equal(t, abci.SideTxResultType_Yes, sideResult.Result)
+
+	result = suite.postHandler(ctx, checkpointAdjust, sideResult.Result)
+	require.True(t, result.IsOK(), "Approved adjust should be applied")
+
+	responseCheckpoint, _ := keeper.GetCheckpointByNumber(ctx, 1)
+	require.Equal(t, uint64(512), responseCheckpoint.EndBlock)
+
+	adjustments := keeper.GetAdjustments(ctx)
+	require.Len(t, adjustments, 1)
+	require.Equal(t, types.AdjustmentSourceGov, adjustments[0].Source)
+	require.Equal(t, proposal.Title, adjustments[0].Proposal)
+	require.Equal(t, checkpointAdjust.From, adjustments[0].Sender)
+
+	// approval is applied once
+	_, found := keeper.GetApprovedAdjust(ctx, 1)
+	require.False(t, found)
+}
+
+func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustApprovedNotOnRootChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	params.DirectAdjustEnabled = false
+	keeper.SetParams(ctx, params)
+
+	checkpoint := hmTypes.CreateBlock(0, 256, hmTypes.HexToHeimdallHash("123"), hmTypes.HexToHeimdallAddress("123"), "testchainid", 1)
+	keeper.AddCheckpoint(ctx, 1, checkpoint)
+
+	proposal := types.NewCheckpointAdjustProposal("Adjust", "Not on rootchain", 1, hmTypes.HexToHeimdallAddress("456"), 0, 512, hmTypes.HexToHeimdallHash("456"), "")
+	keeper.SetApprovedAdjust(ctx, proposal)
+
+	checkpointAdjust := types.MsgCheckpointAdjust{
+		HeaderIndex: 1,
+		Proposer:    hmTypes.HexToHeimdallAddress("456"),
+		StartBlock:  0,
+		EndBlock:    512,
+		RootHash:    hmTypes.HexToHeimdallHash("456"),
+	}
+	rootchainInstance := &rootchain.Rootchain{}
+	suite.contractCaller.On("GetRootChainInstance", mock.Anything).Return(rootchainInstance, nil)
+	suite.contractCaller.On("GetHeaderInfo", mock.Anything, mock.Anything, mock.Anything).Return(borCommon.HexToHash("789"), uint64(0), uint64(300), uint64(1), hmTypes.HexToHeimdallAddress("789"), nil)
+
+	result := suite.handler(ctx, checkpointAdjust)
+	require.True(t, result.IsOK(), "Approved adjust should be accepted")
+
+	// approved adjustment not matching rootchain header gets no votes
+	sideResult := suite.sideHandler(ctx, checkpointAdjust)
+	require.Equal(t, uint32(common.CodeCheckpointAlreadyExists), sideResult.Code)
+
+	result = suite.postHandler(ctx, checkpointAdjust, abci.SideTxResultType_No)
+	require.False(t, result.IsOK())
+
+	responseCheckpoint, _ := keeper.GetCheckpointByNumber(ctx, 1)
+	require.Equal(t, checkpoint, responseCheckpoint)
+	require.Empty(t, keeper.GetAdjustments(ctx))
+
+	_, found := keeper.GetApprovedAdjust(ctx, 1)
+	require.True(t, found)
 }
 
 func (suite *HandlerTestSuite) TestHandleMsgCheckpointAdjustSameCheckpointAsRootChain() {
@@ -171,7 +307,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpoint() {
 
 	header, err := chSim.GenRandCheckpoint(start, maxSize, params.MaxCheckpointLength)
 	require.NoError(t, err)
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 	suite.Run("Success", func() {
 		suite.contractCaller = mocks.IContractCaller{}
 
@@ -265,6 +401,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 		rootchainInstance := &rootchain.Rootchain{}
 
@@ -289,6 +426,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgCheckpointAck() {
 			hmTypes.HexToHeimdallHash("123"),
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 		rootchainInstance := &rootchain.Rootchain{}
 
@@ -339,7 +477,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 	// add current proposer to header
 	header.Proposer = stakingKeeper.GetValidatorSet(ctx).Proposer.Signer
 
-	borChainId := "1234"
+	borChainId := helper.DefaultBorChainID
 	suite.Run("Failure", func() {
 		// create checkpoint msg
 		msgCheckpoint := types.NewMsgCheckpointBlock(
@@ -378,8 +516,12 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 		require.Equal(t, bufferedHeader.EndBlock, header.EndBlock)
 		require.Equal(t, bufferedHeader.RootHash, header.RootHash)
 		require.Equal(t, bufferedHeader.Proposer, header.Proposer)
-		require.Equal(t, bufferedHeader.BorChainID, header.BorChainID)
+		require.Equal(t, bufferedHeader.BorChainID, borChainId)
 		require.Empty(t, err, "Unable to set checkpoint from buffer, Error: %v", err)
+
+		approval, found := keeper.GetBufferApproval(ctx)
+		require.True(t, found, "Approval of buffered checkpoint should be set")
+		require.Equal(t, ctx.BlockHeight(), approval.Height)
 	})
 
 	suite.Run("Replay", func() {
@@ -424,6 +566,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_No)
@@ -440,7 +583,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.EndBlock,
 			header.RootHash,
 			header.RootHash,
-			"1234",
+			helper.DefaultBorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
@@ -455,6 +598,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -462,6 +606,23 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 
 		afterAckBufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 		require.Nil(t, afterAckBufferedCheckpoint)
+
+		number, ok := keeper.GetCheckpointNumberByBlock(ctx, header.EndBlock)
+		require.True(t, ok, "Checkpoint should be indexed by end block")
+		require.Equal(t, checkpointNumber, number)
+
+		number, ok = keeper.GetCheckpointNumberByAckTx(ctx, msgCheckpointAck.TxHash, msgCheckpointAck.LogIndex)
+		require.True(t, ok, "Checkpoint should be indexed by ack tx")
+		require.Equal(t, checkpointNumber, number)
+
+		approval, found := keeper.GetApproval(ctx, checkpointNumber)
+		require.True(t, found, "Approval should be kept for acked checkpoint")
+
+		metadata, found := keeper.GetMetadata(ctx, checkpointNumber)
+		require.True(t, found, "Metadata should be recorded for acked checkpoint")
+		require.Equal(t, approval.Height, metadata.ProposedHeight)
+		require.Equal(t, ctx.BlockHeight(), metadata.AckedHeight)
+		require.False(t, metadata.AfterNoAck)
 	})
 
 	suite.Run("Replay", func() {
@@ -474,6 +635,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		result := suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -493,7 +655,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header2.EndBlock,
 			header2.RootHash,
 			header2.RootHash,
-			"1234",
+			helper.DefaultBorChainID,
 		)
 
 		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
@@ -508,6 +670,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 			header2.RootHash,
 			hmTypes.HexToHeimdallHash("123123"),
 			uint64(1),
+			"",
 		)
 
 		result = suite.postHandler(ctx, msgCheckpointAck, abci.SideTxResultType_Yes)
@@ -517,3 +680,88 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAckChildChain() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.CheckpointKeeper
+
+	chainParams := app.ChainKeeper.GetParams(ctx)
+	chainParams.ChildChains = []cmTypes.ChildChain{
+		{BorChainID: "5678", RootChainAddress: hmTypes.HexToHeimdallAddress("456")},
+	}
+	app.ChainKeeper.SetParams(ctx, chainParams)
+
+	childKeeper, ok := keeper.ForChain(ctx, "5678")
+	require.True(t, ok)
+
+	params := keeper.GetParams(ctx)
+	header, _ := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	// generate proposer for validator set
+	chSim.LoadValidatorSet(2, t, app.StakingKeeper, ctx, false, 10)
+	app.StakingKeeper.IncrementAccum(ctx, 1)
+
+	newAck := func(borChainID string) types.MsgCheckpointAck {
+		return types.NewMsgCheckpointAck(
+			hmTypes.HexToHeimdallAddress("123"),
+			uint64(1),
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			hmTypes.HexToHeimdallHash("123123"),
+			uint64(1),
+			borChainID,
+		)
+	}
+
+	suite.Run("UnknownChain", func() {
+		result := suite.postHandler(ctx, newAck("9999"), abci.SideTxResultType_Yes)
+		require.False(t, result.IsOK())
+		require.Equal(t, common.CodeInvalidBorChainID, result.Code)
+	})
+
+	suite.Run("ChildChainKeepsProposer", func() {
+		msgCheckpoint := types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"5678",
+		)
+
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+
+		result = suite.postHandler(ctx, newAck("5678"), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+		require.Equal(t, uint64(1), childKeeper.GetACKCount(ctx))
+		require.Equal(t, uint64(0), keeper.GetACKCount(ctx))
+		require.Equal(t, validatorSet, app.StakingKeeper.GetValidatorSet(ctx), "child chain ack should not increment accum")
+	})
+
+	suite.Run("PrimaryChainRotatesProposer", func() {
+		msgCheckpoint := types.NewMsgCheckpointBlock(
+			header.Proposer,
+			header.StartBlock,
+			header.EndBlock,
+			header.RootHash,
+			header.RootHash,
+			"",
+		)
+
+		result := suite.postHandler(ctx, msgCheckpoint, abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-checkpoint to be ok, got %v", result)
+
+		validatorSet := app.StakingKeeper.GetValidatorSet(ctx)
+
+		result = suite.postHandler(ctx, newAck(""), abci.SideTxResultType_Yes)
+		require.True(t, result.IsOK(), "expected send-ack to be ok, got %v", result)
+
+		require.Equal(t, uint64(1), keeper.GetACKCount(ctx))
+		require.NotEqual(t, validatorSet, app.StakingKeeper.GetValidatorSet(ctx), "primary chain ack should increment accum")
+	})
+}
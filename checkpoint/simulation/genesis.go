@@ -42,6 +42,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		uint64(lastNoACK),
 		uint64(ackCount),
 		Checkpoints,
+		nil,
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 
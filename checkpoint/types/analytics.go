@@ -0,0 +1,104 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// DefaultStatsWindow is the number of latest checkpoints covered by stats if no window is given
+	DefaultStatsWindow = 100
+	// MaxStatsWindow is the max number of checkpoints covered by stats
+	MaxStatsWindow = 10000
+)
+
+// CheckpointActivity counts the buffer timeouts and no-acks since the last ack
+type CheckpointActivity struct {
+	BufferTimeouts uint64 `json:"buffer_timeouts"`
+	NoAcks         uint64 `json:"no_acks"`
+}
+
+// CheckpointMetadata is the lifecycle of an acked checkpoint on heimdall
+type CheckpointMetadata struct {
+	Number         uint64               `json:"number"`
+	ProposedHeight int64                `json:"proposed_height"` // height at which checkpoint was put into buffer
+	AckedHeight    int64                `json:"acked_height"`    // height at which checkpoint was acked
+	AckedTime      uint64               `json:"acked_time"`      // block time of ack
+	AckTxHash      hmTypes.HeimdallHash `json:"ack_tx_hash"`     // heimdall tx hash of ack
+	BufferTime     uint64               `json:"buffer_time"`     // seconds spent in buffer
+	BufferTimeouts uint64               `json:"buffer_timeouts"` // checkpoints timed out in buffer since previous ack
+	NoAcks         uint64               `json:"no_acks"`         // no-acks since previous ack
+	AfterNoAck     bool                 `json:"after_no_ack"`    // checkpoint was proposed after a no-ack
+}
+
+// NewCheckpointMetadata creates a new CheckpointMetadata
+func NewCheckpointMetadata(
+	number uint64,
+	proposedHeight int64,
+	ackedHeight int64,
+	ackedTime uint64,
+	ackTxHash hmTypes.HeimdallHash,
+	bufferTime uint64,
+	activity CheckpointActivity,
+) CheckpointMetadata {
+	return CheckpointMetadata{
+		Number:         number,
+		ProposedHeight: proposedHeight,
+		AckedHeight:    ackedHeight,
+		AckedTime:      ackedTime,
+		AckTxHash:      ackTxHash,
+		BufferTime:     bufferTime,
+		BufferTimeouts: activity.BufferTimeouts,
+		NoAcks:         activity.NoAcks,
+		AfterNoAck:     activity.NoAcks > 0,
+	}
+}
+
+// StatsSummary summarizes a series of values
+type StatsSummary struct {
+	Min uint64 `json:"min"`
+	Max uint64 `json:"max"`
+	Avg uint64 `json:"avg"`
+
+	sum   uint64
+	count uint64
+}
+
+// Add adds a value to the summary
+func (s *StatsSummary) Add(value uint64) {
+	if s.count == 0 || value < s.Min {
+		s.Min = value
+	}
+	if value > s.Max {
+		s.Max = value
+	}
+
+	s.sum += value
+	s.count++
+	s.Avg = s.sum / s.count
+}
+
+// ProposerStats is the number of checkpoints proposed by a validator
+type ProposerStats struct {
+	Proposer hmTypes.HeimdallAddress `json:"proposer"`
+	Count    uint64                  `json:"count"`
+}
+
+// CheckpointStats are the aggregate statistics of the acked checkpoints from number From to To.
+// Interval, block span and proposers cover all checkpoints of the window, buffer stats and
+// counters only the checkpoints with recorded metadata.
+type CheckpointStats struct {
+	From      uint64 `json:"from"`
+	To        uint64 `json:"to"`
+	StartTime uint64 `json:"start_time"` // proposal time of first checkpoint
+	EndTime   uint64 `json:"end_time"`   // proposal time of last checkpoint
+
+	Interval  StatsSummary    `json:"interval"`   // seconds between proposals of consecutive checkpoints
+	BlockSpan StatsSummary    `json:"block_span"` // bor blocks per checkpoint
+	Proposers []ProposerStats `json:"proposers"`
+
+	Recorded       uint64       `json:"recorded"`    // checkpoints with metadata
+	BufferTime     StatsSummary `json:"buffer_time"` // seconds spent in buffer
+	BufferTimeouts uint64       `json:"buffer_timeouts"`
+	NoAcks         uint64       `json:"no_acks"`
+	AfterNoAck     uint64       `json:"after_no_ack"` // checkpoints proposed after a no-ack
+}
@@ -9,6 +9,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCheckpointAck{}, "checkpoint/MsgCheckpointACK", nil)
 	cdc.RegisterConcrete(MsgCheckpointNoAck{}, "checkpoint/MsgCheckpointNoACK", nil)
 	cdc.RegisterConcrete(MsgCheckpointAdjust{}, "checkpoint/MsgCheckpointAdjust", nil)
+	cdc.RegisterConcrete(CheckpointAdjustProposal{}, "heimdall/CheckpointAdjustProposal", nil)
+	cdc.RegisterConcrete(CheckpointBufferFlushProposal{}, "heimdall/CheckpointBufferFlushProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -2,18 +2,25 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeCheckpoint       = "checkpoint"
-	EventTypeCheckpointAdjust = "checkpoint-adjust"
-	EventTypeCheckpointAck    = "checkpoint-ack"
-	EventTypeCheckpointNoAck  = "checkpoint-noack"
+	EventTypeCheckpoint              = "checkpoint"
+	EventTypeCheckpointAdjust        = "checkpoint-adjust"
+	EventTypeCheckpointAdjustApprove = "checkpoint-adjust-approve"
+	EventTypeCheckpointAck           = "checkpoint-ack"
+	EventTypeCheckpointNoAck         = "checkpoint-noack"
+	EventTypeCheckpointFlush         = "checkpoint-buffer-flush"
 
-	AttributeKeyProposer    = "proposer"
-	AttributeKeyStartBlock  = "start-block"
-	AttributeKeyEndBlock    = "end-block"
-	AttributeKeyHeaderIndex = "header-index"
-	AttributeKeyNewProposer = "new-proposer"
-	AttributeKeyRootHash    = "root-hash"
-	AttributeKeyAccountHash = "account-hash"
+	AttributeKeyProposer     = "proposer"
+	AttributeKeyStartBlock   = "start-block"
+	AttributeKeyEndBlock     = "end-block"
+	AttributeKeyHeaderIndex  = "header-index"
+	AttributeKeyNewProposer  = "new-proposer"
+	AttributeKeyRootHash     = "root-hash"
+	AttributeKeyAccountHash  = "account-hash"
+	AttributeKeyAdjustment   = "adjustment-id"
+	AttributeKeySource       = "source"
+	AttributeKeyOriginalEnd  = "original-end-block"
+	AttributeKeyOriginalRoot = "original-root-hash"
+	AttributeKeyBorChainID   = "bor-chain-id"
 
 	AttributeValueCategory = ModuleName
 )
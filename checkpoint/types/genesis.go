@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -12,10 +13,35 @@ import (
 type GenesisState struct {
 	Params Params `json:"params" yaml:"params"`
 
-	BufferedCheckpoint *hmTypes.Checkpoint  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
-	LastNoACK          uint64               `json:"last_no_ack" yaml:"last_no_ack"`
-	AckCount           uint64               `json:"ack_count" yaml:"ack_count"`
-	Checkpoints        []hmTypes.Checkpoint `json:"checkpoints" yaml:"checkpoints"`
+	BufferedCheckpoint *hmTypes.Checkpoint        `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	LastNoACK          uint64                     `json:"last_no_ack" yaml:"last_no_ack"`
+	AckCount           uint64                     `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint       `json:"checkpoints" yaml:"checkpoints"`
+	AckTxs             []AckTx                    `json:"ack_txs" yaml:"ack_txs"`
+	Adjustments        []Adjustment               `json:"adjustments" yaml:"adjustments"`
+	ApprovedAdjusts    []CheckpointAdjustProposal `json:"approved_adjusts" yaml:"approved_adjusts"`
+	Approvals          []ApprovalWithNumber       `json:"approvals" yaml:"approvals"`
+	BufferApproval     *Approval                  `json:"buffer_approval" yaml:"buffer_approval"`
+	Metadata           []CheckpointMetadata       `json:"metadata" yaml:"metadata"`
+	Activity           CheckpointActivity         `json:"activity" yaml:"activity"`
+
+	ChildChains []ChildChainState `json:"child_chains" yaml:"child_chains"`
+}
+
+// ChildChainState is the checkpoint sequence of a child chain
+type ChildChainState struct {
+	BorChainID         string                     `json:"bor_chain_id" yaml:"bor_chain_id"`
+	BufferedCheckpoint *hmTypes.Checkpoint        `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
+	LastNoACK          uint64                     `json:"last_no_ack" yaml:"last_no_ack"`
+	AckCount           uint64                     `json:"ack_count" yaml:"ack_count"`
+	Checkpoints        []hmTypes.Checkpoint       `json:"checkpoints" yaml:"checkpoints"`
+	AckTxs             []AckTx                    `json:"ack_txs" yaml:"ack_txs"`
+	Adjustments        []Adjustment               `json:"adjustments" yaml:"adjustments"`
+	ApprovedAdjusts    []CheckpointAdjustProposal `json:"approved_adjusts" yaml:"approved_adjusts"`
+	Approvals          []ApprovalWithNumber       `json:"approvals" yaml:"approvals"`
+	BufferApproval     *Approval                  `json:"buffer_approval" yaml:"buffer_approval"`
+	Metadata           []CheckpointMetadata       `json:"metadata" yaml:"metadata"`
+	Activity           CheckpointActivity         `json:"activity" yaml:"activity"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,6 +51,7 @@ func NewGenesisState(
 	lastNoACK uint64,
 	ackCount uint64,
 	checkpoints []hmTypes.Checkpoint,
+	ackTxs []AckTx,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
@@ -32,6 +59,7 @@ func NewGenesisState(
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
 		Checkpoints:        checkpoints,
+		AckTxs:             ackTxs,
 	}
 }
 
@@ -49,12 +77,66 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	if len(data.Checkpoints) != 0 {
-		if int(data.AckCount) != len(data.Checkpoints) {
+	if err := validateSequence(data.AckCount, data.Checkpoints, data.AckTxs, data.Adjustments, data.ApprovedAdjusts); err != nil {
+		return err
+	}
+
+	borChainIDs := make(map[string]bool, len(data.ChildChains))
+	for _, childChain := range data.ChildChains {
+		if childChain.BorChainID == "" || borChainIDs[childChain.BorChainID] {
+			return fmt.Errorf("invalid or duplicate child chain %v", childChain.BorChainID)
+		}
+		borChainIDs[childChain.BorChainID] = true
+
+		if err := validateSequence(childChain.AckCount, childChain.Checkpoints, childChain.AckTxs, childChain.Adjustments, childChain.ApprovedAdjusts); err != nil {
+			return fmt.Errorf("child chain %v: %v", childChain.BorChainID, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSequence validates acked checkpoints, their ack txs and adjustments of a checkpoint sequence
+func validateSequence(ackCount uint64, checkpoints []hmTypes.Checkpoint, ackTxs []AckTx, adjustments []Adjustment, approvedAdjusts []CheckpointAdjustProposal) error {
+	if len(checkpoints) != 0 {
+		if int(ackCount) != len(checkpoints) {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
 
+	seen := make(map[string]bool, len(ackTxs))
+	for _, ackTx := range ackTxs {
+		if ackTx.Number == 0 || ackTx.Number > ackCount {
+			return fmt.Errorf("ack tx %v acks unknown checkpoint %v", ackTx.TxHash, ackTx.Number)
+		}
+
+		key := fmt.Sprintf("%v-%v", ackTx.TxHash, ackTx.LogIndex)
+		if seen[key] {
+			return fmt.Errorf("duplicate ack tx %v with log index %v", ackTx.TxHash, ackTx.LogIndex)
+		}
+		seen[key] = true
+	}
+
+	adjustmentIDs := make(map[uint64]bool, len(adjustments))
+	for _, adjustment := range adjustments {
+		if adjustment.ID == 0 || adjustmentIDs[adjustment.ID] {
+			return fmt.Errorf("invalid or duplicate adjustment id %v", adjustment.ID)
+		}
+		adjustmentIDs[adjustment.ID] = true
+
+		if adjustment.Number == 0 || adjustment.Number > ackCount {
+			return fmt.Errorf("adjustment %v adjusts unknown checkpoint %v", adjustment.ID, adjustment.Number)
+		}
+	}
+
+	approvedNumbers := make(map[uint64]bool, len(approvedAdjusts))
+	for _, proposal := range approvedAdjusts {
+		if proposal.HeaderIndex == 0 || proposal.HeaderIndex > ackCount || approvedNumbers[proposal.HeaderIndex] {
+			return fmt.Errorf("approved adjust of unknown or duplicate checkpoint %v", proposal.HeaderIndex)
+		}
+		approvedNumbers[proposal.HeaderIndex] = true
+	}
+
 	return nil
 }
 
@@ -0,0 +1,102 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// AckTx is the rootchain tx, identified by its hash and log index, which acked a checkpoint
+type AckTx struct {
+	Number   uint64               `json:"number" yaml:"number"`
+	TxHash   hmTypes.HeimdallHash `json:"tx_hash" yaml:"tx_hash"`
+	LogIndex uint64               `json:"log_index" yaml:"log_index"`
+}
+
+// NewAckTx creates a new AckTx
+func NewAckTx(number uint64, txHash hmTypes.HeimdallHash, logIndex uint64) AckTx {
+	return AckTx{
+		Number:   number,
+		TxHash:   txHash,
+		LogIndex: logIndex,
+	}
+}
+
+// CheckpointWithNumber is a checkpoint along with its number
+type CheckpointWithNumber struct {
+	Number uint64 `json:"number"`
+	hmTypes.Checkpoint
+}
+
+// Approval is the heimdall tx which approved a checkpoint and the height at which it was approved.
+// The side-tx signatures of the approval are in the precommits of the block at that height.
+type Approval struct {
+	Height int64                `json:"height"`
+	TxHash hmTypes.HeimdallHash `json:"tx_hash"`
+}
+
+// NewApproval creates a new Approval
+func NewApproval(height int64, txHash hmTypes.HeimdallHash) Approval {
+	return Approval{
+		Height: height,
+		TxHash: txHash,
+	}
+}
+
+// ApprovalWithNumber is the approval of an acked checkpoint along with its number
+type ApprovalWithNumber struct {
+	Number uint64 `json:"number" yaml:"number"`
+	Approval
+}
+
+// Vote status of the checkpoint in buffer
+const (
+	VoteStatusApproved = "approved" // side-tx got +2/3 yes votes, signatures are in the precommits of approval height
+	VoteStatusUnknown  = "unknown"  // checkpoint was buffered before approvals were recorded
+)
+
+// BufferedCheckpoint is the checkpoint in buffer along with its expiry and the heimdall tx which approved it
+type BufferedCheckpoint struct {
+	hmTypes.Checkpoint
+	ExpiryTime     uint64               `json:"expiry_time"`
+	Expired        bool                 `json:"expired"`
+	ApprovalHeight int64                `json:"approval_height"`
+	ApprovalTxHash hmTypes.HeimdallHash `json:"approval_tx_hash"`
+	VoteStatus     string               `json:"vote_status"`
+}
+
+// Adjustment sources
+const (
+	AdjustmentSourceMsg = "msg" // MsgCheckpointAdjust approved by side-tx vote on the rootchain header
+	AdjustmentSourceGov = "gov" // MsgCheckpointAdjust approved by side-tx vote and a CheckpointAdjustProposal
+)
+
+// Adjustment is an audit record of an acked checkpoint which was adjusted
+type Adjustment struct {
+	ID       uint64                  `json:"id" yaml:"id"`
+	Number   uint64                  `json:"number" yaml:"number"`
+	Original hmTypes.Checkpoint      `json:"original" yaml:"original"`
+	Adjusted hmTypes.Checkpoint      `json:"adjusted" yaml:"adjusted"`
+	Height   int64                   `json:"height" yaml:"height"`
+	Source   string                  `json:"source" yaml:"source"`
+	Sender   hmTypes.HeimdallAddress `json:"sender" yaml:"sender"`     // sender of adjust msg
+	TxHash   hmTypes.HeimdallHash    `json:"tx_hash" yaml:"tx_hash"`   // heimdall tx hash of adjust msg
+	Proposal string                  `json:"proposal" yaml:"proposal"` // title of adjust proposal
+}
+
+// NewMsgAdjustment creates an Adjustment triggered by an adjust msg
+func NewMsgAdjustment(sender hmTypes.HeimdallAddress, txHash hmTypes.HeimdallHash) Adjustment {
+	return Adjustment{
+		Source: AdjustmentSourceMsg,
+		Sender: sender,
+		TxHash: txHash,
+	}
+}
+
+// NewGovAdjustment creates an Adjustment approved by an adjust proposal and applied by an adjust msg
+func NewGovAdjustment(title string, sender hmTypes.HeimdallAddress, txHash hmTypes.HeimdallHash) Adjustment {
+	return Adjustment{
+		Source:   AdjustmentSourceGov,
+		Sender:   sender,
+		TxHash:   txHash,
+		Proposal: title,
+	}
+}
@@ -3,12 +3,14 @@ package types
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/cbergoon/merkletree"
 	"github.com/maticnetwork/bor/common"
-	"github.com/maticnetwork/bor/rpc"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/tendermint/crypto/sha3"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -104,6 +106,74 @@ func VerifyAccountProof(dividendAccounts []hmTypes.DividendAccount, userAddr hmT
 	return false, nil
 }
 
+// BlockProof is the merkle proof of a bor block header within the root hash of its checkpoint
+type BlockProof struct {
+	CheckpointNumber uint64                 `json:"checkpoint_number"`
+	BlockNumber      uint64                 `json:"block_number"`
+	StartBlock       uint64                 `json:"start_block"`
+	EndBlock         uint64                 `json:"end_block"`
+	Index            uint64                 `json:"index"`
+	Leaf             hmTypes.HeimdallHash   `json:"leaf"`
+	Proof            []hmTypes.HeimdallHash `json:"proof"`
+	RootHash         hmTypes.HeimdallHash   `json:"root_hash"`
+}
+
+// String implements fmt.Stringer
+func (bp BlockProof) String() string {
+	var sb strings.Builder
+	sb.WriteString("BlockProof: \n")
+	sb.WriteString(fmt.Sprintf("CheckpointNumber: %d\n", bp.CheckpointNumber))
+	sb.WriteString(fmt.Sprintf("BlockNumber: %d\n", bp.BlockNumber))
+	sb.WriteString(fmt.Sprintf("StartBlock: %d\n", bp.StartBlock))
+	sb.WriteString(fmt.Sprintf("EndBlock: %d\n", bp.EndBlock))
+	sb.WriteString(fmt.Sprintf("Index: %d\n", bp.Index))
+	sb.WriteString(fmt.Sprintf("Leaf: %s\n", bp.Leaf))
+	sb.WriteString(fmt.Sprintf("Proof: %s\n", bp.Proof))
+	sb.WriteString(fmt.Sprintf("RootHash: %s\n", bp.RootHash))
+	return sb.String()
+}
+
+// GetHeadersProof returns the leaf of the header at index, its sibling path from the bottom up and the root hash
+// of the given headers. Leaves are padded with empty hashes to the next power of two, as done by GetRootHash.
+func GetHeadersProof(headers []*ethTypes.Header, index uint64) (leaf []byte, proof [][]byte, root []byte, err error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, nil, fmt.Errorf("index %v out of range of %v headers", index, len(headers))
+	}
+
+	level := make([][32]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i, header := range headers {
+		copy(level[i][:], helper.GetHeaderLeaf(header))
+	}
+
+	leaf = convert(level[index : index+1])[0]
+	for position := index; len(level) > 1; position /= 2 {
+		proof = append(proof, convert(level[position^1 : position^1+1])[0])
+
+		parents := make([][32]byte, len(level)/2)
+		for i := range parents {
+			copy(parents[i][:], crypto.Keccak256(level[2*i][:], level[2*i+1][:]))
+		}
+		level = parents
+	}
+
+	return leaf, proof, convert(level)[0], nil
+}
+
+// VerifyHeaderProof checks the sibling path of a leaf at index against the root hash
+func VerifyHeaderProof(leaf []byte, index uint64, proof [][]byte, root []byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			hash = crypto.Keccak256(hash, sibling)
+		} else {
+			hash = crypto.Keccak256(sibling, hash)
+		}
+		index /= 2
+	}
+
+	return index == 0 && bytes.Equal(hash, root)
+}
+
 func convert(input []([32]byte)) [][]byte {
 	var output [][]byte
 	for _, in := range input {
@@ -149,35 +219,3 @@ func nextPowerOfTwo(n uint64) uint64 {
 	n++
 	return n
 }
-
-// spins go-routines to fetch batch elements to allow creation of large merkle trees
-func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem, checkpointLength uint64) (err error) {
-	var batchLength = int(checkpointLength)
-	// group
-	var g errgroup.Group
-
-	for i := 0; i < len(elements); i += batchLength {
-		var newBatch []rpc.BatchElem
-		if len(elements) < i+batchLength {
-			newBatch = elements[i:]
-		} else {
-			newBatch = elements[i : i+batchLength]
-		}
-
-		// common.CheckpointLogger.Info("Batching requests", "index", i, "length", len(newBatch))
-
-		// spawn go-routine
-		g.Go(func() error {
-			// Batch call
-			err := rpcClient.BatchCall(newBatch)
-			return err
-		})
-	}
-
-	if err := g.Wait(); err != nil {
-		return err
-	}
-
-	// common.CheckpointLogger.Info("Fetched all headers", "len", len(elements))
-	return nil
-}
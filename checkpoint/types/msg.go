@@ -26,6 +26,7 @@ type MsgCheckpointAdjust struct {
 	StartBlock  uint64                `json:"start_block"`
 	EndBlock    uint64                `json:"end_block"`
 	RootHash    types.HeimdallHash    `json:"root_hash"`
+	BorChainID  string                `json:"bor_chain_id,omitempty"`
 }
 
 // NewMsgCheckpointAdjust adjust previous checkpoint
@@ -36,6 +37,7 @@ func NewMsgCheckpointAdjust(
 	proposer types.HeimdallAddress,
 	from types.HeimdallAddress,
 	rootHash types.HeimdallHash,
+	borChainID string,
 ) MsgCheckpointAdjust {
 	return MsgCheckpointAdjust{
 		HeaderIndex: headerIndex,
@@ -44,6 +46,7 @@ func NewMsgCheckpointAdjust(
 		Proposer:    proposer,
 		From:        from,
 		RootHash:    rootHash,
+		BorChainID:  borChainID,
 	}
 }
 
@@ -186,6 +189,7 @@ type MsgCheckpointAck struct {
 	RootHash   types.HeimdallHash    `json:"root_hash"`
 	TxHash     types.HeimdallHash    `json:"tx_hash"`
 	LogIndex   uint64                `json:"log_index"`
+	BorChainID string                `json:"bor_chain_id,omitempty"`
 }
 
 func NewMsgCheckpointAck(
@@ -197,6 +201,7 @@ func NewMsgCheckpointAck(
 	rootHash types.HeimdallHash,
 	txHash types.HeimdallHash,
 	logIndex uint64,
+	borChainID string,
 ) MsgCheckpointAck {
 
 	return MsgCheckpointAck{
@@ -208,6 +213,7 @@ func NewMsgCheckpointAck(
 		RootHash:   rootHash,
 		TxHash:     txHash,
 		LogIndex:   logIndex,
+		BorChainID: borChainID,
 	}
 }
 
@@ -272,12 +278,14 @@ func (msg MsgCheckpointAck) GetSideSignBytes() []byte {
 var _ sdk.Msg = &MsgCheckpointNoAck{}
 
 type MsgCheckpointNoAck struct {
-	From types.HeimdallAddress `json:"from"`
+	From       types.HeimdallAddress `json:"from"`
+	BorChainID string                `json:"bor_chain_id,omitempty"`
 }
 
-func NewMsgCheckpointNoAck(from types.HeimdallAddress) MsgCheckpointNoAck {
+func NewMsgCheckpointNoAck(from types.HeimdallAddress, borChainID string) MsgCheckpointNoAck {
 	return MsgCheckpointNoAck{
-		From: from,
+		From:       from,
+		BorChainID: borChainID,
 	}
 }
 
@@ -15,6 +15,7 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+	DefaultDirectAdjustEnabled  bool          = true
 )
 
 // Parameter keys
@@ -23,6 +24,8 @@ var (
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyChildChains          = []byte("ChildChains")
+	KeyDirectAdjustEnabled  = []byte("DirectAdjustEnabled")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -33,6 +36,21 @@ type Params struct {
 	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+
+	// checkpoint params of child chains, child chains without params use the params above
+	ChildChains []ChildChainParams `json:"child_chains" yaml:"child_chains"`
+
+	// allow MsgCheckpointAdjust, checkpoints can always be adjusted through governance
+	DirectAdjustEnabled bool `json:"direct_adjust_enabled" yaml:"direct_adjust_enabled"`
+}
+
+// ChildChainParams defines the checkpoint parameters of a child chain
+type ChildChainParams struct {
+	BorChainID           string        `json:"bor_chain_id" yaml:"bor_chain_id"`
+	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
+	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
+	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
 }
 
 // NewParams creates a new Params object
@@ -47,6 +65,7 @@ func NewParams(
 		AvgCheckpointLength:  checkpointLength,
 		MaxCheckpointLength:  maxCheckpointLength,
 		ChildBlockInterval:   childBlockInterval,
+		DirectAdjustEnabled:  DefaultDirectAdjustEnabled,
 	}
 }
 
@@ -64,6 +83,8 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
 		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
 		{KeyChildBlockInterval, &p.ChildBlockInterval},
+		{KeyChildChains, &p.ChildChains},
+		{KeyDirectAdjustEnabled, &p.DirectAdjustEnabled},
 	}
 }
 
@@ -81,6 +102,7 @@ func DefaultParams() Params {
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
 		ChildBlockInterval:   DefaultChildBlockInterval,
+		DirectAdjustEnabled:  DefaultDirectAdjustEnabled,
 	}
 }
 
@@ -92,9 +114,32 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("DirectAdjustEnabled: %t\n", p.DirectAdjustEnabled))
+	for _, chainParams := range p.ChildChains {
+		sb.WriteString(fmt.Sprintf("ChildChain %s: CheckpointBufferTime: %s AvgCheckpointLength: %d MaxCheckpointLength: %d ChildBlockInterval: %d\n",
+			chainParams.BorChainID, chainParams.CheckpointBufferTime, chainParams.AvgCheckpointLength, chainParams.MaxCheckpointLength, chainParams.ChildBlockInterval))
+	}
 	return sb.String()
 }
 
+// ForChain returns the checkpoint params of a bor chain
+func (p Params) ForChain(borChainID string) Params {
+	for _, chainParams := range p.ChildChains {
+		if chainParams.BorChainID == borChainID {
+			params := NewParams(
+				chainParams.CheckpointBufferTime,
+				chainParams.AvgCheckpointLength,
+				chainParams.MaxCheckpointLength,
+				chainParams.ChildBlockInterval,
+			)
+			params.DirectAdjustEnabled = p.DirectAdjustEnabled
+			return params
+		}
+	}
+
+	return p
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if p.MaxCheckpointLength == 0 || p.AvgCheckpointLength == 0 {
@@ -109,5 +154,17 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ChildBlockInterval should be greater than zero")
 	}
 
+	borChainIDs := make(map[string]bool, len(p.ChildChains))
+	for _, chainParams := range p.ChildChains {
+		if chainParams.BorChainID == "" || borChainIDs[chainParams.BorChainID] {
+			return fmt.Errorf("Invalid or duplicate bor chain id %s in child chain params", chainParams.BorChainID)
+		}
+		borChainIDs[chainParams.BorChainID] = true
+
+		if err := p.ForChain(chainParams.BorChainID).Validate(); err != nil {
+			return fmt.Errorf("Invalid params of child chain %s: %v", chainParams.BorChainID, err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	// ProposalTypeCheckpointAdjust defines the type for a CheckpointAdjustProposal
+	ProposalTypeCheckpointAdjust = "CheckpointAdjust"
+	// ProposalTypeCheckpointBufferFlush defines the type for a CheckpointBufferFlushProposal
+	ProposalTypeCheckpointBufferFlush = "CheckpointBufferFlush"
+)
+
+// Assert checkpoint proposals implement govTypes.Content at compile-time
+var _ govTypes.Content = CheckpointAdjustProposal{}
+var _ govTypes.Content = CheckpointBufferFlushProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeCheckpointAdjust)
+	govTypes.RegisterProposalTypeCodec(CheckpointAdjustProposal{}, "heimdall/CheckpointAdjustProposal")
+	govTypes.RegisterProposalType(ProposalTypeCheckpointBufferFlush)
+	govTypes.RegisterProposalTypeCodec(CheckpointBufferFlushProposal{}, "heimdall/CheckpointBufferFlushProposal")
+}
+
+// CheckpointAdjustProposal approves the adjustment of an acked checkpoint to the given end block, root hash
+// and proposer. The adjustment is applied by a MsgCheckpointAdjust matching the proposal, whose side-tx
+// checks the header on rootchain, even if direct adjustments are disabled.
+type CheckpointAdjustProposal struct {
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	HeaderIndex uint64                  `json:"header_index" yaml:"header_index"`
+	Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	StartBlock  uint64                  `json:"start_block" yaml:"start_block"`
+	EndBlock    uint64                  `json:"end_block" yaml:"end_block"`
+	RootHash    hmTypes.HeimdallHash    `json:"root_hash" yaml:"root_hash"`
+	BorChainID  string                  `json:"bor_chain_id,omitempty" yaml:"bor_chain_id"`
+}
+
+// NewCheckpointAdjustProposal creates a new checkpoint adjust proposal
+func NewCheckpointAdjustProposal(
+	title string,
+	description string,
+	headerIndex uint64,
+	proposer hmTypes.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash hmTypes.HeimdallHash,
+	borChainID string,
+) CheckpointAdjustProposal {
+	return CheckpointAdjustProposal{
+		Title:       title,
+		Description: description,
+		HeaderIndex: headerIndex,
+		Proposer:    proposer,
+		StartBlock:  startBlock,
+		EndBlock:    endBlock,
+		RootHash:    rootHash,
+		BorChainID:  borChainID,
+	}
+}
+
+// GetTitle returns the title of a checkpoint adjust proposal.
+func (cadp CheckpointAdjustProposal) GetTitle() string { return cadp.Title }
+
+// GetDescription returns the description of a checkpoint adjust proposal.
+func (cadp CheckpointAdjustProposal) GetDescription() string { return cadp.Description }
+
+// ProposalRoute returns the routing key of a checkpoint adjust proposal.
+func (cadp CheckpointAdjustProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a checkpoint adjust proposal.
+func (cadp CheckpointAdjustProposal) ProposalType() string { return ProposalTypeCheckpointAdjust }
+
+// ValidateBasic validates the checkpoint adjust proposal
+func (cadp CheckpointAdjustProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, cadp); err != nil {
+		return err
+	}
+
+	if cadp.HeaderIndex == 0 {
+		return hmCommon.ErrNoCheckpointFound(hmCommon.DefaultCodespace)
+	}
+
+	if cadp.EndBlock < cadp.StartBlock || cadp.RootHash.Empty() || cadp.Proposer.Empty() {
+		return hmCommon.ErrBadBlockDetails(hmCommon.DefaultCodespace)
+	}
+
+	return nil
+}
+
+// Matches returns true if the adjust msg adjusts the checkpoint as approved by the proposal
+func (cadp CheckpointAdjustProposal) Matches(msg MsgCheckpointAdjust) bool {
+	return cadp.HeaderIndex == msg.HeaderIndex &&
+		cadp.StartBlock == msg.StartBlock &&
+		cadp.EndBlock == msg.EndBlock &&
+		cadp.RootHash.Equals(msg.RootHash) &&
+		cadp.Proposer.Equals(msg.Proposer)
+}
+
+// String implements the Stringer interface.
+func (cadp CheckpointAdjustProposal) String() string {
+	return fmt.Sprintf(`Checkpoint Adjust Proposal:
+  Title:       %s
+  Description: %s
+  Checkpoint:
+    Number:     %d
+    Proposer:   %s
+    StartBlock: %d
+    EndBlock:   %d
+    RootHash:   %s
+    BorChainID: %s
+`, cadp.Title, cadp.Description, cadp.HeaderIndex, cadp.Proposer, cadp.StartBlock, cadp.EndBlock, cadp.RootHash, cadp.BorChainID)
+}
+
+// CheckpointBufferFlushProposal flushes the checkpoint in buffer, e.g. when the rootchain already moved on
+// and the buffered checkpoint can never be acked. Proposer and start block must match the checkpoint in
+// buffer when the proposal passes. The buffer may change while the proposal is voted on, so its end block
+// and root hash are not matched, but a proposal never flushes a checkpoint of another proposer or one
+// proposed after the next ack.
+type CheckpointBufferFlushProposal struct {
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	StartBlock  uint64                  `json:"start_block" yaml:"start_block"`
+	BorChainID  string                  `json:"bor_chain_id,omitempty" yaml:"bor_chain_id"`
+}
+
+// NewCheckpointBufferFlushProposal creates a new checkpoint buffer flush proposal
+func NewCheckpointBufferFlushProposal(
+	title string,
+	description string,
+	proposer hmTypes.HeimdallAddress,
+	startBlock uint64,
+	borChainID string,
+) CheckpointBufferFlushProposal {
+	return CheckpointBufferFlushProposal{
+		Title:       title,
+		Description: description,
+		Proposer:    proposer,
+		StartBlock:  startBlock,
+		BorChainID:  borChainID,
+	}
+}
+
+// GetTitle returns the title of a checkpoint buffer flush proposal.
+func (cbfp CheckpointBufferFlushProposal) GetTitle() string { return cbfp.Title }
+
+// GetDescription returns the description of a checkpoint buffer flush proposal.
+func (cbfp CheckpointBufferFlushProposal) GetDescription() string { return cbfp.Description }
+
+// ProposalRoute returns the routing key of a checkpoint buffer flush proposal.
+func (cbfp CheckpointBufferFlushProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a checkpoint buffer flush proposal.
+func (cbfp CheckpointBufferFlushProposal) ProposalType() string {
+	return ProposalTypeCheckpointBufferFlush
+}
+
+// ValidateBasic validates the checkpoint buffer flush proposal
+func (cbfp CheckpointBufferFlushProposal) ValidateBasic() sdk.Error {
+	if err := govTypes.ValidateAbstract(hmCommon.DefaultCodespace, cbfp); err != nil {
+		return err
+	}
+
+	if cbfp.Proposer.Empty() {
+		return hmCommon.ErrBadBlockDetails(hmCommon.DefaultCodespace)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cbfp CheckpointBufferFlushProposal) String() string {
+	return fmt.Sprintf(`Checkpoint Buffer Flush Proposal:
+  Title:       %s
+  Description: %s
+  Checkpoint:
+    Proposer:   %s
+    StartBlock: %d
+    BorChainID: %s
+`, cbfp.Title, cbfp.Description, cbfp.Proposer, cbfp.StartBlock, cbfp.BorChainID)
+}
@@ -1,22 +1,34 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
-	QueryParams           = "params"
-	QueryAckCount         = "ack-count"
-	QueryCheckpoint       = "checkpoint"
-	QueryCheckpointBuffer = "checkpoint-buffer"
-	QueryLastNoAck        = "last-no-ack"
-	QueryCheckpointList   = "checkpoint-list"
-	QueryNextCheckpoint   = "next-checkpoint"
-	QueryProposer         = "is-proposer"
-	QueryCurrentProposer  = "current-proposer"
-	StakingQuerierRoute   = "staking"
+	QueryParams            = "params"
+	QueryAckCount          = "ack-count"
+	QueryCheckpoint        = "checkpoint"
+	QueryCheckpointBuffer  = "checkpoint-buffer"
+	QueryLastNoAck         = "last-no-ack"
+	QueryCheckpointList    = "checkpoint-list"
+	QueryNextCheckpoint    = "next-checkpoint"
+	QueryProposer          = "is-proposer"
+	QueryCurrentProposer   = "current-proposer"
+	QueryBlockProof        = "block-proof"
+	QueryCheckpointByBlock = "checkpoint-by-block"
+	QueryCheckpointByAckTx = "checkpoint-by-ack-tx"
+	QueryApproval          = "approval"
+	QueryMetadata          = "checkpoint-metadata"
+	QueryStats             = "checkpoint-stats"
+	QueryAdjustments       = "adjustments"
+	StakingQuerierRoute    = "staking"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
 type QueryCheckpointParams struct {
-	Number uint64
+	Number     uint64
+	BorChainID string
 }
 
 // NewQueryCheckpointParams creates a new instance of QueryCheckpointHeaderIndex.
@@ -24,6 +36,11 @@ func NewQueryCheckpointParams(number uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{Number: number}
 }
 
+// NewQueryChainCheckpointParams creates a new instance of QueryCheckpointParams for checkpoint of a child chain
+func NewQueryChainCheckpointParams(number uint64, borChainID string) QueryCheckpointParams {
+	return QueryCheckpointParams{Number: number, BorChainID: borChainID}
+}
+
 // QueryBorChainID defines the params for querying with bor chain id
 type QueryBorChainID struct {
 	BorChainID string
@@ -33,3 +50,36 @@ type QueryBorChainID struct {
 func NewQueryBorChainID(chainID string) QueryBorChainID {
 	return QueryBorChainID{BorChainID: chainID}
 }
+
+// QueryBorBlockParams defines the params for querying with bor block number
+type QueryBorBlockParams struct {
+	BlockNumber uint64
+}
+
+// NewQueryBorBlockParams creates a new instance of QueryBorBlockParams
+func NewQueryBorBlockParams(blockNumber uint64) QueryBorBlockParams {
+	return QueryBorBlockParams{BlockNumber: blockNumber}
+}
+
+// QueryAckTxParams defines the params for querying with rootchain ack tx hash and log index
+type QueryAckTxParams struct {
+	TxHash   hmTypes.HeimdallHash
+	LogIndex uint64
+}
+
+// NewQueryAckTxParams creates a new instance of QueryAckTxParams
+func NewQueryAckTxParams(txHash hmTypes.HeimdallHash, logIndex uint64) QueryAckTxParams {
+	return QueryAckTxParams{TxHash: txHash, LogIndex: logIndex}
+}
+
+// QueryStatsParams defines the params for querying checkpoint stats over a window of checkpoints
+type QueryStatsParams struct {
+	From       uint64
+	To         uint64
+	BorChainID string
+}
+
+// NewQueryStatsParams creates a new instance of QueryStatsParams
+func NewQueryStatsParams(from uint64, to uint64, borChainID string) QueryStatsParams {
+	return QueryStatsParams{From: from, To: to, BorChainID: borChainID}
+}
@@ -0,0 +1,48 @@
+package clerk
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+)
+
+// RegisterInvariants registers all clerk invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dense-record-ids", DenseRecordIDsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the clerk module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return DenseRecordIDsInvariant(keeper)(ctx)
+	}
+}
+
+// DenseRecordIDsInvariant checks that event record ids have no gaps
+func DenseRecordIDsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var ids []uint64
+		keeper.IterateRecordsAndApplyFn(ctx, func(record types.EventRecord) error {
+			ids = append(ids, record.ID)
+			return nil
+		})
+
+		// records are stored by decimal id, so they are not iterated in numeric order
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+
+		var gaps []string
+		for i := 1; i < len(ids); i++ {
+			if ids[i] != ids[i-1]+1 {
+				gaps = append(gaps, fmt.Sprintf("(%d, %d)", ids[i-1], ids[i]))
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dense record ids",
+			fmt.Sprintf("\trecords: %d\n\tgaps between ids: %v\n", len(ids), gaps)), len(gaps) != 0
+	}
+}
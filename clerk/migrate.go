@@ -0,0 +1,76 @@
+package clerk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	chainmanagerTypes "github.com/maticnetwork/heimdall/chainmanager/types"
+	"github.com/maticnetwork/heimdall/clerk/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// legacyRecordTime is the record time of event records exported before records had one.
+// They are older than any timed record, so bor syncs them before those.
+var legacyRecordTime = time.Unix(0, 0).UTC()
+
+// eventRecordV02 is an event record as exported by v0.2, before records had a record time
+type eventRecordV02 struct {
+	ID       uint64                  `json:"id"`
+	Contract hmTypes.HeimdallAddress `json:"contract"`
+	Data     hmTypes.HexBytes        `json:"data"`
+	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex uint64                  `json:"log_index"`
+	ChainID  string                  `json:"bor_chain_id"`
+}
+
+// genesisStateV02 is the clerk genesis as exported by v0.2, before record sequences were exported
+type genesisStateV02 struct {
+	EventRecords []eventRecordV02 `json:"event_records"`
+}
+
+// MigrateGenesis migrates clerk genesis from v0.2 to v0.3. Event records get the legacy record time,
+// records without a bor chain id get the one of the chain manager params and records are sorted
+// by id. Conflicting records with the same id are rejected.
+func MigrateGenesis(appState map[string]json.RawMessage) (json.RawMessage, error) {
+	var oldState genesisStateV02
+	if err := types.ModuleCdc.UnmarshalJSON(appState[types.ModuleName], &oldState); err != nil {
+		return nil, err
+	}
+
+	var chainManagerState chainmanagerTypes.GenesisState
+	if err := chainmanagerTypes.ModuleCdc.UnmarshalJSON(appState[chainmanagerTypes.ModuleName], &chainManagerState); err != nil {
+		return nil, err
+	}
+
+	borChainID := chainManagerState.Params.ChainParams.BorChainID
+
+	records := make([]*types.EventRecord, 0, len(oldState.EventRecords))
+	seen := make(map[uint64]eventRecordV02)
+
+	for _, record := range oldState.EventRecords {
+		if prev, ok := seen[record.ID]; ok {
+			if prev.TxHash != record.TxHash || prev.LogIndex != record.LogIndex {
+				return nil, fmt.Errorf("conflicting event records with id %d", record.ID)
+			}
+
+			continue
+		}
+		seen[record.ID] = record
+
+		chainID := record.ChainID
+		if chainID == "" {
+			chainID = borChainID
+		}
+
+		newRecord := types.NewEventRecord(record.TxHash, record.LogIndex, record.ID, record.Contract, record.Data, chainID, legacyRecordTime)
+		records = append(records, &newRecord)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+
+	return types.ModuleCdc.MarshalJSON(types.NewGenesisState(records, nil))
+}
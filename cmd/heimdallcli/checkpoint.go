@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	checkpointcli "github.com/maticnetwork/heimdall/checkpoint/client/cli"
+	checkpointUtils "github.com/maticnetwork/heimdall/checkpoint/client/utils"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// CheckpointCmd groups checkpoint commands against the rootchain
+func CheckpointCmd(cliCtx cliContext.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint",
+		Short: "Checkpoint commands against the rootchain",
+	}
+
+	cmd.AddCommand(SubmitCheckpointCmd(cliCtx))
+	return cmd
+}
+
+// SubmitCheckpointCmd submits an approved checkpoint with its aggregated signatures to the rootchain
+func SubmitCheckpointCmd(cliCtx cliContext.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-to-rootchain",
+		Short: "Submit an approved checkpoint with its signatures to the rootchain",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			helper.InitHeimdallConfig("")
+
+			headerNumber := viper.GetUint64(checkpointcli.FlagHeaderNumber)
+			if headerNumber == 0 {
+				return errors.New("Checkpoint number is required")
+			}
+
+			sigs, err := checkpointUtils.GetCheckpointSignatures(cliCtx, headerNumber)
+			if err != nil {
+				return err
+			}
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			params, err := GetChainmanagerParams(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			rootChainAddress := params.ChainParams.RootChainAddress.EthAddress()
+			rootChainInstance, err := contractCaller.GetRootChainInstance(rootChainAddress)
+			if err != nil {
+				return err
+			}
+
+			// checkpoint must be the next one on rootchain
+			currentChildBlock, err := contractCaller.GetLastChildBlock(rootChainInstance)
+			if err != nil {
+				return err
+			}
+
+			if !(currentChildBlock+1 == sigs.StartBlock || (currentChildBlock == 0 && sigs.StartBlock == 0)) {
+				return fmt.Errorf("Checkpoint %v from %v to %v is not the next checkpoint on rootchain, last child block %v",
+					headerNumber, sigs.StartBlock, sigs.EndBlock, currentChildBlock)
+			}
+
+			return contractCaller.SendCheckpoint(sigs.SideTxData, sigs.Sigs, rootChainAddress, rootChainInstance)
+		},
+	}
+
+	cmd.Flags().Uint64(checkpointcli.FlagHeaderNumber, 0, "--header=<checkpoint-number>")
+	return cmd
+}
@@ -111,6 +111,12 @@ func main() {
 		// approve and stake on mainnet
 		StakeCmd(cliCtx),
 		ApproveCmd(cliCtx),
+
+		// rotate validator signer key
+		RotateSignerCmd(cliCtx),
+
+		// submit approved checkpoints to rootchain
+		CheckpointCmd(cliCtx),
 	)
 
 	// bind with-heimdall-config config with root cmd
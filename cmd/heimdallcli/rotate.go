@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmCfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingcli "github.com/maticnetwork/heimdall/staking/client/cli"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	flagCalldataOnly = "calldata-only"
+	flagCancel       = "cancel"
+)
+
+var validatorEndpoint = "/staking/validator/%v"
+
+// RotateSignerCmd prepares a new consensus key and submits the signer change on L1
+func RotateSignerCmd(cliCtx cliContext.CLIContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-signer",
+		Short: "Rotate validator signer (consensus) key",
+		Long: `Generate a new validator key next to priv_validator_key.json and submit the signer
+update to the stake manager on L1. The running node switches to the new key on its own
+at the height the new key gets voting power, and the old key never signs from that
+height on. Restart the bridge after the switch so that it picks up the new key.
+
+The signer update has to be sent from the validator owner. Use --calldata-only to print
+the transaction data and submit it from the owner wallet.`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			helper.InitHeimdallConfig("")
+
+			config := tmCfg.DefaultConfig()
+			config.SetRoot(viper.GetString(helper.HomeFlag))
+			keyFile := config.PrivValidatorKeyFile()
+			stateFile := config.PrivValidatorStateFile()
+
+			if viper.GetBool(flagCancel) {
+				if err := helper.CancelKeyRotation(keyFile, stateFile); err != nil {
+					return err
+				}
+
+				fmt.Println("Pending key rotation cancelled")
+				return nil
+			}
+
+			validatorID := viper.GetUint64(stakingcli.FlagValidatorID)
+			if validatorID == 0 {
+				return errors.New("Validator ID cannot be zero")
+			}
+
+			// current key has to be the validator signer
+			validator, height, err := getValidator(cliCtx, validatorID)
+			if err != nil {
+				return err
+			}
+
+			signer := common.BytesToAddress(helper.GetAddress())
+			if !strings.EqualFold(validator.Signer.EthAddress().Hex(), signer.Hex()) {
+				return fmt.Errorf("Current key %v is not signer of validator %v", signer.Hex(), validatorID)
+			}
+
+			params, err := GetChainmanagerParams(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			stakingManagerAddress := params.ChainParams.StakingManagerAddress.EthAddress()
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			rotation, next, err := helper.PrepareKeyRotation(keyFile, stateFile, validatorID, height)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("New signer %v staged at %v\n", common.BytesToAddress(next.GetAddress()).Hex(), helper.NextKeyFile(keyFile))
+
+			nextPubkey, ok := next.GetPubKey().(secp256k1.PubKeySecp256k1)
+			if !ok {
+				return errors.New("Invalid new signer pubkey")
+			}
+			signerPubkey := nextPubkey[1:] // remove 04 prefix
+
+			if viper.GetBool(flagCalldataOnly) {
+				data, err := contractCaller.StakeManagerABI.Pack("updateSigner", new(big.Int).SetUint64(rotation.ValidatorID), signerPubkey)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("Send from validator owner\nTo: %v\nData: 0x%x\n", stakingManagerAddress.Hex(), data)
+				return nil
+			}
+
+			stakeManagerInstance, err := contractCaller.GetStakeManagerInstance(stakingManagerAddress)
+			if err != nil {
+				return err
+			}
+
+			if err := contractCaller.UpdateSigner(new(big.Int).SetUint64(rotation.ValidatorID), signerPubkey, stakingManagerAddress, stakeManagerInstance); err != nil {
+				// nothing was submitted, drop staged key
+				if cancelErr := helper.CancelKeyRotation(keyFile, stateFile); cancelErr != nil {
+					logger.Error("Error while cancelling key rotation", "error", cancelErr)
+				}
+
+				return err
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(stakingcli.FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().Bool(flagCalldataOnly, false, "--calldata-only=<print signer update calldata instead of sending it>")
+	cmd.Flags().Bool(flagCancel, false, "--cancel=<remove staged key of a rotation which is not active yet>")
+	return cmd
+}
+
+// getValidator returns validator by id along with height it was queried at
+func getValidator(cliCtx cliContext.CLIContext, validatorID uint64) (*hmTypes.Validator, int64, error) {
+	response, err := helper.FetchFromAPI(
+		cliCtx,
+		helper.GetHeimdallServerEndpoint(fmt.Sprintf(validatorEndpoint, validatorID)),
+	)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var validator hmTypes.Validator
+	if err := json.Unmarshal(response.Result, &validator); err != nil {
+		return nil, 0, err
+	}
+
+	return &validator, response.Height, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const flagInvariantsHeight = "height"
+
+func invariantsCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check the invariants of all modules against the application state",
+		Long: `Check the invariants of all modules against the application state at the latest
+height, or at the given height if it is still retained. The node must be stopped.
+
+Invariants can also be checked periodically while the node runs by setting
+invariant_check_period in heimdall-config.toml.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			db, err := sdk.NewLevelDB("application", config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			happ := app.NewHeimdallApp(logger, db)
+			if height := viper.GetInt64(flagInvariantsHeight); height != 0 {
+				if err := happ.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			height := happ.LastBlockHeight()
+			appCtx := happ.NewContext(true, abci.Header{Height: height})
+
+			var broken int
+			for _, route := range happ.Invariants().Routes() {
+				// run on a cached context so that invariants never write state
+				cacheCtx, _ := appCtx.CacheContext()
+				msg, isBroken := route.Invar(cacheCtx)
+				if isBroken {
+					broken++
+					fmt.Printf("BROKEN %s\n%s\n", route.FullRoute(), msg)
+					continue
+				}
+
+				fmt.Printf("OK     %s\n", route.FullRoute())
+			}
+
+			if broken != 0 {
+				return fmt.Errorf("%d invariants broken at height %d", broken, height)
+			}
+
+			fmt.Printf("All invariants hold at height %d\n", height)
+			return nil
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Int64(flagInvariantsHeight, 0, "--height=<height to check>, if left blank the latest height is used")
+	return cmd
+}
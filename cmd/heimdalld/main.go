@@ -99,6 +99,7 @@ func main() {
 		logger.Error("main | BindPFlag | helper.WithHeimdallConfigFlag", "Error", err)
 	}
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	wrapStartCmd(ctx, rootCmd)
 	rootCmd.AddCommand(showAccountCmd())
 	rootCmd.AddCommand(showPrivateKeyCmd())
 	rootCmd.AddCommand(hmserver.ServeCommands(cdc, hmserver.RegisterRoutes))
@@ -109,6 +110,15 @@ func main() {
 	// rollback cmd
 	rootCmd.AddCommand(rollbackCmd(ctx))
 
+	// snapshot cmd
+	rootCmd.AddCommand(snapshotCmd(ctx))
+
+	// migrate cmd
+	rootCmd.AddCommand(migrateGenesisCmd(ctx, cdc))
+
+	// invariants cmd
+	rootCmd.AddCommand(invariantsCmd(ctx))
+
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "HD", os.ExpandEnv("$HOME/.heimdalld"))
 	err := executor.Execute()
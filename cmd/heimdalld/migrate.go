@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+const (
+	flagGenesisTime = "genesis-time"
+)
+
+func migrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [target-version] [genesis-file]",
+		Short: "Migrate exported genesis to a target version",
+		Long: fmt.Sprintf(`Migrate the genesis exported by the previous version into the target version
+and print it to STDOUT. The migrated genesis is validated and a summary of the
+changed modules is printed to STDERR.
+
+Supported target versions: %s
+
+Example:
+$ heimdalld migrate v0.3 /path/to/genesis.json --chain-id=heimdall-137 --genesis-time=2021-01-01T00:00:00Z
+`, strings.Join(migrationVersions(), ", ")),
+		Args: cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			target := args[0]
+
+			genDoc, err := tmTypes.GenesisDocFromFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var appState app.GenesisState
+			if err := json.Unmarshal(genDoc.AppState, &appState); err != nil {
+				return err
+			}
+
+			newAppState, err := app.MigrateGenesis(target, appState)
+			if err != nil {
+				return err
+			}
+
+			if genDoc.AppState, err = cdc.MarshalJSON(newAppState); err != nil {
+				return err
+			}
+
+			if genesisTime := viper.GetString(flagGenesisTime); genesisTime != "" {
+				if genDoc.GenesisTime, err = time.Parse(time.RFC3339, genesisTime); err != nil {
+					return err
+				}
+			}
+
+			if chainID := viper.GetString(client.FlagChainID); chainID != "" {
+				genDoc.ChainID = chainID
+			}
+
+			if err := genDoc.ValidateAndComplete(); err != nil {
+				return err
+			}
+
+			out, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			for _, line := range genesisDiff(appState, newAppState) {
+				fmt.Fprintln(os.Stderr, line)
+			}
+
+			fmt.Println(string(sdk.MustSortJSON(out)))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagGenesisTime, "", "override genesis_time with this flag")
+	cmd.Flags().String(client.FlagChainID, "", "override chain_id with this flag")
+
+	return cmd
+}
+
+// migrationVersions returns the supported migration target versions
+func migrationVersions() []string {
+	versions := make([]string, 0, len(app.GenesisMigrations))
+	for version := range app.GenesisMigrations {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return versions
+}
+
+// genesisDiff returns a summary line for every module whose genesis state differs
+// between two app states, listing the changed top level fields
+func genesisDiff(oldState app.GenesisState, newState app.GenesisState) []string {
+	names := make(map[string]bool)
+	for name := range oldState {
+		names[name] = true
+	}
+	for name := range newState {
+		names[name] = true
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var lines []string
+	for _, name := range sortedNames {
+		oldModule, inOld := oldState[name]
+		newModule, inNew := newState[name]
+
+		switch {
+		case !inOld:
+			lines = append(lines, fmt.Sprintf("%s: added", name))
+		case !inNew:
+			lines = append(lines, fmt.Sprintf("%s: removed", name))
+		default:
+			if fields := changedFields(oldModule, newModule); len(fields) > 0 {
+				lines = append(lines, fmt.Sprintf("%s: changed %s", name, strings.Join(fields, ", ")))
+			}
+		}
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "no modules changed")
+	}
+
+	return lines
+}
+
+// changedFields returns the top level fields that differ between two json objects
+func changedFields(oldJSON json.RawMessage, newJSON json.RawMessage) []string {
+	var oldFields, newFields map[string]json.RawMessage
+	if json.Unmarshal(oldJSON, &oldFields) != nil || json.Unmarshal(newJSON, &newFields) != nil {
+		if jsonEqual(oldJSON, newJSON) {
+			return nil
+		}
+
+		return []string{"(all)"}
+	}
+
+	var fields []string
+	for field, value := range newFields {
+		if !jsonEqual(oldFields[field], value) {
+			fields = append(fields, field)
+		}
+	}
+
+	for field := range oldFields {
+		if _, ok := newFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
+// jsonEqual returns true if both json values are equal ignoring formatting and key order
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	sortedA, errA := sdk.SortJSON(a)
+	sortedB, errB := sdk.SortJSON(b)
+	if errA != nil || errB != nil {
+		return bytes.Equal(a, b)
+	}
+
+	return bytes.Equal(sortedA, sortedB)
+}
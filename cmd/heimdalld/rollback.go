@@ -2,23 +2,34 @@ package main
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/maticnetwork/heimdall/helper"
-	stakingcli "github.com/maticnetwork/heimdall/staking/client/cli"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/iavl"
 	"github.com/tendermint/tendermint/cmd/tendermint/commands"
+	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/cli"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/version"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/helper"
+	stakingcli "github.com/maticnetwork/heimdall/staking/client/cli"
 )
 
+const flagRollbackHeight = "height"
+
 func rollbackCmd(ctx *server.Context) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback cosmos-sdk and tendermint state by one height",
+		Short: "rollback cosmos-sdk and tendermint state by one height or to a given height",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when Tendermint has persisted an incorrect app hash and is thus unable to make
@@ -26,6 +37,10 @@ progress. Rollback overwrites a state at height n with the state at height n - 1
 The application also roll back to height n - 1. No blocks are removed, so upon
 restarting Tendermint the transactions in block n will be re-executed against the
 application.
+
+With --height, both states are rolled back to the given height in one step. The
+height must still be retained by the application stores (see pruning) and the
+stores which changed since that height are reported.
 `,
 		Args: cobra.NoArgs,
 		RunE: func(_ *cobra.Command, _ []string) error {
@@ -37,6 +52,16 @@ application.
 				return err
 			}
 
+			if targetHeight := viper.GetInt64(flagRollbackHeight); targetHeight != 0 {
+				changedStores, err := rollbackToHeight(config, db, targetHeight)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("Stores changed since height %d: %v\n", targetHeight, changedStores)
+				return nil
+			}
+
 			height, hash, err := commands.RollbackState(config)
 
 			if err != nil {
@@ -53,5 +78,242 @@ application.
 	cmd.Flags().String(helper.FlagClientHome, helper.DefaultCLIHome, "client's home directory")
 	cmd.Flags().String(client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().Int(stakingcli.FlagValidatorID, 1, "--id=<validator ID here>, if left blank will be assigned 1")
+	cmd.Flags().Int64(flagRollbackHeight, 0, "--height=<height to rollback to>, if left blank state is rolled back by one height")
 	return cmd
 }
+
+// rollbackToHeight rolls back tendermint state and application stores to the target height.
+// It returns the names of the stores which changed since the target height.
+func rollbackToHeight(config *cfg.Config, db dbm.DB, targetHeight int64) ([]string, error) {
+	latestHeight, err := getLatestAppVersion(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetHeight <= 0 || targetHeight >= latestHeight {
+		return nil, fmt.Errorf("rollback height must be between 1 and %d, got %d", latestHeight-1, targetHeight)
+	}
+
+	latestInfo, err := getCommitInfo(db, latestHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	targetInfo, err := getCommitInfo(db, targetHeight)
+	if err != nil {
+		return nil, fmt.Errorf("height %d is not retained by the application: %v", targetHeight, err)
+	}
+
+	// validate all stores and the tendermint state before touching anything
+	trees, err := loadStoresForRollback(db, targetInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	rolledBackState, err := loadTendermintRollback(config, targetHeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rollback tendermint state: %w", err)
+	}
+
+	// the application is rolled back first. If it fails, tendermint is still at the latest
+	// height, and if tendermint is not rolled back afterwards it replays the blocks to the app.
+	if err := rollbackAppState(db, trees, targetInfo, latestInfo); err != nil {
+		return nil, fmt.Errorf("failed to rollback application state: %w", err)
+	}
+
+	if err := saveTendermintRollback(config, rolledBackState); err != nil {
+		return nil, fmt.Errorf("failed to rollback tendermint state: %w", err)
+	}
+
+	fmt.Printf("Rolled back state from height %d to height %d and hash %X\n", latestHeight, targetHeight, rolledBackState.AppHash)
+	return getChangedStores(targetInfo, latestInfo), nil
+}
+
+// loadStoresForRollback loads the iavl tree of every store present at target commit
+// and checks that the target version has not been pruned
+func loadStoresForRollback(db dbm.DB, target commitInfo) (map[string]*iavl.MutableTree, error) {
+	trees := make(map[string]*iavl.MutableTree, len(target.StoreInfos))
+	for _, info := range target.StoreInfos {
+		tree := iavl.NewMutableTree(getStoreDB(db, info.Name), 0)
+		if _, err := tree.LoadVersion(0); err != nil {
+			return nil, fmt.Errorf("failed to load %s store: %v", info.Name, err)
+		}
+
+		if !tree.VersionExists(info.Core.CommitID.Version) {
+			return nil, fmt.Errorf("height %d is not retained by %s store", target.Version, info.Name)
+		}
+
+		trees[info.Name] = tree
+	}
+
+	return trees, nil
+}
+
+// rollbackAppState deletes all versions of the stores above the target commit and
+// points the multistore to the target height
+func rollbackAppState(db dbm.DB, trees map[string]*iavl.MutableTree, target commitInfo, latest commitInfo) error {
+	for _, info := range target.StoreInfos {
+		if _, err := trees[info.Name].LoadVersionForOverwriting(info.Core.CommitID.Version); err != nil {
+			return fmt.Errorf("failed to rollback %s store: %v", info.Name, err)
+		}
+	}
+
+	// stores mounted after target height don't exist at it
+	for _, info := range latest.StoreInfos {
+		if _, ok := target.getStoreInfo(info.Name); !ok {
+			deleteAllKeys(getStoreDB(db, info.Name))
+		}
+	}
+
+	cms := rootmulti.NewStore(db)
+	cms.RollbackToVersion(target.Version)
+	return nil
+}
+
+// deleteAllKeys removes every key in the db
+func deleteAllKeys(db dbm.DB) {
+	var keys [][]byte
+
+	iterator := db.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		db.Delete(key)
+	}
+}
+
+// loadTendermintRollback returns the tendermint state at the target height without writing it
+func loadTendermintRollback(config *cfg.Config, targetHeight int64) (state.State, error) {
+	blockStoreDB, stateDB, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return state.State{}, err
+	}
+	defer blockStoreDB.Close()
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	latestState := state.LoadState(stateDB)
+
+	height := blockStore.Height()
+	if height != latestState.LastBlockHeight && height != latestState.LastBlockHeight+1 {
+		return state.State{}, fmt.Errorf("statestore height (%d) is not one below or equal to blockstore height (%d)",
+			latestState.LastBlockHeight, height)
+	}
+
+	if targetHeight >= latestState.LastBlockHeight {
+		return state.State{}, fmt.Errorf("rollback height %d must be below tendermint state height %d", targetHeight, latestState.LastBlockHeight)
+	}
+
+	return loadStateAtHeight(stateDB, blockStore, latestState, targetHeight)
+}
+
+// saveTendermintRollback overwrites the tendermint state with the rolled back state.
+// Blocks are not removed, so upon restart the block after the rolled back state is
+// re-executed and later blocks are synced again.
+func saveTendermintRollback(config *cfg.Config, rolledBackState state.State) error {
+	blockStoreDB, stateDB, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	defer stateDB.Close()
+
+	state.SaveState(stateDB, rolledBackState)
+
+	// tendermint replays at most one block on restart, so the block store
+	// must not be ahead of the rolled back state by more than one height
+	store.BlockStoreStateJSON{Height: rolledBackState.LastBlockHeight + 1}.Save(blockStoreDB)
+
+	return nil
+}
+
+// loadStateAtHeight rebuilds the tendermint state as it was after committing the block at
+// the target height. The block at target + 1 must be stored as it carries the app hash.
+func loadStateAtHeight(stateDB dbm.DB, blockStore *store.BlockStore, latestState state.State, targetHeight int64) (state.State, error) {
+	rollbackBlock := blockStore.LoadBlockMeta(targetHeight)
+	if rollbackBlock == nil {
+		return state.State{}, fmt.Errorf("block at height %d not found", targetHeight)
+	}
+
+	// the app hash and last results hash are only agreed upon in the following block
+	nextBlock := blockStore.LoadBlockMeta(targetHeight + 1)
+	if nextBlock == nil {
+		return state.State{}, fmt.Errorf("block at height %d not found", targetHeight+1)
+	}
+
+	lastValidators, err := state.LoadValidators(stateDB, targetHeight)
+	if err != nil {
+		return state.State{}, err
+	}
+
+	validators, err := state.LoadValidators(stateDB, targetHeight+1)
+	if err != nil {
+		return state.State{}, err
+	}
+
+	nextValidators, err := state.LoadValidators(stateDB, targetHeight+2)
+	if err != nil {
+		return state.State{}, err
+	}
+
+	consensusParams, err := state.LoadConsensusParams(stateDB, targetHeight+1)
+	if err != nil {
+		return state.State{}, err
+	}
+
+	valChangeHeight := latestState.LastHeightValidatorsChanged
+	if valChangeHeight > targetHeight {
+		valChangeHeight = targetHeight + 1
+	}
+
+	paramsChangeHeight := latestState.LastHeightConsensusParamsChanged
+	if paramsChangeHeight > targetHeight {
+		paramsChangeHeight = targetHeight + 1
+	}
+
+	return state.State{
+		Version: state.Version{
+			Consensus: version.Consensus{
+				Block: version.BlockProtocol,
+				App:   0,
+			},
+			Software: version.TMCoreSemVer,
+		},
+		// immutable fields
+		ChainID: latestState.ChainID,
+
+		LastBlockHeight:  rollbackBlock.Header.Height,
+		LastBlockTotalTx: rollbackBlock.Header.TotalTxs,
+		LastBlockID:      rollbackBlock.BlockID,
+		LastBlockTime:    rollbackBlock.Header.Time,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+	}, nil
+}
+
+// loadStateAndBlockStore opens the tendermint block store and state dbs
+func loadStateAndBlockStore(config *cfg.Config) (dbm.DB, dbm.DB, error) {
+	dbType := dbm.DBBackendType(config.DBBackend)
+
+	if !tmos.FileExists(filepath.Join(config.DBDir(), "blockstore.db")) {
+		return nil, nil, fmt.Errorf("no blockstore found in %v", config.DBDir())
+	}
+
+	if !tmos.FileExists(filepath.Join(config.DBDir(), "state.db")) {
+		return nil, nil, fmt.Errorf("no statestore found in %v", config.DBDir())
+	}
+
+	return dbm.NewDB("blockstore", dbType, config.DBDir()), dbm.NewDB("state", dbType, config.DBDir()), nil
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// testChain commits blocks to the tendermint and application dbs of a node home
+type testChain struct {
+	t      *testing.T
+	config *cfg.Config
+
+	db      dbm.DB
+	cms     *rootmulti.Store
+	keys    map[string]*sdk.KVStoreKey
+	pruning storeTypes.PruningOptions
+
+	stateDB      dbm.DB
+	blockStoreDB dbm.DB
+	blockStore   *store.BlockStore
+	state        state.State
+	seenCommit   *tmTypes.Commit
+}
+
+// newTestChain creates a node home with the given application stores mounted
+func newTestChain(t *testing.T, pruning storeTypes.PruningOptions, storeNames ...string) *testChain {
+	config := newTestHome(t)
+
+	genesisState, err := state.MakeGenesisState(&tmTypes.GenesisDoc{
+		ChainID:     "heimdall-test",
+		GenesisTime: time.Unix(1000, 0).UTC(),
+		Validators: []tmTypes.GenesisValidator{{
+			PubKey: ed25519.GenPrivKey().PubKey(),
+			Power:  10,
+		}},
+	})
+	require.NoError(t, err)
+
+	c := &testChain{
+		t:       t,
+		config:  config,
+		keys:    make(map[string]*sdk.KVStoreKey),
+		pruning: pruning,
+		state:   genesisState,
+	}
+	c.open()
+	state.SaveState(c.stateDB, c.state)
+
+	for _, name := range storeNames {
+		c.keys[name] = sdk.NewKVStoreKey(name)
+	}
+	c.loadStores()
+
+	return c
+}
+
+// newTestHome returns the config of an empty node home
+func newTestHome(t *testing.T) *cfg.Config {
+	home, err := ioutil.TempDir("", "heimdalld")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(home) })
+
+	config := cfg.TestConfig()
+	config.SetRoot(home)
+	config.DBBackend = string(dbm.GoLevelDBBackend)
+	return config
+}
+
+// open opens the dbs of the node home
+func (c *testChain) open() {
+	var err error
+	c.db, err = sdk.NewLevelDB("application", c.config.DBDir())
+	require.NoError(c.t, err)
+
+	dbType := dbm.DBBackendType(c.config.DBBackend)
+	c.stateDB = dbm.NewDB("state", dbType, c.config.DBDir())
+	c.blockStoreDB = dbm.NewDB("blockstore", dbType, c.config.DBDir())
+	c.blockStore = store.NewBlockStore(c.blockStoreDB)
+}
+
+// close closes the dbs of the node home, so that commands can open them
+func (c *testChain) close() {
+	c.db.Close()
+	c.stateDB.Close()
+	c.blockStoreDB.Close()
+}
+
+// loadStores mounts the stores on a new multistore at the latest version
+func (c *testChain) loadStores() {
+	c.cms = rootmulti.NewStore(c.db)
+	c.cms.SetPruning(c.pruning)
+	for _, key := range c.keys {
+		c.cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+	require.NoError(c.t, c.cms.LoadLatestVersion())
+}
+
+// mountStore adds a store to the application, as done by an upgrade
+func (c *testChain) mountStore(name string) {
+	c.keys[name] = sdk.NewKVStoreKey(name)
+	c.loadStores()
+}
+
+// commit writes the values to the application stores and commits the next block.
+// The app hash of a block is the app hash after the previous block.
+func (c *testChain) commit(values map[string]map[string]string) {
+	for name, kvs := range values {
+		// iavl tree shape depends on the order of writes
+		keys := make([]string, 0, len(kvs))
+		for key := range kvs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		kvStore := c.cms.GetKVStore(c.keys[name])
+		for _, key := range keys {
+			kvStore.Set([]byte(key), []byte(kvs[key]))
+		}
+	}
+	commitID := c.cms.Commit()
+
+	height := c.state.LastBlockHeight + 1
+	lastCommit := c.seenCommit
+	if lastCommit == nil {
+		lastCommit = new(tmTypes.Commit)
+	}
+
+	block, _ := c.state.MakeBlock(height, nil, lastCommit, nil, c.state.Validators.GetProposer().Address)
+	block.Time = c.state.LastBlockTime.Add(time.Second)
+	parts := block.MakePartSet(tmTypes.BlockPartSizeBytes)
+	blockID := tmTypes.BlockID{Hash: block.Hash(), PartsHeader: parts.Header()}
+
+	c.seenCommit = tmTypes.NewCommit(blockID, nil)
+	c.blockStore.SaveBlock(block, parts, c.seenCommit)
+
+	c.state.LastBlockHeight = height
+	c.state.LastBlockID = blockID
+	c.state.LastBlockTime = block.Time
+	c.state.LastValidators = c.state.Validators.Copy()
+	c.state.AppHash = commitID.Hash
+	state.SaveState(c.stateDB, c.state)
+}
+
+// testRollbackChain commits 5 heights. Store "c" is mounted at height 4.
+func testRollbackChain(t *testing.T, pruning storeTypes.PruningOptions) *testChain {
+	c := newTestChain(t, pruning, "a", "b")
+	c.commit(map[string]map[string]string{"a": {"k1": "1"}, "b": {"k1": "1"}})
+	c.commit(map[string]map[string]string{"a": {"k2": "2"}})
+	c.commit(map[string]map[string]string{"a": {"k3": "3"}})
+	c.mountStore("c")
+	c.commit(map[string]map[string]string{"c": {"k4": "4"}})
+	c.commit(map[string]map[string]string{"a": {"k5": "5"}})
+	return c
+}
+
+func TestRollbackToHeight(t *testing.T) {
+	c := testRollbackChain(t, storeTypes.PruneNothing)
+
+	appHashes := make(map[int64][]byte)
+	for height := int64(1); height <= 5; height++ {
+		cInfo, err := getCommitInfo(c.db, height)
+		require.NoError(t, err)
+		appHashes[height] = cInfo.hash()
+	}
+	require.Equal(t, c.state.AppHash, appHashes[5])
+	c.close()
+
+	db, err := sdk.NewLevelDB("application", c.config.DBDir())
+	require.NoError(t, err)
+
+	// roll back 3 heights in one step
+	changedStores, err := rollbackToHeight(c.config, db, 2)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"a", "c"}, changedStores)
+
+	latestHeight, err := getLatestAppVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latestHeight)
+	db.Close()
+
+	c.open()
+	defer c.close()
+
+	// tendermint resumes from height 2 and replays block 3
+	tmState := state.LoadState(c.stateDB)
+	require.Equal(t, int64(2), tmState.LastBlockHeight)
+	require.Equal(t, appHashes[2], tmState.AppHash)
+	require.Equal(t, c.blockStore.LoadBlockMeta(2).BlockID, tmState.LastBlockID)
+	require.Equal(t, int64(3), store.LoadBlockStoreStateJSON(c.blockStoreDB).Height)
+
+	// application loads at height 2 with values of later heights removed
+	delete(c.keys, "c")
+	c.loadStores()
+	require.Equal(t, int64(2), c.cms.LastCommitID().Version)
+	require.Equal(t, appHashes[2], c.cms.LastCommitID().Hash)
+
+	a := c.cms.GetKVStore(c.keys["a"])
+	require.Equal(t, []byte("2"), a.Get([]byte("k2")))
+	require.Nil(t, a.Get([]byte("k3")))
+	require.Nil(t, a.Get([]byte("k5")))
+
+	// store mounted after height 2 is removed
+	iterator := getStoreDB(c.db, "c").Iterator(nil, nil)
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	// re-executing block 3 against the rolled back state gives the same app hash
+	a.Set([]byte("k3"), []byte("3"))
+	require.Equal(t, appHashes[3], c.cms.Commit().Hash)
+	require.Equal(t, []string{"a"}, getChangedStores(mustGetCommitInfo(t, c.db, 2), mustGetCommitInfo(t, c.db, 3)))
+}
+
+func TestRollbackToHeightInvalid(t *testing.T) {
+	c := testRollbackChain(t, storeTypes.NewPruningOptions(2, 0))
+	c.close()
+
+	db, err := sdk.NewLevelDB("application", c.config.DBDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, height := range []int64{-1, 5, 6} {
+		_, err := rollbackToHeight(c.config, db, height)
+		require.Error(t, err, "height %d", height)
+	}
+
+	// versions below height 3 have been pruned
+	_, err = rollbackToHeight(c.config, db, 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not retained")
+
+	// nothing is rolled back on failure
+	latestHeight, err := getLatestAppVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latestHeight)
+
+	stateDB := dbm.NewDB("state", dbm.GoLevelDBBackend, c.config.DBDir())
+	require.Equal(t, int64(5), state.LoadState(stateDB).LastBlockHeight)
+	stateDB.Close()
+
+	// application stores are untouched if the tendermint state can't be rolled back
+	blockStoreDB := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, c.config.DBDir())
+	store.BlockStoreStateJSON{Height: 7}.Save(blockStoreDB)
+	blockStoreDB.Close()
+
+	_, err = rollbackToHeight(c.config, db, 4)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "tendermint")
+
+	latestHeight, err = getLatestAppVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latestHeight)
+}
+
+func TestGetChangedStores(t *testing.T) {
+	from := commitInfo{Version: 1, StoreInfos: []storeInfo{
+		{Name: "a", Core: storeCore{CommitID: sdk.CommitID{Version: 1, Hash: []byte{1}}}},
+		{Name: "b", Core: storeCore{CommitID: sdk.CommitID{Version: 1, Hash: []byte{1}}}},
+		{Name: "c", Core: storeCore{CommitID: sdk.CommitID{Version: 1, Hash: []byte{1}}}},
+	}}
+	to := commitInfo{Version: 3, StoreInfos: []storeInfo{
+		{Name: "a", Core: storeCore{CommitID: sdk.CommitID{Version: 3, Hash: []byte{1}}}},
+		{Name: "b", Core: storeCore{CommitID: sdk.CommitID{Version: 3, Hash: []byte{2}}}},
+		{Name: "d", Core: storeCore{CommitID: sdk.CommitID{Version: 3, Hash: []byte{1}}}},
+	}}
+
+	require.Equal(t, []string{"b", "d", "c"}, getChangedStores(from, to))
+	require.Empty(t, getChangedStores(from, from))
+}
+
+func mustGetCommitInfo(t *testing.T, db dbm.DB, version int64) commitInfo {
+	cInfo, err := getCommitInfo(db, version)
+	require.NoError(t, err)
+	return cInfo
+}
@@ -0,0 +1,639 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	amino "github.com/tendermint/go-amino"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const (
+	flagSnapshotHeight    = "height"
+	flagSnapshotChunkSize = "chunk-size"
+	flagTrustedAppHash    = "trusted-app-hash"
+
+	// snapshotFormat is the version of the snapshot layout, bumped on incompatible changes
+	snapshotFormat uint32 = 1
+
+	snapshotManifestFile   = "manifest.json"
+	snapshotTendermintFile = "tendermint.json"
+	snapshotStoresDir      = "stores"
+
+	defaultSnapshotChunkSize = 16 * 1024 * 1024
+)
+
+// snapshotCodec encodes the tendermint part of a snapshot
+var snapshotCodec = codec.New()
+
+func init() {
+	tmTypes.RegisterBlockAmino(snapshotCodec)
+}
+
+// snapshotManifest describes a snapshot and the files it consists of
+type snapshotManifest struct {
+	Format     uint32           `json:"format"`
+	ChainID    string           `json:"chain_id"`
+	Height     int64            `json:"height"`
+	AppHash    hmTypes.HexBytes `json:"app_hash"`
+	Stores     []snapshotStore  `json:"stores"`
+	Tendermint snapshotFile     `json:"tendermint"`
+}
+
+// snapshotStore describes the iavl tree of a module store. The chunks hold the
+// persisted tree nodes in post-order, each one prefixed by its length.
+// The hash of an empty tree is omitted, as empty hex bytes don't decode back to empty.
+type snapshotStore struct {
+	Name    string           `json:"name"`
+	Version int64            `json:"version"`
+	Hash    hmTypes.HexBytes `json:"hash,omitempty"`
+	Nodes   int64            `json:"nodes"`
+	Chunks  []snapshotFile   `json:"chunks"`
+}
+
+// snapshotFile is a file of the snapshot with its sha256 hash
+type snapshotFile struct {
+	File string           `json:"file"`
+	Size int64            `json:"size"`
+	Hash hmTypes.HexBytes `json:"hash"`
+}
+
+// snapshotTendermint holds the tendermint state and the last block at the snapshot height
+type snapshotTendermint struct {
+	State      state.State     `json:"state"`
+	Block      *tmTypes.Block  `json:"block"`
+	SeenCommit *tmTypes.Commit `json:"seen_commit"`
+}
+
+func snapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "create and restore state snapshots for fast node bootstrap",
+	}
+
+	cmd.AddCommand(
+		snapshotCreateCmd(ctx),
+		snapshotRestoreCmd(ctx),
+	)
+
+	return cmd
+}
+
+func snapshotCreateCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [output-dir]",
+		Short: "create a snapshot of the application and tendermint state at a height",
+		Long: `
+Create a snapshot of every module store and of the tendermint state at the given height,
+or at the latest height if none is given. The node must be stopped.
+
+The snapshot directory holds a manifest describing the snapshot, the chunked iavl
+trees of the module stores and the tendermint state. Every file is hashed in the
+manifest and the store hashes add up to the app hash of the snapshot height.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			manifest, err := createSnapshot(config, args[0], viper.GetInt64(flagSnapshotHeight), viper.GetInt(flagSnapshotChunkSize))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Created snapshot of height %d with app hash %X in %s\n", manifest.Height, manifest.AppHash.Bytes(), args[0])
+			return nil
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().Int64(flagSnapshotHeight, 0, "--height=<height to snapshot>, if left blank the latest height is used")
+	cmd.Flags().Int(flagSnapshotChunkSize, defaultSnapshotChunkSize, "maximum size of a chunk in bytes")
+	return cmd
+}
+
+func snapshotRestoreCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [snapshot-dir]",
+		Short: "restore the application and tendermint state from a snapshot",
+		Long: `
+Restore a snapshot into an empty node home. Every chunk is verified against the manifest,
+every iavl node against its hash and the store hashes against the app hash of the
+snapshot, which is in turn checked against --trusted-app-hash when given.
+
+The node syncs the blocks following the snapshot height from its peers on start. If the
+restore fails, the data directory must be reset before trying again.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			var trustedAppHash hmTypes.HexBytes
+			if hash := viper.GetString(flagTrustedAppHash); hash != "" {
+				trustedAppHash = hmTypes.HexToHexBytes(hash)
+				if trustedAppHash.Empty() {
+					return fmt.Errorf("invalid trusted app hash %s", hash)
+				}
+			}
+
+			manifest, err := restoreSnapshot(config, args[0], trustedAppHash)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Restored snapshot of height %d with app hash %X\n", manifest.Height, manifest.AppHash.Bytes())
+			return nil
+		},
+	}
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagTrustedAppHash, "", "--trusted-app-hash=<hex app hash of the snapshot height>, obtained from a trusted source")
+	return cmd
+}
+
+//
+// Create
+//
+
+// createSnapshot writes a snapshot of the given height to the output directory
+func createSnapshot(config *cfg.Config, outputDir string, height int64, chunkSize int) (snapshotManifest, error) {
+	var manifest snapshotManifest
+
+	if chunkSize <= 0 {
+		return manifest, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	if tmos.FileExists(filepath.Join(outputDir, snapshotManifestFile)) {
+		return manifest, fmt.Errorf("snapshot already exists in %s", outputDir)
+	}
+
+	db, err := sdk.NewLevelDB("application", config.DBDir())
+	if err != nil {
+		return manifest, err
+	}
+	defer db.Close()
+
+	latestHeight, err := getLatestAppVersion(db)
+	if err != nil {
+		return manifest, err
+	}
+
+	if height == 0 {
+		height = latestHeight
+	}
+
+	if height <= 0 || height > latestHeight {
+		return manifest, fmt.Errorf("snapshot height must be between 1 and %d, got %d", latestHeight, height)
+	}
+
+	cInfo, err := getCommitInfo(db, height)
+	if err != nil {
+		return manifest, fmt.Errorf("height %d is not retained by the application: %v", height, err)
+	}
+
+	tm, err := loadSnapshotTendermint(config, height)
+	if err != nil {
+		return manifest, err
+	}
+
+	appHash := cInfo.hash()
+	if !bytes.Equal(tm.State.AppHash, appHash) {
+		return manifest, fmt.Errorf("app hash %X of the application differs from app hash %X of tendermint at height %d", appHash, tm.State.AppHash, height)
+	}
+
+	manifest = snapshotManifest{
+		Format:  snapshotFormat,
+		ChainID: tm.State.ChainID,
+		Height:  height,
+		AppHash: appHash,
+	}
+
+	for _, info := range cInfo.StoreInfos {
+		s, err := exportStore(db, outputDir, info, chunkSize)
+		if err != nil {
+			return manifest, fmt.Errorf("failed to export %s store: %v", info.Name, err)
+		}
+
+		manifest.Stores = append(manifest.Stores, s)
+	}
+
+	tmBytes, err := snapshotCodec.MarshalJSONIndent(tm, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+
+	if manifest.Tendermint, err = writeSnapshotFile(outputDir, snapshotTendermintFile, tmBytes); err != nil {
+		return manifest, err
+	}
+
+	// the manifest is written last, so that only complete snapshots have one
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+
+	_, err = writeSnapshotFile(outputDir, snapshotManifestFile, manifestBytes)
+	return manifest, err
+}
+
+// loadSnapshotTendermint loads the tendermint state, block and seen commit at the given height
+func loadSnapshotTendermint(config *cfg.Config, height int64) (tm snapshotTendermint, err error) {
+	blockStoreDB, stateDB, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return tm, err
+	}
+	defer blockStoreDB.Close()
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	latestState := state.LoadState(stateDB)
+
+	switch {
+	case height == latestState.LastBlockHeight:
+		tm.State = latestState
+	case height < latestState.LastBlockHeight:
+		if tm.State, err = loadStateAtHeight(stateDB, blockStore, latestState, height); err != nil {
+			return tm, err
+		}
+	default:
+		return tm, fmt.Errorf("snapshot height %d is above tendermint state height %d", height, latestState.LastBlockHeight)
+	}
+
+	if tm.Block = blockStore.LoadBlock(height); tm.Block == nil {
+		return tm, fmt.Errorf("block at height %d not found", height)
+	}
+
+	if tm.SeenCommit = blockStore.LoadSeenCommit(height); tm.SeenCommit == nil {
+		return tm, fmt.Errorf("seen commit at height %d not found", height)
+	}
+
+	return tm, nil
+}
+
+// exportStore writes the iavl tree of a store at its version in the commit as chunks
+func exportStore(db dbm.DB, outputDir string, info storeInfo, chunkSize int) (snapshotStore, error) {
+	storeDB := getStoreDB(db, info.Name)
+	version := info.Core.CommitID.Version
+
+	rootKey := iavlRootKeyFormat.Key(version)
+	if !storeDB.Has(rootKey) {
+		return snapshotStore{}, fmt.Errorf("version %d is not retained", version)
+	}
+
+	rootHash := storeDB.Get(rootKey)
+	if !bytes.Equal(rootHash, info.Core.CommitID.Hash) {
+		return snapshotStore{}, fmt.Errorf("root hash %X differs from commit hash %X", rootHash, info.Core.CommitID.Hash)
+	}
+
+	w := &snapshotChunkWriter{
+		dir:       outputDir,
+		store:     info.Name,
+		chunkSize: chunkSize,
+	}
+
+	// an empty tree has no nodes
+	if len(rootHash) != 0 {
+		if err := exportIAVLNode(storeDB, rootHash, w); err != nil {
+			return snapshotStore{}, err
+		}
+	}
+
+	if err := w.flush(); err != nil {
+		return snapshotStore{}, err
+	}
+
+	return snapshotStore{
+		Name:    info.Name,
+		Version: version,
+		Hash:    info.Core.CommitID.Hash,
+		Nodes:   w.nodes,
+		Chunks:  w.chunks,
+	}, nil
+}
+
+// exportIAVLNode writes the subtree under the node in post-order, children before their parent
+func exportIAVLNode(db dbm.DB, hash []byte, w *snapshotChunkWriter) error {
+	bz := db.Get(iavlNodeKeyFormat.Key(hash))
+	if bz == nil {
+		return fmt.Errorf("node %X not found", hash)
+	}
+
+	node, err := decodeIAVLNode(bz)
+	if err != nil {
+		return err
+	}
+
+	if !node.isLeaf() {
+		if err := exportIAVLNode(db, node.leftHash, w); err != nil {
+			return err
+		}
+
+		if err := exportIAVLNode(db, node.rightHash, w); err != nil {
+			return err
+		}
+	}
+
+	return w.write(bz)
+}
+
+// snapshotChunkWriter splits the nodes of a store into chunks of about chunkSize bytes
+type snapshotChunkWriter struct {
+	dir       string
+	store     string
+	chunkSize int
+
+	buf    bytes.Buffer
+	nodes  int64
+	chunks []snapshotFile
+}
+
+// write adds a node to the current chunk
+func (w *snapshotChunkWriter) write(node []byte) error {
+	if err := amino.EncodeByteSlice(&w.buf, node); err != nil {
+		return err
+	}
+	w.nodes++
+
+	if w.buf.Len() >= w.chunkSize {
+		return w.flush()
+	}
+
+	return nil
+}
+
+// flush writes the current chunk to disk
+func (w *snapshotChunkWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	name := path.Join(snapshotStoresDir, w.store, fmt.Sprintf("%06d.chunk", len(w.chunks)))
+
+	chunk, err := writeSnapshotFile(w.dir, name, w.buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	w.chunks = append(w.chunks, chunk)
+	w.buf.Reset()
+
+	return nil
+}
+
+// writeSnapshotFile writes a file of the snapshot and returns its description
+func writeSnapshotFile(dir string, name string, bz []byte) (snapshotFile, error) {
+	filePath := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return snapshotFile{}, err
+	}
+
+	if err := ioutil.WriteFile(filePath, bz, 0644); err != nil {
+		return snapshotFile{}, err
+	}
+
+	hash := sha256.Sum256(bz)
+
+	return snapshotFile{
+		File: name,
+		Size: int64(len(bz)),
+		Hash: hash[:],
+	}, nil
+}
+
+//
+// Restore
+//
+
+// restoreSnapshot verifies the snapshot in the directory and restores it into the node home
+func restoreSnapshot(config *cfg.Config, dir string, trustedAppHash hmTypes.HexBytes) (snapshotManifest, error) {
+	var manifest snapshotManifest
+
+	manifestBytes, err := ioutil.ReadFile(filepath.Join(dir, snapshotManifestFile))
+	if err != nil {
+		return manifest, err
+	}
+
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return manifest, fmt.Errorf("failed to decode snapshot manifest: %v", err)
+	}
+
+	if manifest.Format != snapshotFormat {
+		return manifest, fmt.Errorf("unsupported snapshot format %d, expected %d", manifest.Format, snapshotFormat)
+	}
+
+	// the store hashes of the manifest must add up to the app hash
+	cInfo := commitInfo{Version: manifest.Height}
+	for _, s := range manifest.Stores {
+		cInfo.StoreInfos = append(cInfo.StoreInfos, storeInfo{
+			Name: s.Name,
+			Core: storeCore{CommitID: sdk.CommitID{Version: s.Version, Hash: s.Hash}},
+		})
+	}
+
+	appHash := cInfo.hash()
+	if !manifest.AppHash.Equals(appHash) {
+		return manifest, fmt.Errorf("store hashes add up to app hash %X instead of %X", appHash, manifest.AppHash.Bytes())
+	}
+
+	if trustedAppHash != nil && !trustedAppHash.Equals(appHash) {
+		return manifest, fmt.Errorf("snapshot app hash %X differs from trusted app hash %X", appHash, trustedAppHash.Bytes())
+	}
+
+	tm, err := readSnapshotTendermint(dir, manifest)
+	if err != nil {
+		return manifest, err
+	}
+
+	// refuse to overwrite an existing node
+	for _, name := range []string{"blockstore.db", "state.db"} {
+		if tmos.FileExists(filepath.Join(config.DBDir(), name)) {
+			return manifest, fmt.Errorf("%s already exists in %v, reset the node before restoring", name, config.DBDir())
+		}
+	}
+
+	db, err := sdk.NewLevelDB("application", config.DBDir())
+	if err != nil {
+		return manifest, err
+	}
+	defer db.Close()
+
+	if latestHeight, err := getLatestAppVersion(db); err != nil || latestHeight != 0 {
+		return manifest, fmt.Errorf("application state already exists in %v, reset the node before restoring", config.DBDir())
+	}
+
+	for _, s := range manifest.Stores {
+		if err := importStore(db, dir, s); err != nil {
+			return manifest, fmt.Errorf("failed to import %s store: %v", s.Name, err)
+		}
+	}
+
+	setCommitInfo(db, cInfo)
+	restoreTendermintState(config, tm)
+
+	return manifest, nil
+}
+
+// readSnapshotTendermint reads the tendermint state of the snapshot and checks it against the manifest
+func readSnapshotTendermint(dir string, manifest snapshotManifest) (tm snapshotTendermint, err error) {
+	bz, err := readSnapshotFile(dir, manifest.Tendermint)
+	if err != nil {
+		return tm, err
+	}
+
+	if err := snapshotCodec.UnmarshalJSON(bz, &tm); err != nil {
+		return tm, fmt.Errorf("failed to decode tendermint state: %v", err)
+	}
+
+	if tm.Block == nil || tm.SeenCommit == nil {
+		return tm, fmt.Errorf("tendermint state is missing the block at height %d", manifest.Height)
+	}
+
+	if tm.State.ChainID != manifest.ChainID || tm.State.LastBlockHeight != manifest.Height || tm.Block.Height != manifest.Height {
+		return tm, fmt.Errorf("tendermint state does not match the snapshot chain and height")
+	}
+
+	if !manifest.AppHash.Equals(tm.State.AppHash) {
+		return tm, fmt.Errorf("tendermint app hash %X differs from snapshot app hash %X", tm.State.AppHash, manifest.AppHash.Bytes())
+	}
+
+	if !bytes.Equal(tm.Block.Hash(), tm.State.LastBlockID.Hash) {
+		return tm, fmt.Errorf("block hash %X differs from last block id %X", tm.Block.Hash(), tm.State.LastBlockID.Hash)
+	}
+
+	if !tm.Block.MakePartSet(tmTypes.BlockPartSizeBytes).Header().Equals(tm.State.LastBlockID.PartsHeader) {
+		return tm, fmt.Errorf("block parts differ from last block id %X", tm.State.LastBlockID.Hash)
+	}
+
+	if !tm.SeenCommit.BlockID.Equals(tm.State.LastBlockID) {
+		return tm, fmt.Errorf("seen commit is not for block %X", tm.State.LastBlockID.Hash)
+	}
+
+	return tm, nil
+}
+
+// importStore writes the nodes of a store and verifies they form the tree of the store hash
+func importStore(db dbm.DB, dir string, s snapshotStore) error {
+	storeDB := getStoreDB(db, s.Name)
+
+	// nodes come in post-order, so the hashes of the subtrees written so far are on
+	// the stack and an inner node must be the parent of the two on top of it
+	var stack [][]byte
+	var nodes int64
+
+	for _, chunk := range s.Chunks {
+		bz, err := readSnapshotFile(dir, chunk)
+		if err != nil {
+			return err
+		}
+
+		batch := storeDB.NewBatch()
+		for len(bz) > 0 {
+			nodeBytes, n, err := amino.DecodeByteSlice(bz)
+			if err != nil {
+				batch.Close()
+				return fmt.Errorf("failed to read node from %s: %v", chunk.File, err)
+			}
+			bz = bz[n:]
+
+			node, err := decodeIAVLNode(nodeBytes)
+			if err != nil {
+				batch.Close()
+				return err
+			}
+
+			if !node.isLeaf() {
+				if len(stack) < 2 ||
+					!bytes.Equal(stack[len(stack)-2], node.leftHash) ||
+					!bytes.Equal(stack[len(stack)-1], node.rightHash) {
+					batch.Close()
+					return fmt.Errorf("node %d in %s does not match its children", nodes, chunk.File)
+				}
+				stack = stack[:len(stack)-2]
+			}
+
+			hash := node.hash()
+			batch.Set(iavlNodeKeyFormat.Key(hash), nodeBytes)
+			stack = append(stack, hash)
+			nodes++
+		}
+
+		batch.WriteSync()
+		batch.Close()
+	}
+
+	if nodes != s.Nodes {
+		return fmt.Errorf("read %d nodes, expected %d", nodes, s.Nodes)
+	}
+
+	switch {
+	case len(stack) == 0 && len(s.Hash) == 0:
+	case len(stack) == 1 && bytes.Equal(stack[0], s.Hash):
+	default:
+		return fmt.Errorf("nodes do not form a tree with root hash %X", s.Hash.Bytes())
+	}
+
+	rootHash := []byte{}
+	if len(s.Hash) != 0 {
+		rootHash = s.Hash
+	}
+
+	storeDB.SetSync(iavlRootKeyFormat.Key(s.Version), rootHash)
+	return nil
+}
+
+// readSnapshotFile reads a file of the snapshot and checks its size and hash
+func readSnapshotFile(dir string, file snapshotFile) ([]byte, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file.File)))
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	if int64(len(bz)) != file.Size || !file.Hash.Equals(hash[:]) {
+		return nil, fmt.Errorf("snapshot file %s is corrupted", file.File)
+	}
+
+	return bz, nil
+}
+
+// restoreTendermintState saves the state and the last block of the snapshot in fresh tendermint dbs
+func restoreTendermintState(config *cfg.Config, tm snapshotTendermint) {
+	dbType := dbm.DBBackendType(config.DBBackend)
+
+	stateDB := dbm.NewDB("state", dbType, config.DBDir())
+	defer stateDB.Close()
+
+	blockStoreDB := dbm.NewDB("blockstore", dbType, config.DBDir())
+	defer blockStoreDB.Close()
+
+	// validator sets and consensus params are only saved in full at the height they
+	// changed, so the restored node treats the first heights it saves as changes
+	tm.State.LastHeightValidatorsChanged = tm.State.LastBlockHeight + 2
+	tm.State.LastHeightConsensusParamsChanged = tm.State.LastBlockHeight + 1
+	state.SaveState(stateDB, tm.State)
+
+	// the block store starts right at the snapshot block
+	store.BlockStoreStateJSON{Height: tm.State.LastBlockHeight - 1}.Save(blockStoreDB)
+	blockStore := store.NewBlockStore(blockStoreDB)
+	blockStore.SaveBlock(tm.Block, tm.Block.MakePartSet(tmTypes.BlockPartSizeBytes), tm.SeenCommit)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/state"
+	dbm "github.com/tendermint/tm-db"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var snapshotTestStores = []string{"checkpoint", "bor", "clerk", "staking", "empty"}
+
+// snapshotTestValues returns the values written to the stores at a height
+func snapshotTestValues(height int) map[string]map[string]string {
+	values := make(map[string]map[string]string)
+	for _, name := range snapshotTestStores[:4] {
+		kvs := make(map[string]string)
+		for i := 0; i < 20; i++ {
+			kvs[fmt.Sprintf("%s-%03d", name, (i*7+height)%30)] = fmt.Sprintf("value-%d-%d", height, i)
+		}
+		values[name] = kvs
+	}
+
+	return values
+}
+
+// testSnapshotChain commits 5 heights to every snapshot test store except "empty"
+func testSnapshotChain(t *testing.T) *testChain {
+	c := newTestChain(t, storeTypes.PruneNothing, snapshotTestStores...)
+	for height := 1; height <= 5; height++ {
+		c.commit(snapshotTestValues(height))
+	}
+
+	return c
+}
+
+func TestDecodeIAVLNode(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, 0)
+	for i := 0; i < 50; i++ {
+		tree.Set([]byte(fmt.Sprintf("key-%03d", i)), []byte(fmt.Sprintf("value-%d", i)))
+	}
+	rootHash, version, err := tree.SaveVersion()
+	require.NoError(t, err)
+
+	// every persisted node decodes and hashes to its key
+	leaves, inner := 0, 0
+	iterator := dbm.IteratePrefix(db, []byte{'n'})
+	for ; iterator.Valid(); iterator.Next() {
+		var hash []byte
+		iavlNodeKeyFormat.Scan(iterator.Key(), &hash)
+
+		node, err := decodeIAVLNode(iterator.Value())
+		require.NoError(t, err)
+		require.Equal(t, hash, node.hash())
+		require.Equal(t, version, node.version)
+
+		if node.isLeaf() {
+			leaves++
+			require.Equal(t, int64(1), node.size)
+			i, err := strconv.Atoi(string(node.key[len("key-"):]))
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("value-%d", i), string(node.value))
+			require.Nil(t, node.leftHash)
+		} else {
+			inner++
+			require.Nil(t, node.value)
+			require.Len(t, node.leftHash, 32)
+			require.Len(t, node.rightHash, 32)
+		}
+	}
+	iterator.Close()
+
+	require.Equal(t, 50, leaves)
+	require.Equal(t, 49, inner)
+	require.Equal(t, rootHash, db.Get(iavlRootKeyFormat.Key(version)))
+
+	// truncated nodes fail to decode
+	nodeBytes := db.Get(iavlNodeKeyFormat.Key(rootHash))
+	_, err = decodeIAVLNode(nodeBytes[:len(nodeBytes)-1])
+	require.Error(t, err)
+}
+
+func TestSnapshotCreateRestore(t *testing.T) {
+	c := testSnapshotChain(t)
+	appHash := mustGetCommitInfo(t, c.db, 3).hash()
+	nextAppHash := mustGetCommitInfo(t, c.db, 4).hash()
+	c.close()
+
+	// small chunks to split stores over several files
+	dir := filepath.Join(newTestHome(t).RootDir, "snapshot")
+	manifest, err := createSnapshot(c.config, dir, 3, 512)
+	require.NoError(t, err)
+	require.Equal(t, snapshotFormat, manifest.Format)
+	require.Equal(t, "heimdall-test", manifest.ChainID)
+	require.Equal(t, int64(3), manifest.Height)
+	require.Equal(t, hmTypes.HexBytes(appHash), manifest.AppHash)
+
+	require.Len(t, manifest.Stores, len(snapshotTestStores))
+	for _, s := range manifest.Stores {
+		if s.Name == "empty" {
+			require.Zero(t, s.Nodes)
+			require.Empty(t, s.Chunks)
+			continue
+		}
+
+		require.True(t, len(s.Chunks) > 1, "%s store should have several chunks", s.Name)
+	}
+
+	_, err = createSnapshot(c.config, dir, 3, 512)
+	require.Error(t, err, "existing snapshot should not be overwritten")
+
+	// restore into an empty home
+	restoredConfig := newTestHome(t)
+	restored, err := restoreSnapshot(restoredConfig, dir, appHash)
+	require.NoError(t, err)
+	require.Equal(t, manifest, restored)
+
+	r := &testChain{t: t, config: restoredConfig, keys: make(map[string]*sdk.KVStoreKey), pruning: storeTypes.PruneNothing}
+	for _, name := range snapshotTestStores {
+		r.keys[name] = sdk.NewKVStoreKey(name)
+	}
+	r.open()
+	defer r.close()
+
+	// application loads at the snapshot height with its app hash
+	r.loadStores()
+	require.Equal(t, int64(3), r.cms.LastCommitID().Version)
+	require.Equal(t, appHash, r.cms.LastCommitID().Hash)
+	for name, kvs := range snapshotTestValues(3) {
+		kvStore := r.cms.GetKVStore(r.keys[name])
+		for key, value := range kvs {
+			require.Equal(t, []byte(value), kvStore.Get([]byte(key)))
+		}
+	}
+
+	r.state = state.LoadState(r.stateDB)
+	require.Equal(t, int64(3), r.state.LastBlockHeight)
+	require.Equal(t, appHash, []byte(r.state.AppHash))
+	require.Equal(t, int64(3), r.blockStore.Height())
+
+	// the restored node commits the next height with the same app hash as the original node
+	r.seenCommit = r.blockStore.LoadSeenCommit(3)
+	r.commit(snapshotTestValues(4))
+	require.Equal(t, nextAppHash, []byte(r.state.AppHash))
+	require.Equal(t, int64(4), r.blockStore.Height())
+
+	// a node which is not empty is not overwritten
+	_, err = restoreSnapshot(restoredConfig, dir, nil)
+	require.Error(t, err)
+}
+
+func TestSnapshotRestoreInvalid(t *testing.T) {
+	c := testSnapshotChain(t)
+	c.close()
+
+	dir := filepath.Join(newTestHome(t).RootDir, "snapshot")
+	manifest, err := createSnapshot(c.config, dir, 0, 512)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), manifest.Height)
+
+	for _, height := range []int64{-1, 6} {
+		_, err := createSnapshot(c.config, filepath.Join(dir, "invalid"), height, 512)
+		require.Error(t, err, "height %d", height)
+	}
+
+	// untrusted app hash
+	_, err = restoreSnapshot(newTestHome(t), dir, hmTypes.HexBytes{1, 2, 3})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "differs from trusted app hash")
+
+	// store with nodes to tamper with
+	index := 0
+	for len(manifest.Stores[index].Chunks) == 0 {
+		index++
+	}
+
+	// manifest store hash which does not add up to the app hash
+	tampered := manifest
+	tampered.Stores = append([]snapshotStore{}, manifest.Stores...)
+	tampered.Stores[index].Hash = hmTypes.HexBytes{1}
+	writeTestManifest(t, dir, tampered)
+	_, err = restoreSnapshot(newTestHome(t), dir, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "add up to app hash")
+
+	// corrupted chunk
+	writeTestManifest(t, dir, manifest)
+	chunkPath := filepath.Join(dir, filepath.FromSlash(manifest.Stores[index].Chunks[0].File))
+	bz, err := ioutil.ReadFile(chunkPath)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(chunkPath, bz, 0644))
+	_, err = restoreSnapshot(newTestHome(t), dir, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "corrupted")
+
+	// chunk with a valid file hash but a tampered node
+	tampered = manifest
+	tampered.Stores = append([]snapshotStore{}, manifest.Stores...)
+	tampered.Stores[index].Chunks = append([]snapshotFile{}, manifest.Stores[index].Chunks...)
+	tampered.Stores[index].Chunks[0], err = writeSnapshotFile(dir, manifest.Stores[index].Chunks[0].File, bz)
+	require.NoError(t, err)
+	writeTestManifest(t, dir, tampered)
+	_, err = restoreSnapshot(newTestHome(t), dir, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to import "+manifest.Stores[index].Name+" store")
+}
+
+// writeTestManifest overwrites the manifest of the snapshot
+func writeTestManifest(t *testing.T, dir string, manifest snapshotManifest) {
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, snapshotManifestFile), bz, 0644))
+}
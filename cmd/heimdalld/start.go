@@ -0,0 +1,148 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	flagWithTendermint = "with-tendermint"
+	flagTraceStore     = "trace-store"
+	flagCPUProfile     = "cpu-profile"
+)
+
+// wrapStartCmd hooks in-process start of the sdk start command while a consensus key
+// rotation is pending, so that tendermint signs with a priv validator which follows it.
+// The sdk start has no priv validator hook and tendermint's remote signer does not sign
+// side tx results, any other start is left to the sdk as is.
+func wrapStartCmd(ctx *server.Context, rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() != "start" {
+			continue
+		}
+
+		sdkStart := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			if !viper.GetBool(flagWithTendermint) {
+				return sdkStart(cmd, args)
+			}
+
+			rotation, err := helper.LoadKeyRotation(helper.KeyRotationFile(ctx.Config.PrivValidatorKeyFile()))
+			if err != nil {
+				return err
+			}
+
+			if !rotation.IsPending() {
+				return sdkStart(cmd, args)
+			}
+
+			ctx.Logger.Info("starting ABCI with Tendermint and pending consensus key rotation", "newSigner", rotation.NewSigner)
+			return startInProcess(ctx)
+		}
+	}
+}
+
+func startInProcess(ctx *server.Context) error {
+	cfg := ctx.Config
+	home := cfg.RootDir
+
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	if err != nil {
+		return err
+	}
+
+	var traceWriter *os.File
+	if traceWriterFile := viper.GetString(flagTraceStore); traceWriterFile != "" {
+		traceWriter, err = os.OpenFile(traceWriterFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+		if err != nil {
+			return err
+		}
+	}
+
+	var happ *app.HeimdallApp
+	if traceWriter != nil {
+		happ = newApp(ctx.Logger, db, traceWriter).(*app.HeimdallApp)
+	} else {
+		happ = newApp(ctx.Logger, db, nil).(*app.HeimdallApp)
+	}
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	server.UpgradeOldPrivValFile(cfg)
+
+	// priv validator switching consensus key at rotation activation height
+	privValidator, err := helper.NewRotatingPV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), ctx.Logger.With("module", "privval"))
+	if err != nil {
+		return err
+	}
+	happ.SetValidatorUpdateListener(privValidator.OnValidatorUpdates)
+
+	// create & start tendermint node
+	tmNode, err := node.NewNode(
+		cfg,
+		privValidator,
+		nodeKey,
+		proxy.NewLocalClientCreator(happ),
+		node.DefaultGenesisDocProviderFunc(cfg),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		ctx.Logger.With("module", "node"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+
+	var cpuProfileCleanup func()
+
+	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
+		f, err := os.Create(cpuProfile)
+		if err != nil {
+			return err
+		}
+
+		ctx.Logger.Info("starting CPU profiler", "profile", cpuProfile)
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return err
+		}
+
+		cpuProfileCleanup = func() {
+			ctx.Logger.Info("stopping CPU profiler", "profile", cpuProfile)
+			pprof.StopCPUProfile()
+			f.Close()
+		}
+	}
+
+	server.TrapSignal(func() {
+		if tmNode.IsRunning() {
+			_ = tmNode.Stop()
+		}
+
+		if cpuProfileCleanup != nil {
+			cpuProfileCleanup()
+		}
+
+		ctx.Logger.Info("exiting...")
+	})
+
+	// run forever (the node will not be returned)
+	select {}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Keys used by the root multistore to persist its metadata, see cosmos-sdk store/rootmulti
+const (
+	latestVersionKey = "s/latest"
+	commitInfoKeyFmt = "s/%d" // s/<version>
+)
+
+// Key formats used by iavl to persist tree nodes and roots, see iavl nodedb
+var (
+	iavlNodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size) // n<hash>
+	iavlRootKeyFormat = iavl.NewKeyFormat('r', 8)           // r<version>
+)
+
+// storeCodec decodes root multistore metadata
+var storeCodec = codec.New()
+
+// commitInfo mirrors the commit info the root multistore saves for every version.
+// It holds the version and hash of each module store at that height.
+type commitInfo struct {
+	Version    int64
+	StoreInfos []storeInfo
+}
+
+// storeInfo mirrors the per store commit info of the root multistore
+type storeInfo struct {
+	Name string
+	Core storeCore
+}
+
+// storeCore mirrors the commit id of a store in the root multistore
+type storeCore struct {
+	CommitID sdk.CommitID
+}
+
+// getStoreInfo returns the store info by store name
+func (ci commitInfo) getStoreInfo(name string) (storeInfo, bool) {
+	for _, info := range ci.StoreInfos {
+		if info.Name == name {
+			return info, true
+		}
+	}
+
+	return storeInfo{}, false
+}
+
+// hash returns the app hash of the commit, computed the same way as the root multistore does
+func (ci commitInfo) hash() []byte {
+	m := make(map[string][]byte, len(ci.StoreInfos))
+	for _, info := range ci.StoreInfos {
+		m[info.Name] = tmhash.Sum(info.Core.CommitID.Hash)
+	}
+
+	return merkle.SimpleHashFromMap(m)
+}
+
+// getLatestAppVersion returns the latest committed version of the application multistore
+func getLatestAppVersion(db dbm.DB) (int64, error) {
+	var latest int64
+
+	latestBytes := db.Get([]byte(latestVersionKey))
+	if latestBytes == nil {
+		return 0, nil
+	}
+
+	if err := storeCodec.UnmarshalBinaryLengthPrefixed(latestBytes, &latest); err != nil {
+		return 0, err
+	}
+
+	return latest, nil
+}
+
+// getCommitInfo returns the commit info of the application multistore at the given version
+func getCommitInfo(db dbm.DB, version int64) (commitInfo, error) {
+	var cInfo commitInfo
+
+	cInfoBytes := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if cInfoBytes == nil {
+		return cInfo, fmt.Errorf("no commit info found for height %d", version)
+	}
+
+	if err := storeCodec.UnmarshalBinaryLengthPrefixed(cInfoBytes, &cInfo); err != nil {
+		return cInfo, fmt.Errorf("failed to decode commit info for height %d: %v", version, err)
+	}
+
+	return cInfo, nil
+}
+
+// setCommitInfo saves the commit info and marks its version as the latest of the application multistore
+func setCommitInfo(db dbm.DB, cInfo commitInfo) {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	batch.Set([]byte(fmt.Sprintf(commitInfoKeyFmt, cInfo.Version)), storeCodec.MustMarshalBinaryLengthPrefixed(cInfo))
+	batch.Set([]byte(latestVersionKey), storeCodec.MustMarshalBinaryLengthPrefixed(cInfo.Version))
+	batch.WriteSync()
+}
+
+// getStoreDB returns the prefixed db holding the iavl tree of a module store
+func getStoreDB(db dbm.DB, name string) dbm.DB {
+	return dbm.NewPrefixDB(db, []byte("s/k:"+name+"/"))
+}
+
+// getChangedStores returns the names of stores whose hash differs between two commits.
+// Stores which exist in only one of the commits are reported as changed as well.
+func getChangedStores(from commitInfo, to commitInfo) []string {
+	var changed []string
+
+	for _, info := range to.StoreInfos {
+		old, ok := from.getStoreInfo(info.Name)
+		if !ok || !bytes.Equal(old.Core.CommitID.Hash, info.Core.CommitID.Hash) {
+			changed = append(changed, info.Name)
+		}
+	}
+
+	for _, info := range from.StoreInfos {
+		if _, ok := to.getStoreInfo(info.Name); !ok {
+			changed = append(changed, info.Name)
+		}
+	}
+
+	return changed
+}
+
+// iavlNode holds the fields of an iavl tree node as persisted by iavl
+type iavlNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+// isLeaf returns true if the node holds a value
+func (n iavlNode) isLeaf() bool {
+	return n.height == 0
+}
+
+// decodeIAVLNode decodes a persisted iavl node, see iavl Node.writeBytes
+func decodeIAVLNode(bz []byte) (node iavlNode, err error) {
+	var n int
+
+	if node.height, n, err = amino.DecodeInt8(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node height: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.size, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node size: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.version, n, err = amino.DecodeVarint(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node version: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.key, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node key: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.isLeaf() {
+		if node.value, _, err = amino.DecodeByteSlice(bz); err != nil {
+			return node, fmt.Errorf("failed to decode node value: %v", err)
+		}
+
+		return node, nil
+	}
+
+	if node.leftHash, n, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node left hash: %v", err)
+	}
+	bz = bz[n:]
+
+	if node.rightHash, _, err = amino.DecodeByteSlice(bz); err != nil {
+		return node, fmt.Errorf("failed to decode node right hash: %v", err)
+	}
+
+	return node, nil
+}
+
+// hash computes the node hash from its content, see iavl Node.writeHashBytes
+func (n iavlNode) hash() []byte {
+	var buf bytes.Buffer
+
+	// writes to a buffer never fail
+	_ = amino.EncodeInt8(&buf, n.height)
+	_ = amino.EncodeVarint(&buf, n.size)
+	_ = amino.EncodeVarint(&buf, n.version)
+
+	if n.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, n.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(n.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, n.leftHash)
+		_ = amino.EncodeByteSlice(&buf, n.rightHash)
+	}
+
+	return tmhash.Sum(buf.Bytes())
+}
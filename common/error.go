@@ -32,6 +32,7 @@ const (
 	CodeNoCheckpointBuffer       CodeType = 1511
 	CodeCheckpointBuffer         CodeType = 1512
 	CodeCheckpointAlreadyExists  CodeType = 1513
+	CodeDirectAdjustDisabled     CodeType = 1514
 
 	CodeOldValidator        CodeType = 2500
 	CodeNoValidator         CodeType = 2501
@@ -48,6 +49,7 @@ const (
 	CodeErrDecodeEvent      CodeType = 2512
 	CodeNoSignerChangeError CodeType = 2513
 	CodeNonce               CodeType = 2514
+	CodeDescriptionDisabled CodeType = 2515
 
 	CodeSpanNotCountinuous  CodeType = 3501
 	CodeUnableToFreezeSet   CodeType = 3502
@@ -139,6 +141,10 @@ func ErrCheckpointBufferFound(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeCheckpointBuffer, "Checkpoint buffer found")
 }
 
+func ErrDirectAdjustDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeDirectAdjustDisabled, "Direct checkpoint adjustment is disabled, adjust through governance")
+}
+
 func ErrInvalidNoACK(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidNoACK, "Invalid No ACK -- Waiting for last checkpoint ACK")
 }
@@ -205,6 +211,10 @@ func ErrValidatorNotDeactivated(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeValSave, "Validator Not Deactivated")
 }
 
+func ErrDescriptionDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeDescriptionDisabled, "Validator descriptions are not enabled")
+}
+
 func ErrValidatorAlreadyJoined(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeValAlreadyJoined, "Validator already joined")
 }
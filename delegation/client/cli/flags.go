@@ -0,0 +1,13 @@
+package cli
+
+const (
+	FlagProposerAddress = "proposer"
+	FlagValidatorID     = "validator-id"
+	FlagDelegator       = "delegator"
+	FlagAmount          = "amount"
+	FlagShares          = "shares"
+	FlagRewards         = "rewards"
+	FlagTxHash          = "tx-hash"
+	FlagLogIndex        = "log-index"
+	FlagBlockNumber     = "block-number"
+)
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group delegation queries under a subcommand
+	delegationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the delegation module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	// delegation query command
+	delegationQueryCmd.AddCommand(
+		client.GetCommands(
+			GetDelegation(cdc),
+			GetDelegatorDelegations(cdc),
+			GetValidatorDelegations(cdc),
+			GetSequence(cdc),
+		)...,
+	)
+
+	return delegationQueryCmd
+}
+
+// GetDelegation queries delegation of a delegator on a validator
+func GetDelegation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation",
+		Short: "show delegation of a delegator on a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			delegator := hmTypes.HexToHeimdallAddress(viper.GetString(FlagDelegator))
+			if validatorID == 0 || delegator.Empty() {
+				return fmt.Errorf("validator ID and delegator address required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegationParams(hmTypes.NewValidatorID(validatorID), delegator))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	cmd.Flags().String(FlagDelegator, "", "--delegator=<delegator-address>")
+	markFlagsRequired(cmd, "GetDelegation", FlagValidatorID, FlagDelegator)
+	return cmd
+}
+
+// GetDelegatorDelegations queries all delegations of a delegator
+func GetDelegatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-delegations",
+		Short: "show all delegations of a delegator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegator := hmTypes.HexToHeimdallAddress(viper.GetString(FlagDelegator))
+			if delegator.Empty() {
+				return fmt.Errorf("delegator address required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(delegator))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagDelegator, "", "--delegator=<delegator-address>")
+	markFlagsRequired(cmd, "GetDelegatorDelegations", FlagDelegator)
+	return cmd
+}
+
+// GetValidatorDelegations queries all delegations on a validator
+func GetValidatorDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-delegations",
+		Short: "show all delegations on a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("validator ID required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.NewValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	markFlagsRequired(cmd, "GetValidatorDelegations", FlagValidatorID)
+	return cmd
+}
+
+// GetSequence checks if a delegation event was already mirrored
+func GetSequence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "get sequence from txhash and logindex",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			logIndex := viper.GetUint64(FlagLogIndex)
+			txHashStr := viper.GetString(FlagTxHash)
+			if txHashStr == "" {
+				return fmt.Errorf("LogIndex and transaction hash required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySequenceParams(txHashStr, logIndex))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySequence), queryParams)
+			if err != nil || len(res) == 0 {
+				fmt.Println("No delegation event exists")
+				return nil
+			}
+
+			fmt.Println("Success. Delegation event exists with sequence:", string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+	markFlagsRequired(cmd, "GetSequence", FlagTxHash, FlagLogIndex)
+	return cmd
+}
@@ -0,0 +1,223 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var cliLogger = helper.Logger.With("module", "delegation/client/cli")
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Delegation transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			ShareMintTxCmd(cdc),
+			ShareBurnTxCmd(cdc),
+			ClaimRewardsTxCmd(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// ShareMintTxCmd will create a share mint tx
+func ShareMintTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-mint",
+		Short: "Mirror delegator shares minted on a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer, delegator, txhash, err := readEventFlags(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			amount, shares, err := readShareFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgShareMint(
+				proposer,
+				viper.GetUint64(FlagValidatorID),
+				delegator,
+				amount,
+				shares,
+				txhash,
+				viper.GetUint64(FlagLogIndex),
+				viper.GetUint64(FlagBlockNumber),
+			)
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	addEventFlags(cmd)
+	cmd.Flags().String(FlagAmount, "", "--amount=<amount>")
+	cmd.Flags().String(FlagShares, "", "--shares=<shares>")
+	markFlagsRequired(cmd, "ShareMintTxCmd", FlagAmount, FlagShares)
+
+	return cmd
+}
+
+// ShareBurnTxCmd will create a share burn tx
+func ShareBurnTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-burn",
+		Short: "Mirror delegator shares burned on a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer, delegator, txhash, err := readEventFlags(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			amount, shares, err := readShareFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgShareBurn(
+				proposer,
+				viper.GetUint64(FlagValidatorID),
+				delegator,
+				amount,
+				shares,
+				txhash,
+				viper.GetUint64(FlagLogIndex),
+				viper.GetUint64(FlagBlockNumber),
+			)
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	addEventFlags(cmd)
+	cmd.Flags().String(FlagAmount, "", "--amount=<amount>")
+	cmd.Flags().String(FlagShares, "", "--shares=<shares>")
+	markFlagsRequired(cmd, "ShareBurnTxCmd", FlagAmount, FlagShares)
+
+	return cmd
+}
+
+// ClaimRewardsTxCmd will create a claim rewards tx
+func ClaimRewardsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Mirror delegator rewards claimed from a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposer, delegator, txhash, err := readEventFlags(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			rewards, ok := sdk.NewIntFromString(viper.GetString(FlagRewards))
+			if !ok {
+				return errors.New("Invalid rewards amount")
+			}
+
+			msg := types.NewMsgClaimRewards(
+				proposer,
+				viper.GetUint64(FlagValidatorID),
+				delegator,
+				rewards,
+				txhash,
+				viper.GetUint64(FlagLogIndex),
+				viper.GetUint64(FlagBlockNumber),
+			)
+
+			// broadcast msg with cli
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	addEventFlags(cmd)
+	cmd.Flags().String(FlagRewards, "", "--rewards=<rewards>")
+	markFlagsRequired(cmd, "ClaimRewardsTxCmd", FlagRewards)
+
+	return cmd
+}
+
+// addEventFlags adds flags shared by all delegation event txs
+func addEventFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	cmd.Flags().String(FlagDelegator, "", "--delegator=<delegator-address>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().Uint64(FlagLogIndex, 0, "--log-index=<log-index>")
+	cmd.Flags().Uint64(FlagBlockNumber, 0, "--block-number=<block-number>")
+	markFlagsRequired(cmd, cmd.Use, FlagValidatorID, FlagDelegator, FlagTxHash, FlagLogIndex, FlagBlockNumber)
+}
+
+func markFlagsRequired(cmd *cobra.Command, name string, flags ...string) {
+	for _, flag := range flags {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			cliLogger.Error(name+" | MarkFlagRequired | "+flag, "Error", err)
+		}
+	}
+}
+
+// readEventFlags reads proposer, delegator and tx hash shared by all delegation event txs
+func readEventFlags(cliCtx context.CLIContext) (proposer hmTypes.HeimdallAddress, delegator hmTypes.HeimdallAddress, txhash hmTypes.HeimdallHash, err error) {
+	// get proposer
+	proposer = hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+	if proposer.Empty() {
+		proposer = helper.GetFromAddress(cliCtx)
+	}
+
+	if viper.GetUint64(FlagValidatorID) == 0 {
+		return proposer, delegator, txhash, fmt.Errorf("validator ID cannot be zero")
+	}
+
+	delegator = hmTypes.HexToHeimdallAddress(viper.GetString(FlagDelegator))
+	if delegator.Empty() {
+		return proposer, delegator, txhash, fmt.Errorf("delegator address cannot be zero")
+	}
+
+	txhashStr := viper.GetString(FlagTxHash)
+	if txhashStr == "" {
+		return proposer, delegator, txhash, fmt.Errorf("transaction hash has to be supplied")
+	}
+
+	return proposer, delegator, hmTypes.HexToHeimdallHash(txhashStr), nil
+}
+
+// readShareFlags reads amount and shares of a share mint or burn
+func readShareFlags() (amount sdk.Int, shares sdk.Int, err error) {
+	amount, ok := sdk.NewIntFromString(viper.GetString(FlagAmount))
+	if !ok {
+		return amount, shares, errors.New("Invalid amount")
+	}
+
+	shares, ok = sdk.NewIntFromString(viper.GetString(FlagShares))
+	if !ok {
+		return amount, shares, errors.New("Invalid shares")
+	}
+
+	return amount, shares, nil
+}
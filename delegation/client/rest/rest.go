@@ -0,0 +1,21 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// RestLogger for delegation module logger
+var RestLogger tmLog.Logger
+
+func init() {
+	RestLogger = helper.Logger.With("module", "delegation/rest")
+}
+
+// RegisterRoutes registers delegation-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
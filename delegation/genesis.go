@@ -0,0 +1,32 @@
+package delegation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/delegation/types"
+)
+
+// InitGenesis sets delegation information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	// chains started with the module mirror from genesis
+	keeper.SetMirroredSince(ctx, data.MirroredSince)
+
+	for _, delegation := range data.Delegations {
+		if err := keeper.SetDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, sequence := range data.Sequences {
+		keeper.SetDelegationSequence(ctx, sequence)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetAllDelegations(ctx),
+		keeper.GetDelegationSequences(ctx),
+		keeper.GetMirroredSince(ctx),
+	)
+}
@@ -0,0 +1,78 @@
+package delegation
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewHandler returns a handler for "delegation" type messages.
+func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgShareMint:
+			return handleDelegationEvent(ctx, k, msg.Type(), msg.BlockNumber, msg.LogIndex)
+		case types.MsgShareBurn:
+			return handleDelegationEvent(ctx, k, msg.Type(), msg.BlockNumber, msg.LogIndex)
+		case types.MsgClaimRewards:
+			return handleDelegationEvent(ctx, k, msg.Type(), msg.BlockNumber, msg.LogIndex)
+		default:
+			return sdk.ErrUnknownRequest("Unrecognized delegation msg type").Result()
+		}
+	}
+}
+
+// handleDelegationEvent rejects events which were already mirrored or are older
+// than the mirror. The actual state change happens in the post-tx handler once
+// the side-tx passes.
+func handleDelegationEvent(ctx sdk.Context, k Keeper, msgType string, blockNumber uint64, logIndex uint64) sdk.Result {
+	k.Logger(ctx).Debug("✅ Validating delegation msg",
+		"type", msgType,
+		"logIndex", logIndex,
+		"blockNumber", blockNumber,
+	)
+
+	if err := checkMirror(ctx, k, blockNumber); err != nil {
+		return err.Result()
+	}
+
+	// check if incoming tx already exists
+	if k.HasDelegationSequence(ctx, getSequence(blockNumber, logIndex)) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return common.ErrOldTx(k.Codespace()).Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// checkMirror rejects events before the mirror is enabled and events older than the first
+// mirrored one, which may belong to positions whose earlier events were never mirrored
+func checkMirror(ctx sdk.Context, k Keeper, blockNumber uint64) sdk.Error {
+	if !k.IsMirrorEnabled(ctx) {
+		k.Logger(ctx).Error("Delegation mirror is not enabled")
+		return types.ErrMirrorDisabled(k.Codespace())
+	}
+
+	if mirroredSince := k.GetMirroredSince(ctx); mirroredSince != 0 && blockNumber < mirroredSince {
+		k.Logger(ctx).Error("Delegation event is older than mirror", "blockNumber", blockNumber, "mirroredSince", mirroredSince)
+		return types.ErrBeforeMirror(k.Codespace())
+	}
+
+	return nil
+}
+
+// getSequence returns sequence id of a mainchain log
+func getSequence(blockNumber uint64, logIndex uint64) string {
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(blockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
+	return sequence.String()
+}
@@ -0,0 +1,294 @@
+package delegation
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/chainmanager"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	// DefaultValue default value
+	DefaultValue = []byte{0x01}
+
+	DelegationKey         = []byte{0x91} // prefix for each key to a delegation, by validator and delegator
+	DelegatorIndexKey     = []byte{0x92} // prefix for each key to a delegation index, by delegator and validator
+	DelegationSequenceKey = []byte{0x93} // prefix for each key for delegation event sequences
+	MirroredSinceKey      = []byte{0x94} // key to store mainchain block of the first mirrored delegation event
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	// The (unexposed) key used to access the store from the Context.
+	key sdk.StoreKey
+	// The codec codec for binary encoding/decoding of delegations.
+	cdc *codec.Codec
+	// code space
+	codespace sdk.CodespaceType
+	// chain keeper
+	chainKeeper chainmanager.Keeper
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+	chainKeeper chainmanager.Keeper,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		key:         storeKey,
+		codespace:   codespace,
+		chainKeeper: chainKeeper,
+	}
+}
+
+// Codespace returns the keeper's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetValidatorDelegationsPrefix returns prefix of all delegations on a validator
+func GetValidatorDelegationsPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(DelegationKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetDelegationKey returns key of a delegation by validator and delegator
+func GetDelegationKey(valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(GetValidatorDelegationsPrefix(valID), delegator.Bytes()...)
+}
+
+// GetDelegatorIndexPrefix returns prefix of all delegation indexes of a delegator
+func GetDelegatorIndexPrefix(delegator hmTypes.HeimdallAddress) []byte {
+	return append(DelegatorIndexKey, delegator.Bytes()...)
+}
+
+// GetDelegatorIndexKey returns index key of a delegation by delegator and validator
+func GetDelegatorIndexKey(delegator hmTypes.HeimdallAddress, valID hmTypes.ValidatorID) []byte {
+	return append(GetDelegatorIndexPrefix(delegator), sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetDelegationSequenceKey returns key of a delegation event sequence
+func GetDelegationSequenceKey(sequence string) []byte {
+	return append(DelegationSequenceKey, []byte(sequence)...)
+}
+
+//
+// Delegations
+//
+
+// SetDelegation stores delegation and indexes it by delegator
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) error {
+	store := ctx.KVStore(k.key)
+
+	bz, err := k.cdc.MarshalBinaryBare(delegation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetDelegationKey(delegation.ValidatorID, delegation.Delegator), bz)
+	store.Set(GetDelegatorIndexKey(delegation.Delegator, delegation.ValidatorID), DefaultValue)
+	return nil
+}
+
+// GetDelegation returns delegation of delegator on validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) (delegation types.Delegation, ok bool) {
+	store := ctx.KVStore(k.key)
+
+	bz := store.Get(GetDelegationKey(valID, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &delegation)
+	return delegation, true
+}
+
+// GetValidatorDelegations returns all delegations on a validator
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, valID hmTypes.ValidatorID) (delegations []types.Delegation) {
+	k.iterateDelegations(ctx, GetValidatorDelegationsPrefix(valID), func(delegation types.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	return
+}
+
+// GetDelegatorDelegations returns all delegations of a delegator, ordered by validator id
+func (k *Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator hmTypes.HeimdallAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.key)
+	prefix := GetDelegatorIndexPrefix(delegator)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valID := hmTypes.NewValidatorID(binary.BigEndian.Uint64(iterator.Key()[len(prefix):]))
+		if delegation, ok := k.GetDelegation(ctx, valID, delegator); ok {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return
+}
+
+// GetAllDelegations returns all delegations
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	k.IterateDelegationsAndApplyFn(ctx, func(delegation types.Delegation) error {
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	return
+}
+
+// IterateDelegationsAndApplyFn iterate delegations and apply the given function.
+func (k *Keeper) IterateDelegationsAndApplyFn(ctx sdk.Context, f func(delegation types.Delegation) error) {
+	k.iterateDelegations(ctx, DelegationKey, f)
+}
+
+func (k *Keeper) iterateDelegations(ctx sdk.Context, prefix []byte, f func(delegation types.Delegation) error) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+
+		// call function and return if required
+		if err := f(delegation); err != nil {
+			return
+		}
+	}
+}
+
+// AddShares credits minted shares and staked amount to a delegation
+func (k *Keeper) AddShares(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, amount sdk.Int, shares sdk.Int, sequence string) (types.Delegation, error) {
+	delegation, ok := k.GetDelegation(ctx, valID, delegator)
+	if !ok {
+		delegation = types.NewDelegation(valID, delegator)
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	delegation.Amount = delegation.Amount.Add(amount)
+	delegation.LastUpdated = sequence
+
+	return delegation, k.SetDelegation(ctx, delegation)
+}
+
+// RemoveShares debits burned shares and unstaked amount from a delegation. A position opened
+// before the mirror started may burn more than was mirrored, its balances then floor at zero
+// and the delegation is flagged as partial.
+func (k *Keeper) RemoveShares(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, amount sdk.Int, shares sdk.Int, sequence string) (types.Delegation, error) {
+	delegation, ok := k.GetDelegation(ctx, valID, delegator)
+	if !ok {
+		delegation = types.NewDelegation(valID, delegator)
+	}
+
+	if delegation.Shares.LT(shares) || delegation.Amount.LT(amount) {
+		delegation.Partial = true
+	}
+
+	delegation.Shares = subFloorZero(delegation.Shares, shares)
+	delegation.Amount = subFloorZero(delegation.Amount, amount)
+	delegation.LastUpdated = sequence
+
+	return delegation, k.SetDelegation(ctx, delegation)
+}
+
+// AddClaimedRewards records rewards claimed by a delegator from a validator
+func (k *Keeper) AddClaimedRewards(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, rewards sdk.Int, sequence string) (types.Delegation, error) {
+	delegation, ok := k.GetDelegation(ctx, valID, delegator)
+	if !ok {
+		delegation = types.NewDelegation(valID, delegator)
+	}
+
+	delegation.ClaimedRewards = delegation.ClaimedRewards.Add(rewards)
+	delegation.LastUpdated = sequence
+
+	return delegation, k.SetDelegation(ctx, delegation)
+}
+
+func subFloorZero(a sdk.Int, b sdk.Int) sdk.Int {
+	if a.LT(b) {
+		return sdk.ZeroInt()
+	}
+	return a.Sub(b)
+}
+
+//
+// Mirror
+//
+
+// IsMirrorEnabled returns true if delegation events are mirrored, from genesis on new
+// chains and from the delegation-mirror upgrade on running chains
+func (k *Keeper) IsMirrorEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(MirroredSinceKey)
+}
+
+// EnableMirror starts mirroring delegation events
+func (k *Keeper) EnableMirror(ctx sdk.Context) {
+	if !k.IsMirrorEnabled(ctx) {
+		k.SetMirroredSince(ctx, 0)
+	}
+}
+
+// GetMirroredSince returns mainchain block of the first mirrored delegation event, 0 if
+// no event was mirrored yet. Positions are known from this block on.
+func (k *Keeper) GetMirroredSince(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(MirroredSinceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetMirroredSince sets mainchain block of the first mirrored delegation event
+func (k *Keeper) SetMirroredSince(ctx sdk.Context, blockNumber uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(MirroredSinceKey, sdk.Uint64ToBigEndian(blockNumber))
+}
+
+//
+// Sequences
+//
+
+// SetDelegationSequence sets mapping for sequence id to bool
+func (k *Keeper) SetDelegationSequence(ctx sdk.Context, sequence string) {
+	store := ctx.KVStore(k.key)
+	store.Set(GetDelegationSequenceKey(sequence), DefaultValue)
+}
+
+// HasDelegationSequence checks if delegation event was already processed
+func (k *Keeper) HasDelegationSequence(ctx sdk.Context, sequence string) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(GetDelegationSequenceKey(sequence))
+}
+
+// GetDelegationSequences returns all processed delegation event sequences
+func (k *Keeper) GetDelegationSequences(ctx sdk.Context) (sequences []string) {
+	store := ctx.KVStore(k.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, DelegationSequenceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sequences = append(sequences, string(iterator.Key()[len(DelegationSequenceKey):]))
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+package delegation_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/delegation"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// KeeperTestSuite integrate test suite context object
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = createTestApp(false)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *KeeperTestSuite) TestDelegationIndexes() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.DelegationKeeper
+
+	alice := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	bob := hmTypes.HexToHeimdallAddress("0x2000000000000000000000000000000000000002")
+
+	_, err := keeper.AddShares(ctx, hmTypes.NewValidatorID(2), alice, sdk.NewInt(100), sdk.NewInt(90), "1")
+	require.NoError(t, err)
+	_, err = keeper.AddShares(ctx, hmTypes.NewValidatorID(1), alice, sdk.NewInt(50), sdk.NewInt(50), "2")
+	require.NoError(t, err)
+	_, err = keeper.AddShares(ctx, hmTypes.NewValidatorID(2), bob, sdk.NewInt(10), sdk.NewInt(9), "3")
+	require.NoError(t, err)
+
+	// by delegator, ordered by validator id
+	delegations := keeper.GetDelegatorDelegations(ctx, alice)
+	require.Len(t, delegations, 2)
+	require.Equal(t, hmTypes.NewValidatorID(1), delegations[0].ValidatorID)
+	require.Equal(t, hmTypes.NewValidatorID(2), delegations[1].ValidatorID)
+	require.Len(t, keeper.GetDelegatorDelegations(ctx, bob), 1)
+
+	// by validator
+	require.Len(t, keeper.GetValidatorDelegations(ctx, hmTypes.NewValidatorID(2)), 2)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, hmTypes.NewValidatorID(1)), 1)
+	require.Empty(t, keeper.GetValidatorDelegations(ctx, hmTypes.NewValidatorID(3)))
+
+	require.Len(t, keeper.GetAllDelegations(ctx), 3)
+}
+
+func (suite *KeeperTestSuite) TestShareUpdates() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.DelegationKeeper
+
+	valID := hmTypes.NewValidatorID(1)
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+
+	_, ok := keeper.GetDelegation(ctx, valID, delegator)
+	require.False(t, ok)
+
+	_, err := keeper.AddShares(ctx, valID, delegator, sdk.NewInt(1000), sdk.NewInt(800), "10")
+	require.NoError(t, err)
+	_, err = keeper.RemoveShares(ctx, valID, delegator, sdk.NewInt(400), sdk.NewInt(300), "11")
+	require.NoError(t, err)
+	_, err = keeper.AddClaimedRewards(ctx, valID, delegator, sdk.NewInt(7), "12")
+	require.NoError(t, err)
+
+	delegation, ok := keeper.GetDelegation(ctx, valID, delegator)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(500), delegation.Shares)
+	require.Equal(t, sdk.NewInt(600), delegation.Amount)
+	require.Equal(t, sdk.NewInt(7), delegation.ClaimedRewards)
+	require.Equal(t, "12", delegation.LastUpdated)
+
+	require.False(t, delegation.Partial)
+
+	// burning more than mirrored floors at zero and flags the position
+	delegation, err = keeper.RemoveShares(ctx, valID, delegator, sdk.NewInt(1000), sdk.NewInt(1000), "13")
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.IsZero())
+	require.True(t, delegation.Amount.IsZero())
+	require.Equal(t, sdk.NewInt(7), delegation.ClaimedRewards)
+	require.True(t, delegation.Partial)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	t, ctx, keeper := suite.T(), suite.ctx, suite.app.DelegationKeeper
+
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	position := types.NewDelegation(hmTypes.NewValidatorID(3), delegator)
+	position.Shares = sdk.NewInt(42)
+	position.Amount = sdk.NewInt(43)
+	position.LastUpdated = "100001"
+
+	genesisState := types.NewGenesisState([]types.Delegation{position}, []string{"100001"}, 1)
+	require.NoError(t, types.ValidateGenesis(genesisState))
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(nil, []string{""}, 0)))
+
+	invalid := position
+	invalid.Delegator = hmTypes.ZeroHeimdallAddress
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState([]types.Delegation{invalid}, nil, 0)))
+
+	invalid = position
+	invalid.ValidatorID = 0
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState([]types.Delegation{invalid}, nil, 0)))
+
+	invalid = position
+	invalid.Amount = sdk.NewInt(-1)
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState([]types.Delegation{invalid}, nil, 0)))
+
+	// export returns what was initialized
+	delegation.InitGenesis(ctx, keeper, genesisState)
+	require.True(t, keeper.HasDelegationSequence(ctx, "100001"))
+	require.Equal(t, uint64(1), keeper.GetMirroredSince(ctx))
+	require.Equal(t, genesisState, delegation.ExportGenesis(ctx, keeper))
+}
@@ -0,0 +1,121 @@
+package delegation
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewQuerier returns a new sdk.Keeper instance.
+func NewQuerier(k Keeper, contractCaller helper.IContractCaller) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QuerySequence:
+			return handleQuerySequence(ctx, req, k, contractCaller)
+		case types.QueryDelegation:
+			return handleQueryDelegation(ctx, req, k)
+		case types.QueryDelegatorDelegations:
+			return handleQueryDelegatorDelegations(ctx, req, k)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown delegation query endpoint")
+		}
+	}
+}
+
+func handleQuerySequence(ctx sdk.Context, req abci.RequestQuery, k Keeper, contractCallerObj helper.IContractCaller) ([]byte, sdk.Error) {
+	var params types.QuerySequenceParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	chainParams := k.chainKeeper.GetParams(ctx)
+
+	// get main tx receipt
+	receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash(), chainParams.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
+
+	// check if incoming tx already exists
+	if !k.HasDelegationSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Debug("No sequence exist", "txHash", params.TxHash, "logIndex", params.LogIndex)
+		return nil, nil
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sequence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegationParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegation, ok := k.GetDelegation(ctx, params.ValidatorID, params.Delegator)
+	if !ok {
+		return nil, types.ErrNoDelegation(k.Codespace())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, delegation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegations := k.GetDelegatorDelegations(ctx, params.Delegator)
+	if delegations == nil {
+		delegations = []types.Delegation{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegations := k.GetValidatorDelegations(ctx, params.ValidatorID)
+	if delegations == nil {
+		delegations = []types.Delegation{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
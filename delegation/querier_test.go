@@ -0,0 +1,150 @@
+package delegation_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/delegation"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// QuerierTestSuite integrate test suite context object
+type QuerierTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	querier        sdk.Querier
+	contractCaller mocks.IContractCaller
+}
+
+// SetupTest setup all necessary things for querier tesing
+func (suite *QuerierTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = createTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.querier = delegation.NewQuerier(suite.app.DelegationKeeper, &suite.contractCaller)
+}
+
+// TestQuerierTestSuite
+func TestQuerierTestSuite(t *testing.T) {
+	suite.Run(t, new(QuerierTestSuite))
+}
+
+// TestInvalidQuery checks request query
+func (suite *QuerierTestSuite) TestInvalidQuery() {
+	t, ctx, querier := suite.T(), suite.ctx, suite.querier
+
+	req := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{"other"}, req)
+	require.Error(t, err)
+	require.Nil(t, bz)
+}
+
+// TestQueryDelegations queries delegations by delegator and validator
+func (suite *QuerierTestSuite) TestQueryDelegations() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	alice := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	bob := hmTypes.HexToHeimdallAddress("0x2000000000000000000000000000000000000002")
+	_, err := app.DelegationKeeper.AddShares(ctx, hmTypes.NewValidatorID(1), alice, sdk.NewInt(10), sdk.NewInt(10), "1")
+	require.NoError(t, err)
+	_, err = app.DelegationKeeper.AddShares(ctx, hmTypes.NewValidatorID(2), alice, sdk.NewInt(20), sdk.NewInt(20), "2")
+	require.NoError(t, err)
+	_, err = app.DelegationKeeper.AddShares(ctx, hmTypes.NewValidatorID(2), bob, sdk.NewInt(30), sdk.NewInt(30), "3")
+	require.NoError(t, err)
+
+	// single delegation
+	req := abci.RequestQuery{
+		Path: route(types.QueryDelegation),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryDelegationParams(hmTypes.NewValidatorID(2), bob)),
+	}
+	res, sdkErr := querier(ctx, []string{types.QueryDelegation}, req)
+	require.NoError(t, sdkErr)
+
+	var position types.Delegation
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &position))
+	require.Equal(t, sdk.NewInt(30), position.Shares)
+
+	// missing delegation
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDelegationParams(hmTypes.NewValidatorID(1), bob))
+	_, sdkErr = querier(ctx, []string{types.QueryDelegation}, req)
+	require.Error(t, sdkErr)
+	require.Equal(t, types.CodeNoDelegation, sdkErr.Code())
+
+	// by delegator
+	req = abci.RequestQuery{
+		Path: route(types.QueryDelegatorDelegations),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryDelegatorParams(alice)),
+	}
+	res, sdkErr = querier(ctx, []string{types.QueryDelegatorDelegations}, req)
+	require.NoError(t, sdkErr)
+
+	var delegations []types.Delegation
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &delegations))
+	require.Len(t, delegations, 2)
+
+	// by validator
+	req = abci.RequestQuery{
+		Path: route(types.QueryValidatorDelegations),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorParams(hmTypes.NewValidatorID(2))),
+	}
+	res, sdkErr = querier(ctx, []string{types.QueryValidatorDelegations}, req)
+	require.NoError(t, sdkErr)
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &delegations))
+	require.Len(t, delegations, 2)
+
+	// empty list is encoded as array
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryValidatorParams(hmTypes.NewValidatorID(9)))
+	res, sdkErr = querier(ctx, []string{types.QueryValidatorDelegations}, req)
+	require.NoError(t, sdkErr)
+
+	var raw []json.RawMessage
+	require.NoError(t, json.Unmarshal(res, &raw))
+	require.Empty(t, raw)
+}
+
+// TestQuerySequence queries sequence of a relayed event
+func (suite *QuerierTestSuite) TestQuerySequence() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	txHash := "0x000000000000000000000000000000000000000000000000000000000000000a"
+	txReceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(7)}
+	suite.contractCaller.On("GetConfirmedTxReceipt", hmTypes.HexToHeimdallHash(txHash).EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+
+	req := abci.RequestQuery{
+		Path: route(types.QuerySequence),
+		Data: app.Codec().MustMarshalJSON(types.NewQuerySequenceParams(txHash, 2)),
+	}
+
+	res, err := querier(ctx, []string{types.QuerySequence}, req)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	sequence := new(big.Int).Mul(big.NewInt(7), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, big.NewInt(2))
+	app.DelegationKeeper.SetDelegationSequence(ctx, sequence.String())
+
+	res, err = querier(ctx, []string{types.QuerySequence}, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func route(path string) string {
+	return "custom/" + types.QuerierRoute + "/" + path
+}
@@ -0,0 +1,289 @@
+package delegation
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns a side handler for "delegation" type messages.
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgShareMint:
+			return SideHandleMsgShareMint(ctx, k, msg, contractCaller)
+		case types.MsgShareBurn:
+			return SideHandleMsgShareBurn(ctx, k, msg, contractCaller)
+		case types.MsgClaimRewards:
+			return SideHandleMsgClaimRewards(ctx, k, msg, contractCaller)
+		default:
+			return abci.ResponseDeliverSideTx{
+				Code: uint32(sdk.CodeUnknownRequest),
+			}
+		}
+	}
+}
+
+// NewPostTxHandler returns a post handler for "delegation" type messages.
+func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult abci.SideTxResultType) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgShareMint:
+			return PostHandleMsgShareMint(ctx, k, msg, sideTxResult)
+		case types.MsgShareBurn:
+			return PostHandleMsgShareBurn(ctx, k, msg, sideTxResult)
+		case types.MsgClaimRewards:
+			return PostHandleMsgClaimRewards(ctx, k, msg, sideTxResult)
+		default:
+			return sdk.ErrUnknownRequest("Unrecognized delegation msg type").Result()
+		}
+	}
+}
+
+// getConfirmedReceipt fetches the confirmed mainchain receipt of a delegation event
+// and checks it belongs to the block number carried by the msg
+func getConfirmedReceipt(ctx sdk.Context, k Keeper, contractCaller helper.IContractCaller, txHash hmTypes.HeimdallHash, blockNumber uint64) (*ethTypes.Receipt, sdk.CodeType) {
+	params := k.chainKeeper.GetParams(ctx)
+
+	receipt, err := contractCaller.GetConfirmedTxReceipt(txHash.EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return nil, common.CodeWaitFrConfirmation
+	}
+
+	if receipt.BlockNumber.Uint64() != blockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", blockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return nil, common.CodeInvalidMsg
+	}
+
+	return receipt, sdk.CodeOK
+}
+
+// validateDelegator checks validator id and delegator address of the event against the msg
+func validateDelegator(ctx sdk.Context, k Keeper, eventValID *big.Int, eventUser []byte, msgValID hmTypes.ValidatorID, msgDelegator hmTypes.HeimdallAddress) bool {
+	if eventValID.Uint64() != msgValID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msgValID, "validatorIdFromTx", eventValID)
+		return false
+	}
+
+	if !bytes.Equal(eventUser, msgDelegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesn't match with user in log", "msgDelegator", msgDelegator.String(), "userFromTx", hmTypes.BytesToHeimdallAddress(eventUser).String())
+		return false
+	}
+
+	return true
+}
+
+// SideHandleMsgShareMint handles MsgShareMint message for external call
+func SideHandleMsgShareMint(ctx sdk.Context, k Keeper, msg types.MsgShareMint, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for share mint msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	receipt, code := getConfirmedReceipt(ctx, k, contractCaller, msg.TxHash, msg.BlockNumber)
+	if code != sdk.CodeOK {
+		return common.ErrorSideTx(k.Codespace(), code)
+	}
+
+	chainParams := k.chainKeeper.GetParams(ctx).ChainParams
+	eventLog, err := contractCaller.DecodeShareMintedEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return common.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if !validateDelegator(ctx, k, eventLog.ValidatorId, eventLog.User.Bytes(), msg.ID, msg.Delegator) {
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 || eventLog.Tokens.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount or shares in message don't match with log", "msgAmount", msg.Amount, "amountFromTx", eventLog.Amount, "msgShares", msg.Shares, "sharesFromTx", eventLog.Tokens)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for share mint msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgShareBurn handles MsgShareBurn message for external call
+func SideHandleMsgShareBurn(ctx sdk.Context, k Keeper, msg types.MsgShareBurn, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for share burn msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	receipt, code := getConfirmedReceipt(ctx, k, contractCaller, msg.TxHash, msg.BlockNumber)
+	if code != sdk.CodeOK {
+		return common.ErrorSideTx(k.Codespace(), code)
+	}
+
+	chainParams := k.chainKeeper.GetParams(ctx).ChainParams
+	eventLog, err := contractCaller.DecodeShareBurnedEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return common.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if !validateDelegator(ctx, k, eventLog.ValidatorId, eventLog.User.Bytes(), msg.ID, msg.Delegator) {
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 || eventLog.Tokens.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount or shares in message don't match with log", "msgAmount", msg.Amount, "amountFromTx", eventLog.Amount, "msgShares", msg.Shares, "sharesFromTx", eventLog.Tokens)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for share burn msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+// SideHandleMsgClaimRewards handles MsgClaimRewards message for external call
+func SideHandleMsgClaimRewards(ctx sdk.Context, k Keeper, msg types.MsgClaimRewards, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for claim rewards msg",
+		"txHash", hmTypes.BytesToHeimdallHash(msg.TxHash.Bytes()),
+		"logIndex", uint64(msg.LogIndex),
+		"blockNumber", msg.BlockNumber,
+	)
+
+	receipt, code := getConfirmedReceipt(ctx, k, contractCaller, msg.TxHash, msg.BlockNumber)
+	if code != sdk.CodeOK {
+		return common.ErrorSideTx(k.Codespace(), code)
+	}
+
+	chainParams := k.chainKeeper.GetParams(ctx).ChainParams
+	eventLog, err := contractCaller.DecodeDelClaimRewardsEvent(chainParams.StakingInfoAddress.EthAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return common.ErrorSideTx(k.Codespace(), common.CodeErrDecodeEvent)
+	}
+
+	if !validateDelegator(ctx, k, eventLog.ValidatorId, eventLog.User.Bytes(), msg.ID, msg.Delegator) {
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	if eventLog.Rewards.Cmp(msg.Rewards.BigInt()) != 0 {
+		k.Logger(ctx).Error("Rewards in message don't match with log", "msgRewards", msg.Rewards, "rewardsFromTx", eventLog.Rewards)
+		return common.ErrorSideTx(k.Codespace(), common.CodeInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Succesfully validated External call for claim rewards msg")
+	result.Result = abci.SideTxResultType_Yes
+	return
+}
+
+/*
+	Post Handlers - update state/tx events
+*/
+
+// PostHandleMsgShareMint handles share mint after side-tx approval
+func PostHandleMsgShareMint(ctx sdk.Context, k Keeper, msg types.MsgShareMint, sideTxResult abci.SideTxResultType) sdk.Result {
+	return postHandleDelegationEvent(ctx, k, msg, msg.BlockNumber, msg.LogIndex, sideTxResult, func(sequence string) (types.Delegation, error) {
+		return k.AddShares(ctx, msg.ID, msg.Delegator, msg.Amount, msg.Shares, sequence)
+	}, types.EventTypeShareMint,
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+	)
+}
+
+// PostHandleMsgShareBurn handles share burn after side-tx approval
+func PostHandleMsgShareBurn(ctx sdk.Context, k Keeper, msg types.MsgShareBurn, sideTxResult abci.SideTxResultType) sdk.Result {
+	return postHandleDelegationEvent(ctx, k, msg, msg.BlockNumber, msg.LogIndex, sideTxResult, func(sequence string) (types.Delegation, error) {
+		return k.RemoveShares(ctx, msg.ID, msg.Delegator, msg.Amount, msg.Shares, sequence)
+	}, types.EventTypeShareBurn,
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, msg.Shares.String()),
+	)
+}
+
+// PostHandleMsgClaimRewards handles rewards claim after side-tx approval
+func PostHandleMsgClaimRewards(ctx sdk.Context, k Keeper, msg types.MsgClaimRewards, sideTxResult abci.SideTxResultType) sdk.Result {
+	return postHandleDelegationEvent(ctx, k, msg, msg.BlockNumber, msg.LogIndex, sideTxResult, func(sequence string) (types.Delegation, error) {
+		return k.AddClaimedRewards(ctx, msg.ID, msg.Delegator, msg.Rewards, sequence)
+	}, types.EventTypeClaimRewards,
+		sdk.NewAttribute(types.AttributeKeyRewards, msg.Rewards.String()),
+	)
+}
+
+// postHandleDelegationEvent applies an approved delegation event exactly once and emits its event
+func postHandleDelegationEvent(
+	ctx sdk.Context,
+	k Keeper,
+	msg sdk.Msg,
+	blockNumber uint64,
+	logIndex uint64,
+	sideTxResult abci.SideTxResultType,
+	apply func(sequence string) (types.Delegation, error),
+	eventType string,
+	attributes ...sdk.Attribute,
+) sdk.Result {
+	// Skip handler if delegation event is not approved
+	if sideTxResult != abci.SideTxResultType_Yes {
+		k.Logger(ctx).Debug("Skipping delegation event since side-tx didn't get yes votes", "type", msg.Type())
+		return common.ErrSideTxValidation(k.Codespace()).Result()
+	}
+
+	// another event in the same block may have started the mirror
+	if err := checkMirror(ctx, k, blockNumber); err != nil {
+		return err.Result()
+	}
+
+	// check if incoming tx is older
+	sequence := getSequence(blockNumber, logIndex)
+	if k.HasDelegationSequence(ctx, sequence) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return common.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// first mirrored event starts the mirror
+	if k.GetMirroredSince(ctx) == 0 {
+		k.SetMirroredSince(ctx, blockNumber)
+	}
+
+	delegation, err := apply(sequence)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update delegation", "type", msg.Type(), "error", err)
+		return types.ErrDelegationSave(k.Codespace()).Result()
+	}
+
+	// save delegation event sequence
+	k.SetDelegationSequence(ctx, sequence)
+
+	k.Logger(ctx).Debug("Persisted delegation state", "delegation", delegation.String())
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                  // action
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                // module name
+				sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+				sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),             // result
+				sdk.NewAttribute(types.AttributeKeyValidatorID, delegation.ValidatorID.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delegation.Delegator.String()),
+			}, attributes...)...,
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,239 @@
+package delegation_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/delegation"
+	"github.com/maticnetwork/heimdall/delegation/types"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Create test suite
+//
+
+// SideHandlerTestSuite integrate test suite context object
+type SideHandlerTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	sideHandler    hmTypes.SideTxHandler
+	postHandler    hmTypes.PostTxHandler
+	contractCaller mocks.IContractCaller
+}
+
+func (suite *SideHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = createTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = delegation.NewSideTxHandler(suite.app.DelegationKeeper, &suite.contractCaller)
+	suite.postHandler = delegation.NewPostTxHandler(suite.app.DelegationKeeper, &suite.contractCaller)
+}
+
+func TestSideHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SideHandlerTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *SideHandlerTestSuite) TestSideHandler() {
+	t, ctx := suite.T(), suite.ctx
+
+	// side handler
+	result := suite.sideHandler(ctx, nil)
+	require.Equal(t, uint32(sdk.CodeUnknownRequest), result.Code)
+	require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgShareMint() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	logIndex := uint64(3)
+	blockNumber := uint64(512)
+	txHash := hmTypes.HexToHeimdallHash("0x01")
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	event := &stakinginfo.StakinginfoShareMinted{
+		ValidatorId: big.NewInt(1),
+		User:        ethCommon.BytesToAddress(delegator.Bytes()),
+		Amount:      big.NewInt(1000),
+		Tokens:      big.NewInt(900),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		msg := types.NewMsgShareMint(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 1, delegator, sdk.NewInt(1000), sdk.NewInt(900), txHash, logIndex, blockNumber)
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(sdk.CodeOK), result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_Yes, result.Result, "Result should be `yes`")
+
+		// nothing is persisted by the side handler
+		_, ok := app.DelegationKeeper.GetDelegation(ctx, hmTypes.NewValidatorID(1), delegator)
+		require.False(t, ok)
+	})
+
+	t.Run("NoReceipt", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+
+		msg := types.NewMsgShareMint(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 1, delegator, sdk.NewInt(1000), sdk.NewInt(900), txHash, logIndex, blockNumber)
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeWaitFrConfirmation), result.Code)
+		require.Equal(t, abci.SideTxResultType_Skip, result.Result)
+	})
+
+	t.Run("SharesMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		msg := types.NewMsgShareMint(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 1, delegator, sdk.NewInt(1000), sdk.NewInt(901), txHash, logIndex, blockNumber)
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeInvalidMsg), result.Code)
+	})
+
+	t.Run("DelegatorMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeShareMintedEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txReceipt, logIndex).Return(event, nil)
+
+		other := hmTypes.HexToHeimdallAddress("0x2000000000000000000000000000000000000002")
+		msg := types.NewMsgShareMint(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 1, other, sdk.NewInt(1000), sdk.NewInt(900), txHash, logIndex, blockNumber)
+		result := suite.sideHandler(ctx, msg)
+		require.Equal(t, uint32(common.CodeInvalidMsg), result.Code)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgClaimRewards() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := app.ChainKeeper.GetParams(ctx)
+
+	logIndex := uint64(1)
+	blockNumber := uint64(20)
+	txHash := hmTypes.HexToHeimdallHash("0x02")
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+
+	suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+	suite.contractCaller.On("DecodeDelClaimRewardsEvent", chainParams.ChainParams.StakingInfoAddress.EthAddress(), txReceipt, logIndex).Return(&stakinginfo.StakinginfoDelClaimRewards{
+		ValidatorId: big.NewInt(2),
+		User:        ethCommon.BytesToAddress(delegator.Bytes()),
+		Rewards:     big.NewInt(5),
+		Tokens:      big.NewInt(0),
+	}, nil)
+
+	msg := types.NewMsgClaimRewards(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 2, delegator, sdk.NewInt(5), txHash, logIndex, blockNumber)
+	result := suite.sideHandler(ctx, msg)
+	require.Equal(t, abci.SideTxResultType_Yes, result.Result)
+
+	// wrong validator
+	msg = types.NewMsgClaimRewards(hmTypes.BytesToHeimdallAddress([]byte("proposer")), 3, delegator, sdk.NewInt(5), txHash, logIndex, blockNumber)
+	result = suite.sideHandler(ctx, msg)
+	require.Equal(t, uint32(common.CodeInvalidMsg), result.Code)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandler() {
+	t, ctx := suite.T(), suite.ctx
+
+	// post handler
+	result := suite.postHandler(ctx, nil, abci.SideTxResultType_Yes)
+	require.False(t, result.IsOK(), "Post handler should fail")
+	require.Equal(t, sdk.CodeUnknownRequest, result.Code)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleDelegationEvents() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	proposer := hmTypes.BytesToHeimdallAddress([]byte("proposer"))
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	txHash := hmTypes.HexToHeimdallHash("0x03")
+
+	mint := types.NewMsgShareMint(proposer, 1, delegator, sdk.NewInt(1000), sdk.NewInt(800), txHash, 0, 100)
+
+	// rejected side-tx is not applied
+	result := suite.postHandler(ctx, mint, abci.SideTxResultType_No)
+	require.False(t, result.IsOK())
+	_, ok := app.DelegationKeeper.GetDelegation(ctx, hmTypes.NewValidatorID(1), delegator)
+	require.False(t, ok)
+
+	result = suite.postHandler(ctx, mint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+	require.NotEmpty(t, result.Events)
+
+	// replay is rejected
+	result = suite.postHandler(ctx, mint, abci.SideTxResultType_Yes)
+	require.Equal(t, common.CodeOldTx, result.Code)
+
+	burn := types.NewMsgShareBurn(proposer, 1, delegator, sdk.NewInt(250), sdk.NewInt(200), txHash, 1, 100)
+	result = suite.postHandler(ctx, burn, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+
+	claim := types.NewMsgClaimRewards(proposer, 1, delegator, sdk.NewInt(3), txHash, 2, 100)
+	result = suite.postHandler(ctx, claim, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+
+	position, ok := app.DelegationKeeper.GetDelegation(ctx, hmTypes.NewValidatorID(1), delegator)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(600), position.Shares)
+	require.Equal(t, sdk.NewInt(750), position.Amount)
+	require.Equal(t, sdk.NewInt(3), position.ClaimedRewards)
+
+	// already processed events are rejected by the handler
+	handler := delegation.NewHandler(app.DelegationKeeper, &suite.contractCaller)
+	require.Equal(t, common.CodeOldTx, handler(ctx, burn).Code)
+	require.True(t, handler(ctx, types.NewMsgShareBurn(proposer, 1, delegator, sdk.NewInt(1), sdk.NewInt(1), txHash, 3, 100)).IsOK())
+}
+
+func (suite *SideHandlerTestSuite) TestMirroredSince() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	proposer := hmTypes.BytesToHeimdallAddress([]byte("proposer"))
+	delegator := hmTypes.HexToHeimdallAddress("0x1000000000000000000000000000000000000001")
+	txHash := hmTypes.HexToHeimdallHash("0x03")
+	handler := delegation.NewHandler(app.DelegationKeeper, &suite.contractCaller)
+
+	// genesis enables the mirror, first mirrored event starts it
+	require.True(t, app.DelegationKeeper.IsMirrorEnabled(ctx))
+	require.Equal(t, uint64(0), app.DelegationKeeper.GetMirroredSince(ctx))
+
+	mint := types.NewMsgShareMint(proposer, 1, delegator, sdk.NewInt(1000), sdk.NewInt(800), txHash, 0, 100)
+	require.True(t, handler(ctx, mint).IsOK())
+	result := suite.postHandler(ctx, mint, abci.SideTxResultType_Yes)
+	require.True(t, result.IsOK(), "expected post handler to succeed, got %v", result)
+	require.Equal(t, uint64(100), app.DelegationKeeper.GetMirroredSince(ctx))
+
+	// events before the first mirrored one are rejected
+	older := types.NewMsgShareBurn(proposer, 1, delegator, sdk.NewInt(250), sdk.NewInt(200), txHash, 0, 99)
+	require.Equal(t, types.CodeBeforeMirror, handler(ctx, older).Code)
+	require.Equal(t, types.CodeBeforeMirror, suite.postHandler(ctx, older, abci.SideTxResultType_Yes).Code)
+
+	// events are rejected until the mirror is enabled
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(delegation.MirroredSinceKey)
+	require.False(t, app.DelegationKeeper.IsMirrorEnabled(ctx))
+	later := types.NewMsgShareBurn(proposer, 1, delegator, sdk.NewInt(250), sdk.NewInt(200), txHash, 1, 101)
+	require.Equal(t, types.CodeMirrorDisabled, handler(ctx, later).Code)
+	require.Equal(t, types.CodeMirrorDisabled, suite.postHandler(ctx, later, abci.SideTxResultType_Yes).Code)
+
+	app.DelegationKeeper.EnableMirror(ctx)
+	require.True(t, handler(ctx, later).IsOK())
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgShareMint{}, "delegation/MsgShareMint", nil)
+	cdc.RegisterConcrete(MsgShareBurn{}, "delegation/MsgShareBurn", nil)
+	cdc.RegisterConcrete(MsgClaimRewards{}, "delegation/MsgClaimRewards", nil)
+}
+
+// ModuleCdc module cdc
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
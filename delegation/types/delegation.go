@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Delegation is a delegator's position on a single validator, mirrored from
+// the StakingInfo share events emitted by the validator share contracts.
+type Delegation struct {
+	ValidatorID    hmTypes.ValidatorID     `json:"validator_id"`
+	Delegator      hmTypes.HeimdallAddress `json:"delegator"`
+	Shares         sdk.Int                 `json:"shares"`
+	Amount         sdk.Int                 `json:"amount"`
+	ClaimedRewards sdk.Int                 `json:"claimed_rewards"`
+	LastUpdated    string                  `json:"last_updated"`
+	Partial        bool                    `json:"partial"` // opened before mirror started, balances are lower bounds
+}
+
+// NewDelegation creates an empty delegation for the given validator and delegator
+func NewDelegation(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) Delegation {
+	return Delegation{
+		ValidatorID:    validatorID,
+		Delegator:      delegator,
+		Shares:         sdk.ZeroInt(),
+		Amount:         sdk.ZeroInt(),
+		ClaimedRewards: sdk.ZeroInt(),
+	}
+}
+
+// Validate performs basic validation of a delegation
+func (d Delegation) Validate() error {
+	if d.ValidatorID == 0 {
+		return fmt.Errorf("invalid validator id %v", d.ValidatorID)
+	}
+
+	if d.Delegator.Empty() {
+		return fmt.Errorf("empty delegator address for validator %v", d.ValidatorID)
+	}
+
+	if d.Shares.IsNegative() || d.Amount.IsNegative() || d.ClaimedRewards.IsNegative() {
+		return fmt.Errorf("negative balance in delegation %v/%v", d.ValidatorID, d.Delegator)
+	}
+
+	return nil
+}
+
+// String returns human readable string
+func (d Delegation) String() string {
+	return fmt.Sprintf(
+		"Delegation{%v %v shares:%v amount:%v rewards:%v}",
+		d.ValidatorID,
+		d.Delegator.String(),
+		d.Shares,
+		d.Amount,
+		d.ClaimedRewards,
+	)
+}
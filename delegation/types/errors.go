@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Delegation errors reserve 100 ~ 199.
+const (
+	CodeNoDelegation   sdk.CodeType = 101
+	CodeDelegationSave sdk.CodeType = 102
+	CodeMirrorDisabled sdk.CodeType = 103
+	CodeBeforeMirror   sdk.CodeType = 104
+)
+
+// ErrNoDelegation is an error for a missing delegator position
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation found")
+}
+
+// ErrDelegationSave is an error for a failed delegation update
+func ErrDelegationSave(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDelegationSave, "cannot save delegation")
+}
+
+// ErrMirrorDisabled is an error for a delegation event before mirroring is enabled
+func ErrMirrorDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMirrorDisabled, "delegation mirror is not enabled")
+}
+
+// ErrBeforeMirror is an error for a delegation event older than the first mirrored one
+func ErrBeforeMirror(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBeforeMirror, "delegation event is older than mirrored since block")
+}
@@ -0,0 +1,16 @@
+package types
+
+// delegation module event types
+const (
+	EventTypeShareMint    = "share-mint"
+	EventTypeShareBurn    = "share-burn"
+	EventTypeClaimRewards = "claim-rewards"
+
+	AttributeKeyValidatorID = "validator-id"
+	AttributeKeyDelegator   = "delegator"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyShares      = "shares"
+	AttributeKeyRewards     = "rewards"
+
+	AttributeValueCategory = ModuleName
+)
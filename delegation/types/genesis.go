@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// GenesisState is the delegation state that must be provided at genesis.
+type GenesisState struct {
+	Delegations []Delegation `json:"delegations" yaml:"delegations"`
+	Sequences   []string     `json:"sequences" yaml:"sequences"`
+
+	// MirroredSince is the mainchain block of the first mirrored event, 0 if none was mirrored yet
+	MirroredSince uint64 `json:"mirrored_since" yaml:"mirrored_since"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(delegations []Delegation, sequences []string, mirroredSince uint64) GenesisState {
+	return GenesisState{
+		Delegations:   delegations,
+		Sequences:     sequences,
+		MirroredSince: mirroredSince,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil, nil, 0)
+}
+
+// ValidateGenesis performs basic validation of delegation genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	for _, delegation := range data.Delegations {
+		if err := delegation.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, sq := range data.Sequences {
+		if sq == "" {
+			return errors.New("Invalid Sequence")
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns delegation GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "delegation"
+
+	// StoreKey is the store key string for delegation
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for delegation
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for delegation
+	QuerierRoute = ModuleName
+
+	// DefaultCodespace default code space
+	DefaultCodespace sdk.CodespaceType = ModuleName
+)
@@ -0,0 +1,284 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Share mint
+//
+
+var _ sdk.Msg = &MsgShareMint{}
+
+// MsgShareMint represents delegator shares minted on a validator
+type MsgShareMint struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      sdk.Int                 `json:"amount"`
+	Shares      sdk.Int                 `json:"shares"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareMint creates new share-mint msg
+func NewMsgShareMint(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	amount sdk.Int,
+	shares sdk.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgShareMint {
+	return MsgShareMint{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Shares:      shares,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgShareMint) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgShareMint) Type() string {
+	return "share-mint"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgShareMint) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgShareMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgShareMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareMint) GetTxHash() hmTypes.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareMint) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgShareMint) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// Share burn
+//
+
+var _ sdk.Msg = &MsgShareBurn{}
+
+// MsgShareBurn represents delegator shares burned on a validator
+type MsgShareBurn struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Amount      sdk.Int                 `json:"amount"`
+	Shares      sdk.Int                 `json:"shares"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareBurn creates new share-burn msg
+func NewMsgShareBurn(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	amount sdk.Int,
+	shares sdk.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgShareBurn {
+	return MsgShareBurn{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Amount:      amount,
+		Shares:      shares,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgShareBurn) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgShareBurn) Type() string {
+	return "share-burn"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgShareBurn) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgShareBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgShareBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareBurn) GetTxHash() hmTypes.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareBurn) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgShareBurn) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// Claim rewards
+//
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// MsgClaimRewards represents delegator rewards claimed from a validator
+type MsgClaimRewards struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Rewards     sdk.Int                 `json:"rewards"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgClaimRewards creates new claim-rewards msg
+func NewMsgClaimRewards(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	rewards sdk.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgClaimRewards {
+	return MsgClaimRewards{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Rewards:     rewards,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgClaimRewards) Type() string {
+	return "claim-rewards"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimRewards) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgClaimRewards) GetTxHash() hmTypes.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgClaimRewards) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns side sign bytes
+func (msg MsgClaimRewards) GetSideSignBytes() []byte {
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the delegation Querier
+const (
+	QuerySequence             = "sequence"
+	QueryDelegation           = "delegation"
+	QueryDelegatorDelegations = "delegator-delegations"
+	QueryValidatorDelegations = "validator-delegations"
+)
+
+// QuerySequenceParams defines the params for querying a delegation event sequence.
+type QuerySequenceParams struct {
+	TxHash   string
+	LogIndex uint64
+}
+
+// NewQuerySequenceParams creates a new instance of QuerySequenceParams.
+func NewQuerySequenceParams(txHash string, logIndex uint64) QuerySequenceParams {
+	return QuerySequenceParams{TxHash: txHash, LogIndex: logIndex}
+}
+
+// QueryDelegationParams defines the params for querying a single delegation.
+type QueryDelegationParams struct {
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+}
+
+// NewQueryDelegationParams creates a new instance of QueryDelegationParams.
+func NewQueryDelegationParams(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) QueryDelegationParams {
+	return QueryDelegationParams{ValidatorID: validatorID, Delegator: delegator}
+}
+
+// QueryDelegatorParams defines the params for querying delegations of a delegator.
+type QueryDelegatorParams struct {
+	Delegator hmTypes.HeimdallAddress `json:"delegator"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams.
+func NewQueryDelegatorParams(delegator hmTypes.HeimdallAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{Delegator: delegator}
+}
+
+// QueryValidatorParams defines the params for querying delegations of a validator.
+type QueryValidatorParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams.
+func NewQueryValidatorParams(validatorID hmTypes.ValidatorID) QueryValidatorParams {
+	return QueryValidatorParams{ValidatorID: validatorID}
+}
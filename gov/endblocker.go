@@ -58,8 +58,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
 
-				// write state to the underlying multi-store
+				// write state to the underlying multi-store, along with events of handler
 				writeCache()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 			} else {
 				proposal.Status = types.StatusFailed
 				tagValue = types.AttributeValueProposalFailed
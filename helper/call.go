@@ -9,8 +9,10 @@ import (
 	"strings"
 
 	lru "github.com/hashicorp/golang-lru"
+	ethereum "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rpc"
@@ -30,7 +32,10 @@ import (
 type IContractCaller interface {
 	GetHeaderInfo(headerID uint64, rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error)
+	GetBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error)
 	GetValidatorInfo(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (validator types.Validator, err error)
+	GetValidatorNonce(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (uint64, error)
+	GetStakingEvents(stakingInfoAddress common.Address, fromBlock uint64, toBlock uint64) ([]StakingEvent, error)
 	GetLastChildBlock(rootChainInstance *rootchain.Rootchain) (uint64, error)
 	CurrentHeaderBlock(rootChainInstance *rootchain.Rootchain, childBlockInterval uint64) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
@@ -39,6 +44,7 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMainChainBaseFee() (*big.Int, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -51,6 +57,10 @@ type IContractCaller interface {
 	DecodeValidatorStakeUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeValidatorExitEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
 	DecodeSignerUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	// decode delegation events
+	DecodeShareMintedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
+	DecodeDelClaimRewardsEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoDelClaimRewards, error)
 	// decode state events
 	DecodeStateSyncedEvent(common.Address, *ethTypes.Receipt, uint64) (*statesender.StatesenderStateSynced, error)
 
@@ -62,6 +72,7 @@ type IContractCaller interface {
 	GetMaticTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	ApproveTokens(*big.Int, common.Address, common.Address, *erc20.Erc20) error
 	StakeFor(common.Address, *big.Int, *big.Int, bool, common.Address, *stakemanager.Stakemanager) error
+	UpdateSigner(*big.Int, []byte, common.Address, *stakemanager.Stakemanager) error
 	CurrentAccountStateRoot(stakingInfoInstance *stakinginfo.Stakinginfo) ([32]byte, error)
 
 	// bor related contracts
@@ -69,6 +80,7 @@ type IContractCaller interface {
 	GetSpanDetails(id *big.Int, validatorset *validatorset.Validatorset) (*big.Int, *big.Int, *big.Int, error)
 	CurrentStateCounter(stateSenderInstance *statesender.Statesender) (Number *big.Int)
 	CheckIfBlocksExist(end uint64) bool
+	GetChildChainCaller(borChainID string) (IContractCaller, error)
 
 	GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error)
 	GetStakingInfoInstance(stakingInfoAddress common.Address) (*stakinginfo.Stakinginfo, error)
@@ -97,6 +109,7 @@ type ContractCaller struct {
 	MaticTokenABI    abi.ABI
 
 	ReceiptCache *lru.Cache
+	HeaderCache  *HeaderCache
 
 	ContractInstanceCache map[common.Address]interface{}
 }
@@ -118,6 +131,7 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 	contractCallerObj.MainChainRPC = GetMainChainRPCClient()
 	contractCallerObj.MaticChainRPC = GetMaticRPCClient()
 	contractCallerObj.ReceiptCache, _ = NewLru(1000)
+	contractCallerObj.HeaderCache = GetSharedHeaderCache()
 
 	//
 	// ABIs
@@ -164,7 +178,7 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address) (*rootchain.Rootchain, error) {
 	contractInstance, ok := c.ContractInstanceCache[rootchainAddress]
 	if !ok {
-		ci, err := rootchain.NewRootchain(rootchainAddress, mainChainClient)
+		ci, err := rootchain.NewRootchain(rootchainAddress, c.MainChainClient)
 		c.ContractInstanceCache[rootchainAddress] = ci
 		return ci, err
 	}
@@ -175,7 +189,7 @@ func (c *ContractCaller) GetRootChainInstance(rootchainAddress common.Address) (
 func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Address) (*stakinginfo.Stakinginfo, error) {
 	contractInstance, ok := c.ContractInstanceCache[stakingInfoAddress]
 	if !ok {
-		ci, err := stakinginfo.NewStakinginfo(stakingInfoAddress, mainChainClient)
+		ci, err := stakinginfo.NewStakinginfo(stakingInfoAddress, c.MainChainClient)
 		c.ContractInstanceCache[stakingInfoAddress] = ci
 		return ci, err
 	}
@@ -186,7 +200,7 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 func (c *ContractCaller) GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error) {
 	contractInstance, ok := c.ContractInstanceCache[validatorSetAddress]
 	if !ok {
-		ci, err := validatorset.NewValidatorset(validatorSetAddress, mainChainClient)
+		ci, err := validatorset.NewValidatorset(validatorSetAddress, c.MainChainClient)
 		c.ContractInstanceCache[validatorSetAddress] = ci
 		return ci, err
 
@@ -198,7 +212,7 @@ func (c *ContractCaller) GetValidatorSetInstance(validatorSetAddress common.Addr
 func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Address) (*stakemanager.Stakemanager, error) {
 	contractInstance, ok := c.ContractInstanceCache[stakingManagerAddress]
 	if !ok {
-		ci, err := stakemanager.NewStakemanager(stakingManagerAddress, mainChainClient)
+		ci, err := stakemanager.NewStakemanager(stakingManagerAddress, c.MainChainClient)
 		c.ContractInstanceCache[stakingManagerAddress] = ci
 		return ci, err
 	}
@@ -209,7 +223,7 @@ func (c *ContractCaller) GetStakeManagerInstance(stakingManagerAddress common.Ad
 func (c *ContractCaller) GetSlashManagerInstance(slashManagerAddress common.Address) (*slashmanager.Slashmanager, error) {
 	contractInstance, ok := c.ContractInstanceCache[slashManagerAddress]
 	if !ok {
-		ci, err := slashmanager.NewSlashmanager(slashManagerAddress, mainChainClient)
+		ci, err := slashmanager.NewSlashmanager(slashManagerAddress, c.MainChainClient)
 		c.ContractInstanceCache[slashManagerAddress] = ci
 		return ci, err
 	}
@@ -220,7 +234,7 @@ func (c *ContractCaller) GetSlashManagerInstance(slashManagerAddress common.Addr
 func (c *ContractCaller) GetStateSenderInstance(stateSenderAddress common.Address) (*statesender.Statesender, error) {
 	contractInstance, ok := c.ContractInstanceCache[stateSenderAddress]
 	if !ok {
-		ci, err := statesender.NewStatesender(stateSenderAddress, mainChainClient)
+		ci, err := statesender.NewStatesender(stateSenderAddress, c.MainChainClient)
 		c.ContractInstanceCache[stateSenderAddress] = ci
 		return ci, err
 	}
@@ -231,7 +245,7 @@ func (c *ContractCaller) GetStateSenderInstance(stateSenderAddress common.Addres
 func (c *ContractCaller) GetStateReceiverInstance(stateReceiverAddress common.Address) (*statereceiver.Statereceiver, error) {
 	contractInstance, ok := c.ContractInstanceCache[stateReceiverAddress]
 	if !ok {
-		ci, err := statereceiver.NewStatereceiver(stateReceiverAddress, mainChainClient)
+		ci, err := statereceiver.NewStatereceiver(stateReceiverAddress, c.MainChainClient)
 		c.ContractInstanceCache[stateReceiverAddress] = ci
 		return ci, err
 	}
@@ -242,7 +256,7 @@ func (c *ContractCaller) GetStateReceiverInstance(stateReceiverAddress common.Ad
 func (c *ContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	contractInstance, ok := c.ContractInstanceCache[maticTokenAddress]
 	if !ok {
-		ci, err := erc20.NewErc20(maticTokenAddress, mainChainClient)
+		ci, err := erc20.NewErc20(maticTokenAddress, c.MainChainClient)
 		c.ContractInstanceCache[maticTokenAddress] = ci
 		return ci, err
 	}
@@ -282,7 +296,7 @@ func (c *ContractCaller) GetHeaderInfo(number uint64, rootChainInstance *rootcha
 		nil
 }
 
-// GetRootHash get root hash from bor chain
+// GetRootHash computes root hash of bor headers from start to end, only fetching headers missing in the header cache
 func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength uint64) ([]byte, error) {
 	noOfBlock := end - start + 1
 
@@ -294,12 +308,34 @@ func (c *ContractCaller) GetRootHash(start uint64, end uint64, checkpointLength
 		return nil, errors.New("number of headers requested exceeds")
 	}
 
-	rootHash, err := c.MaticChainClient.GetRootHash(context.Background(), start, end)
+	rootHash, err := c.HeaderCache.GetRootHash(start, end, func(start uint64, end uint64) ([]*ethTypes.Header, error) {
+		return fetchHeaders(c.MaticChainRPC, start, end)
+	})
 	if err != nil {
+		Logger.Error("Could not compute roothash of matic chain headers", "start", start, "end", end, "Error", err)
 		return nil, errors.New("Could not fetch roothash from matic chain")
 	}
 
-	return common.FromHex(rootHash), nil
+	return rootHash, nil
+}
+
+// GetBlockHeaders fetches the bor block headers from start to end
+func (c *ContractCaller) GetBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	if end-start+1 > checkpointLength {
+		return nil, errors.New("number of headers requested exceeds")
+	}
+
+	headers, err := fetchHeaders(c.MaticChainRPC, start, end)
+	if err != nil {
+		Logger.Error("Unable to fetch headers from matic chain", "start", start, "end", end, "Error", err)
+		return nil, err
+	}
+
+	return headers, nil
 }
 
 // GetLastChildBlock fetch current child block
@@ -369,6 +405,24 @@ func (c *ContractCaller) GetMainChainBlock(blockNum *big.Int) (header *ethTypes.
 	return latestBlock, nil
 }
 
+// GetMainChainBaseFee returns base fee of latest main chain block.
+// Falls back to suggested gas price if main chain has no base fee.
+func (c *ContractCaller) GetMainChainBaseFee() (*big.Int, error) {
+	var head struct {
+		BaseFee *hexutil.Big `json:"baseFeePerGas"`
+	}
+	if err := c.MainChainRPC.CallContext(context.Background(), &head, "eth_getBlockByNumber", "latest", false); err != nil {
+		Logger.Error("Unable to connect to main chain", "Error", err)
+		return nil, err
+	}
+
+	if head.BaseFee != nil {
+		return head.BaseFee.ToInt(), nil
+	}
+
+	return c.MainChainClient.SuggestGasPrice(context.Background())
+}
+
 // GetMaticChainBlock returns child chain block header
 func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes.Header, err error) {
 	latestBlock, err := c.MaticChainClient.HeaderByNumber(context.Background(), blockNum)
@@ -496,6 +550,72 @@ func (c *ContractCaller) DecodeValidatorTopupFeesEvent(contractAddress common.Ad
 	return event, nil
 }
 
+// DecodeShareMintedEvent represents delegator shares minted event
+func (c *ContractCaller) DecodeShareMintedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator shares burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeDelClaimRewardsEvent represents delegator rewards claimed event
+func (c *ContractCaller) DecodeDelClaimRewardsEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoDelClaimRewards, error) {
+	event := new(stakinginfo.StakinginfoDelClaimRewards)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "DelClaimRewards", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeValidatorJoinEvent represents validator staked event
 func (c *ContractCaller) DecodeValidatorJoinEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStaked, error) {
 	event := new(stakinginfo.StakinginfoStaked)
@@ -652,6 +772,103 @@ func (c *ContractCaller) DecodeUnJailedEvent(contractAddress common.Address, rec
 	return event, nil
 }
 
+//
+// Staking nonce related functions
+//
+
+// StakingNonceEvents are the StakingInfo events which increment validator nonce
+var StakingNonceEvents = []string{"Staked", "StakeUpdate", "SignerChange", "UnstakeInit"}
+
+// StakingEvent is a StakingInfo event carrying validator nonce
+type StakingEvent struct {
+	Name        string      `json:"name"`
+	ValidatorID uint64      `json:"validator_id"`
+	Nonce       uint64      `json:"nonce"`
+	TxHash      common.Hash `json:"tx_hash"`
+	LogIndex    uint64      `json:"log_index"`
+	BlockNumber uint64      `json:"block_number"`
+}
+
+// GetValidatorNonce returns nonce of validator on StakingInfo contract
+func (c *ContractCaller) GetValidatorNonce(valID types.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (uint64, error) {
+	nonce, err := stakingInfoInstance.ValidatorNonce(nil, new(big.Int).SetUint64(valID.Uint64()))
+	if err != nil {
+		Logger.Error("Error fetching validator nonce from staking info", "error", err, "validatorId", valID)
+		return 0, err
+	}
+
+	return nonce.Uint64(), nil
+}
+
+// GetStakingEvents returns StakingInfo events carrying validator nonce between fromBlock and toBlock, in log order
+func (c *ContractCaller) GetStakingEvents(stakingInfoAddress common.Address, fromBlock uint64, toBlock uint64) ([]StakingEvent, error) {
+	topics := make([]common.Hash, 0, len(StakingNonceEvents))
+	for _, name := range StakingNonceEvents {
+		topics = append(topics, c.StakingInfoABI.Events[name].Id())
+	}
+
+	logs, err := c.MainChainClient.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{stakingInfoAddress},
+		Topics:    [][]common.Hash{topics},
+	})
+	if err != nil {
+		Logger.Error("Error while filtering staking logs", "error", err, "fromBlock", fromBlock, "toBlock", toBlock)
+		return nil, err
+	}
+
+	events := make([]StakingEvent, 0, len(logs))
+	for i := range logs {
+		vLog := logs[i]
+		selectedEvent := EventByID(&c.StakingInfoABI, vLog.Topics[0].Bytes())
+		if selectedEvent == nil {
+			continue
+		}
+
+		var validatorID, nonce *big.Int
+		switch selectedEvent.Name {
+		case "Staked":
+			event := new(stakinginfo.StakinginfoStaked)
+			if err := UnpackLog(&c.StakingInfoABI, event, selectedEvent.Name, &vLog); err != nil {
+				return nil, err
+			}
+			validatorID, nonce = event.ValidatorId, event.Nonce
+		case "StakeUpdate":
+			event := new(stakinginfo.StakinginfoStakeUpdate)
+			if err := UnpackLog(&c.StakingInfoABI, event, selectedEvent.Name, &vLog); err != nil {
+				return nil, err
+			}
+			validatorID, nonce = event.ValidatorId, event.Nonce
+		case "SignerChange":
+			event := new(stakinginfo.StakinginfoSignerChange)
+			if err := UnpackLog(&c.StakingInfoABI, event, selectedEvent.Name, &vLog); err != nil {
+				return nil, err
+			}
+			validatorID, nonce = event.ValidatorId, event.Nonce
+		case "UnstakeInit":
+			event := new(stakinginfo.StakinginfoUnstakeInit)
+			if err := UnpackLog(&c.StakingInfoABI, event, selectedEvent.Name, &vLog); err != nil {
+				return nil, err
+			}
+			validatorID, nonce = event.ValidatorId, event.Nonce
+		default:
+			continue
+		}
+
+		events = append(events, StakingEvent{
+			Name:        selectedEvent.Name,
+			ValidatorID: validatorID.Uint64(),
+			Nonce:       nonce.Uint64(),
+			TxHash:      vLog.TxHash,
+			LogIndex:    uint64(vLog.Index),
+			BlockNumber: vLog.BlockNumber,
+		})
+	}
+
+	return events, nil
+}
+
 //
 // Account root related functions
 //
@@ -712,7 +929,7 @@ func (c *ContractCaller) CheckIfBlocksExist(end uint64) bool {
 	var block *ethTypes.Header
 
 	err := c.MaticChainRPC.Call(&block, "eth_getBlockByNumber", fmt.Sprintf("0x%x", end), false)
-	if err != nil {
+	if err != nil || block == nil {
 		return false
 	}
 
@@ -747,8 +964,7 @@ func getABI(data string) (abi.ABI, error) {
 
 // GetCheckpointSign returns sigs input of committed checkpoint tranasction
 func (c *ContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error) {
-	mainChainClient := GetMainClient()
-	transaction, isPending, err := mainChainClient.TransactionByHash(context.Background(), txHash)
+	transaction, isPending, err := c.MainChainClient.TransactionByHash(context.Background(), txHash)
 	if err != nil {
 		Logger.Error("Error while Fetching Transaction By hash from MainChain", "error", err)
 		return []byte{}, []byte{}, []byte{}, err
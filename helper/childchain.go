@@ -0,0 +1,83 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+var (
+	// childChainRPCClients stores RPC clients of child bor chains by bor chain id
+	childChainRPCClients map[string]*rpc.Client
+
+	childChainHeaderCaches     = make(map[string]*HeaderCache)
+	childChainHeaderCachesLock sync.Mutex
+)
+
+// dialChildChains dials the RPC endpoints of child bor chains given as comma separated <bor chain id>=<url> pairs
+func dialChildChains(urls string) (map[string]*rpc.Client, error) {
+	clients := make(map[string]*rpc.Client)
+	for _, pair := range strings.Split(urls, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid child chain RPC url %s, expected <bor chain id>=<url>", pair)
+		}
+
+		client, err := rpc.Dial(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		clients[parts[0]] = client
+	}
+
+	return clients, nil
+}
+
+// GetChildChainRPCClient returns the RPC client of a child bor chain
+func GetChildChainRPCClient(borChainID string) (*rpc.Client, error) {
+	client, ok := childChainRPCClients[borChainID]
+	if !ok {
+		return nil, fmt.Errorf("no RPC url configured for child chain %s", borChainID)
+	}
+
+	return client, nil
+}
+
+// getChildChainHeaderCache returns the header cache of a child bor chain, headers of different chains share block numbers
+func getChildChainHeaderCache(borChainID string) *HeaderCache {
+	childChainHeaderCachesLock.Lock()
+	defer childChainHeaderCachesLock.Unlock()
+
+	if _, ok := childChainHeaderCaches[borChainID]; !ok {
+		childChainHeaderCaches[borChainID] = NewHeaderCache()
+	}
+
+	return childChainHeaderCaches[borChainID]
+}
+
+// GetChildChainCaller returns a contract caller which reads bor data from a child bor chain
+// instead of the primary bor chain. Main chain calls are shared.
+func (c *ContractCaller) GetChildChainCaller(borChainID string) (IContractCaller, error) {
+	rpcClient, err := GetChildChainRPCClient(borChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	childChainCaller := *c
+	childChainCaller.MaticChainRPC = rpcClient
+	childChainCaller.MaticChainClient = ethclient.NewClient(rpcClient)
+	childChainCaller.HeaderCache = getChildChainHeaderCache(borChainID)
+	childChainCaller.ReceiptCache, _ = NewLru(1000)
+	childChainCaller.ContractInstanceCache = make(map[common.Address]interface{})
+
+	return &childChainCaller, nil
+}
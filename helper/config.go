@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	ethCrypto "github.com/maticnetwork/bor/crypto"
@@ -66,6 +67,10 @@ const (
 
 	DefaultMainchainMaxGasPrice = 400000000000 // 400 Gwei
 
+	DefaultCheckpointSizingPolicy   = "static"
+	DefaultCheckpointTargetInterval = 30 * time.Minute
+	DefaultCheckpointMaxBaseFee     = 100000000000 // 100 Gwei
+
 	DefaultBorChainID string = "15001"
 
 	secretFilePerm = 0600
@@ -98,6 +103,8 @@ type Configuration struct {
 	BorRPCUrl        string `mapstructure:"bor_rpc_url"`        // RPC endpoint for bor chain
 	TendermintRPCUrl string `mapstructure:"tendermint_rpc_url"` // tendemint node url
 
+	ChildChainRPCUrls string `mapstructure:"child_chain_rpc_urls"` // RPC endpoints for child bor chains, comma separated <bor chain id>=<url> pairs
+
 	AmqpURL           string `mapstructure:"amqp_url"`             // amqp url
 	HeimdallServerURL string `mapstructure:"heimdall_rest_server"` // heimdall server url
 
@@ -114,6 +121,17 @@ type Configuration struct {
 
 	// wait time related options
 	NoACKWaitTime time.Duration `mapstructure:"no_ack_wait_time"` // Time ack service waits to clear buffer and elect new proposer
+
+	// checkpoint sizing related options
+	CheckpointSizingPolicy   string        `mapstructure:"checkpoint_sizing_policy"`   // Policy to pick checkpoint ranges: static, interval or gas
+	CheckpointTargetInterval time.Duration `mapstructure:"checkpoint_target_interval"` // Target time between checkpoints for interval policy
+	CheckpointMaxBaseFee     int64         `mapstructure:"checkpoint_max_base_fee"`    // Main chain base fee above which gas policy waits for larger checkpoints
+
+	// metrics related options
+	BridgePrometheusListenAddr string `mapstructure:"bridge_prometheus_listen_addr"` // Address of bridge prometheus metrics server, empty disables it
+
+	// invariant related options
+	InvariantCheckPeriod uint64 `mapstructure:"invariant_check_period"` // Number of blocks between invariant checks, 0 disables them
 }
 
 var conf Configuration
@@ -133,6 +151,9 @@ var privObject secp256k1.PrivKeySecp256k1
 
 var pubObject secp256k1.PubKeySecp256k1
 
+// keyMtx guards privObject and pubObject, consensus key rotation swaps them at runtime
+var keyMtx sync.RWMutex
+
 // Logger stores global logger object
 var Logger logger.Logger
 
@@ -198,6 +219,11 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	}
 
 	maticClient = ethclient.NewClient(maticRPCClient)
+
+	if childChainRPCClients, err = dialChildChains(conf.ChildChainRPCUrls); err != nil {
+		log.Fatal(err)
+	}
+
 	// Loading genesis doc
 	genDoc, err := tmTypes.GenesisDocFromFile(filepath.Join(configDir, "genesis.json"))
 	if err != nil {
@@ -211,8 +237,9 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 		Logger.Error(err.Error())
 	}
 	privVal := privval.LoadFilePV(filepath.Join(configDir, "priv_validator_key.json"), filepath.Join(configDir, "priv_validator_key.json"))
-	cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privObject)
-	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
+	var privKey secp256k1.PrivKeySecp256k1
+	cdc.MustUnmarshalBinaryBare(privVal.Key.PrivKey.Bytes(), &privKey)
+	setPrivPubKey(privKey)
 }
 
 // GetDefaultHeimdallConfig returns configration with default params
@@ -236,6 +263,10 @@ func GetDefaultHeimdallConfig() Configuration {
 		SpanPollInterval:         DefaultSpanPollInterval,
 
 		NoACKWaitTime: NoACKWaitTime,
+
+		CheckpointSizingPolicy:   DefaultCheckpointSizingPolicy,
+		CheckpointTargetInterval: DefaultCheckpointTargetInterval,
+		CheckpointMaxBaseFee:     DefaultCheckpointMaxBaseFee,
 	}
 }
 
@@ -254,6 +285,21 @@ func SetTestConfig(_conf Configuration) {
 	conf = _conf
 }
 
+// TEST PURPOSE ONLY
+// SetTestPrivPubKey sets test priv and pub key
+func SetTestPrivPubKey(privKey secp256k1.PrivKeySecp256k1) {
+	setPrivPubKey(privKey)
+}
+
+// setPrivPubKey replaces process wide priv and pub key objects
+func setPrivPubKey(privKey secp256k1.PrivKeySecp256k1) {
+	keyMtx.Lock()
+	defer keyMtx.Unlock()
+
+	privObject = privKey
+	pubObject = privKey.PubKey().(secp256k1.PubKeySecp256k1)
+}
+
 //
 // Get main/matic clients
 //
@@ -285,6 +331,9 @@ func GetMaticEthClient() *eth.EthAPIBackend {
 
 // GetPrivKey returns priv key object
 func GetPrivKey() secp256k1.PrivKeySecp256k1 {
+	keyMtx.RLock()
+	defer keyMtx.RUnlock()
+
 	return privObject
 }
 
@@ -300,6 +349,9 @@ func GetECDSAPrivKey() *ecdsa.PrivateKey {
 
 // GetPubKey returns pub key object
 func GetPubKey() secp256k1.PubKeySecp256k1 {
+	keyMtx.RLock()
+	defer keyMtx.RUnlock()
+
 	return pubObject
 }
 
@@ -0,0 +1,463 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// KeyRotationFileName is the rotation record stored next to priv_validator_key.json
+const KeyRotationFileName = "key_rotation.json"
+
+// validator updates returned from EndBlock at height H are applied by tendermint at H+2
+const validatorUpdateDelay = 2
+
+// KeyRotation tracks a consensus key rotation from staging to activation
+type KeyRotation struct {
+	ValidatorID      uint64 `json:"validator_id"`
+	OldSigner        string `json:"old_signer"`
+	NewSigner        string `json:"new_signer"`
+	PreparedHeight   int64  `json:"prepared_height"`  // latest block height when new key was staged
+	ScheduledHeight  int64  `json:"scheduled_height"` // height of end block which gave new key voting power
+	ActivationHeight int64  `json:"activation_height"`
+	Completed        bool   `json:"completed"`
+}
+
+// IsPending returns true if the rotation has not switched keys yet
+func (r *KeyRotation) IsPending() bool {
+	return r != nil && !r.Completed
+}
+
+// Save writes rotation record to the given path
+func (r *KeyRotation) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temp file first so a crash never leaves a half written record
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// LoadKeyRotation loads rotation record from path, returns nil if there is none
+func LoadKeyRotation(path string) (*KeyRotation, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var rotation KeyRotation
+	if err := json.Unmarshal(data, &rotation); err != nil {
+		return nil, err
+	}
+
+	return &rotation, nil
+}
+
+// KeyRotationFile returns rotation record path for priv validator key file
+func KeyRotationFile(keyFile string) string {
+	return filepath.Join(filepath.Dir(keyFile), KeyRotationFileName)
+}
+
+// NextKeyFile returns path of the staged file for priv validator key/state file
+func NextKeyFile(file string) string {
+	return strings.TrimSuffix(file, filepath.Ext(file)) + ".next" + filepath.Ext(file)
+}
+
+// RetiredKeyFile returns path the priv validator key/state file is moved to after rotation
+func RetiredKeyFile(file string, activationHeight int64) string {
+	return fmt.Sprintf("%s.retired-%d%s", strings.TrimSuffix(file, filepath.Ext(file)), activationHeight, filepath.Ext(file))
+}
+
+// PrepareKeyRotation generates the next consensus key and records a pending rotation.
+// Validator updates of blocks below preparedHeight are never taken for the new key.
+func PrepareKeyRotation(keyFile string, stateFile string, validatorID uint64, preparedHeight int64) (*KeyRotation, *privval.FilePV, error) {
+	rotationFile := KeyRotationFile(keyFile)
+	rotation, err := LoadKeyRotation(rotationFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if rotation.IsPending() {
+		return nil, nil, fmt.Errorf("Key rotation to %v is already pending", rotation.NewSigner)
+	}
+
+	nextKeyFile := NextKeyFile(keyFile)
+	if _, err := os.Stat(nextKeyFile); err == nil {
+		return nil, nil, fmt.Errorf("Staged key %v already exists", nextKeyFile)
+	}
+
+	current := privval.LoadFilePVEmptyState(keyFile, stateFile)
+	next := privval.GenFilePV(nextKeyFile, NextKeyFile(stateFile))
+	if next.GetPubKey().Equals(current.GetPubKey()) {
+		return nil, nil, errors.New("Next key is same as current key")
+	}
+
+	next.Save()
+
+	rotation = &KeyRotation{
+		ValidatorID:    validatorID,
+		OldSigner:      current.GetAddress().String(),
+		NewSigner:      next.GetAddress().String(),
+		PreparedHeight: preparedHeight,
+	}
+	if err := rotation.Save(rotationFile); err != nil {
+		return nil, nil, err
+	}
+
+	return rotation, next, nil
+}
+
+// CancelKeyRotation removes staged key and record of a rotation which is not scheduled yet
+func CancelKeyRotation(keyFile string, stateFile string) error {
+	rotationFile := KeyRotationFile(keyFile)
+	rotation, err := LoadKeyRotation(rotationFile)
+	if err != nil {
+		return err
+	}
+
+	if !rotation.IsPending() {
+		return errors.New("No pending key rotation")
+	}
+
+	if rotation.ActivationHeight != 0 {
+		return fmt.Errorf("Key rotation is already scheduled at height %d", rotation.ActivationHeight)
+	}
+
+	for _, file := range []string{NextKeyFile(keyFile), NextKeyFile(stateFile), rotationFile} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// Rotating priv validator
+//
+
+// RotatingPV is a priv validator which hands signing over from the current key
+// to a staged key at the height the staged key becomes part of the validator set.
+// Heights below activation are only ever signed by the old key and heights
+// at or above it only by the new one.
+type RotatingPV struct {
+	mtx sync.Mutex
+
+	keyFile      string
+	stateFile    string
+	rotationFile string
+
+	active   *privval.FilePV
+	next     *privval.FilePV
+	rotation *KeyRotation
+
+	// height of last end block seen, consensus signs height after it
+	height int64
+
+	rotationModTime time.Time
+	logger          log.Logger
+}
+
+var _ tmTypes.PrivValidator = (*RotatingPV)(nil)
+
+// NewRotatingPV loads priv validator with rotation record (if any)
+func NewRotatingPV(keyFile string, stateFile string, logger log.Logger) (*RotatingPV, error) {
+	pv := &RotatingPV{
+		keyFile:      keyFile,
+		stateFile:    stateFile,
+		rotationFile: KeyRotationFile(keyFile),
+		logger:       logger,
+	}
+
+	// a crash during switch leaves only the staged key behind
+	if _, err := os.Stat(keyFile); os.IsNotExist(err) {
+		if _, err := os.Stat(NextKeyFile(keyFile)); err == nil {
+			rotation, err := LoadKeyRotation(pv.rotationFile)
+			if err != nil {
+				return nil, err
+			}
+			if !rotation.IsPending() {
+				return nil, fmt.Errorf("Found staged key %v without pending rotation", NextKeyFile(keyFile))
+			}
+			pv.rotation = rotation
+			if err := pv.switchKeys(); err != nil {
+				return nil, err
+			}
+			return pv, nil
+		}
+	}
+
+	pv.active = privval.LoadOrGenFilePV(keyFile, stateFile)
+	if err := pv.refreshRotation(); err != nil {
+		return nil, err
+	}
+
+	return pv, nil
+}
+
+// GetPubKey returns pubkey of the active key
+func (pv *RotatingPV) GetPubKey() crypto.PubKey {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	return pv.active.GetPubKey()
+}
+
+// SignVote signs vote with the key owning vote height
+func (pv *RotatingPV) SignVote(chainID string, vote *tmTypes.Vote) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	signer, err := pv.signerFor(vote.Height)
+	if err != nil {
+		return err
+	}
+
+	return signer.SignVote(chainID, vote)
+}
+
+// SignProposal signs proposal with the key owning proposal height
+func (pv *RotatingPV) SignProposal(chainID string, proposal *tmTypes.Proposal) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	signer, err := pv.signerFor(proposal.Height)
+	if err != nil {
+		return err
+	}
+
+	return signer.SignProposal(chainID, proposal)
+}
+
+// SignSideTxResult signs side tx result with the key owning height of the vote it is part of
+func (pv *RotatingPV) SignSideTxResult(sideTxResult *tmTypes.SideTxResultWithData) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	// no end block seen since start, vote height is not known yet
+	if pv.height == 0 {
+		return pv.active.SignSideTxResult(sideTxResult)
+	}
+
+	signer, err := pv.signerFor(pv.height + 1)
+	if err != nil {
+		return err
+	}
+
+	return signer.SignSideTxResult(sideTxResult)
+}
+
+// Rotation returns copy of current rotation record
+func (pv *RotatingPV) Rotation() *KeyRotation {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if pv.rotation == nil {
+		return nil
+	}
+
+	rotation := *pv.rotation
+	return &rotation
+}
+
+// OnValidatorUpdates receives validator updates returned from EndBlock at height.
+// It fixes activation height once the staged key gets voting power and switches
+// keys right before consensus moves to the activation height. Blocks replayed from
+// below the height the key was staged or the rotation was scheduled are ignored.
+func (pv *RotatingPV) OnValidatorUpdates(height int64, updates []abci.ValidatorUpdate) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	pv.height = height
+
+	if err := pv.refreshRotation(); err != nil {
+		pv.logger.Error("Error while loading key rotation", "error", err)
+		return
+	}
+
+	if !pv.rotation.IsPending() || height < pv.rotation.PreparedHeight {
+		return
+	}
+
+	if pv.rotation.ActivationHeight == 0 {
+		nextPubKey := tmTypes.TM2PB.PubKey(pv.next.GetPubKey())
+		for _, update := range updates {
+			if update.Power > 0 && update.PubKey.Type == nextPubKey.Type && bytes.Equal(update.PubKey.Data, nextPubKey.Data) {
+				pv.rotation.ScheduledHeight = height
+				pv.rotation.ActivationHeight = height + validatorUpdateDelay
+				if err := pv.saveRotation(); err != nil {
+					pv.logger.Error("Error while saving key rotation", "error", err)
+				}
+
+				pv.logger.Info("Consensus key rotation scheduled", "newSigner", pv.rotation.NewSigner, "activationHeight", pv.rotation.ActivationHeight)
+				break
+			}
+		}
+	}
+
+	// next height is the activation height, hand over before consensus starts it
+	if pv.rotation.ActivationHeight > 0 && height >= pv.rotation.ScheduledHeight && height+1 >= pv.rotation.ActivationHeight {
+		if err := pv.switchKeys(); err != nil {
+			pv.logger.Error("Error while switching consensus key", "error", err)
+		}
+	}
+}
+
+// signerFor returns key allowed to sign at height
+func (pv *RotatingPV) signerFor(height int64) (*privval.FilePV, error) {
+	if pv.rotation == nil || pv.rotation.ActivationHeight == 0 {
+		return pv.active, nil
+	}
+
+	if pv.rotation.Completed {
+		if height < pv.rotation.ActivationHeight {
+			return nil, fmt.Errorf("Refusing to sign height %d below key activation height %d", height, pv.rotation.ActivationHeight)
+		}
+
+		return pv.active, nil
+	}
+
+	// old key must never sign at or above activation height, keys are switched
+	// from end block of the height before it only
+	if height >= pv.rotation.ActivationHeight {
+		return nil, fmt.Errorf("Refusing to sign height %d with old key, switch to %v at height %d is pending", height, pv.rotation.NewSigner, pv.rotation.ActivationHeight)
+	}
+
+	return pv.active, nil
+}
+
+// refreshRotation loads rotation record if it changed on disk
+func (pv *RotatingPV) refreshRotation() error {
+	info, err := os.Stat(pv.rotationFile)
+	if os.IsNotExist(err) {
+		// rotation was cancelled before it got scheduled
+		if pv.rotation.IsPending() && pv.rotation.ActivationHeight == 0 {
+			pv.rotation = nil
+			pv.next = nil
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if info.ModTime().Equal(pv.rotationModTime) {
+		return nil
+	}
+	pv.rotationModTime = info.ModTime()
+
+	rotation, err := LoadKeyRotation(pv.rotationFile)
+	if err != nil {
+		return err
+	}
+
+	if !rotation.IsPending() {
+		pv.rotation = rotation
+		pv.next = nil
+		return nil
+	}
+
+	// key was moved in place but record was not updated
+	if pv.active.GetAddress().String() == rotation.NewSigner {
+		pv.rotation = rotation
+		pv.next = nil
+		rotation.Completed = true
+		return pv.saveRotation()
+	}
+
+	if pv.active.GetAddress().String() != rotation.OldSigner {
+		return fmt.Errorf("Key rotation expects current signer %v, found %v", rotation.OldSigner, pv.active.GetAddress().String())
+	}
+
+	nextKeyFile := NextKeyFile(pv.keyFile)
+	if _, err := os.Stat(nextKeyFile); err != nil {
+		return fmt.Errorf("Staged key for pending rotation not found: %v", err)
+	}
+
+	next := privval.LoadFilePV(nextKeyFile, NextKeyFile(pv.stateFile))
+	if next.GetAddress().String() != rotation.NewSigner {
+		return fmt.Errorf("Staged key %v does not match rotation signer %v", next.GetAddress().String(), rotation.NewSigner)
+	}
+
+	pv.rotation = rotation
+	pv.next = next
+
+	pv.logger.Info("Loaded pending consensus key rotation", "oldSigner", rotation.OldSigner, "newSigner", rotation.NewSigner)
+	return nil
+}
+
+// switchKeys retires current key and moves staged key in place
+func (pv *RotatingPV) switchKeys() error {
+	nextKeyFile := NextKeyFile(pv.keyFile)
+	nextStateFile := NextKeyFile(pv.stateFile)
+	activationHeight := pv.rotation.ActivationHeight
+
+	if _, err := os.Stat(nextKeyFile); err == nil {
+		moves := [][2]string{
+			{pv.keyFile, RetiredKeyFile(pv.keyFile, activationHeight)},
+			{pv.stateFile, RetiredKeyFile(pv.stateFile, activationHeight)},
+			{nextKeyFile, pv.keyFile},
+			{nextStateFile, pv.stateFile},
+		}
+		for _, move := range moves {
+			if _, err := os.Stat(move[0]); os.IsNotExist(err) {
+				continue
+			}
+			if err := os.Rename(move[0], move[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	pv.active = privval.LoadFilePV(pv.keyFile, pv.stateFile)
+	pv.next = nil
+	if pv.active.GetAddress().String() != pv.rotation.NewSigner {
+		return fmt.Errorf("Rotated key %v does not match rotation signer %v", pv.active.GetAddress().String(), pv.rotation.NewSigner)
+	}
+
+	pv.rotation.Completed = true
+	if err := pv.saveRotation(); err != nil {
+		return err
+	}
+
+	// keep process wide signer in sync with consensus key
+	if privKey, ok := pv.active.Key.PrivKey.(secp256k1.PrivKeySecp256k1); ok {
+		setPrivPubKey(privKey)
+	}
+
+	pv.logger.Info("Switched consensus key", "signer", pv.rotation.NewSigner, "activationHeight", activationHeight)
+	return nil
+}
+
+func (pv *RotatingPV) saveRotation() error {
+	if err := pv.rotation.Save(pv.rotationFile); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(pv.rotationFile); err == nil {
+		pv.rotationModTime = info.ModTime()
+	}
+
+	return nil
+}
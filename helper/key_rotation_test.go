@@ -0,0 +1,240 @@
+package helper
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "heimdall-test"
+
+func signTestVote(t *testing.T, pv tmTypes.PrivValidator, height int64) (*tmTypes.Vote, error) {
+	t.Helper()
+
+	vote := &tmTypes.Vote{
+		Type:             tmTypes.PrecommitType,
+		Height:           height,
+		Timestamp:        time.Now().UTC(),
+		ValidatorAddress: pv.GetPubKey().Address(),
+	}
+
+	return vote, pv.SignVote(testChainID, vote)
+}
+
+func requireSignedBy(t *testing.T, vote *tmTypes.Vote, pubKey crypto.PubKey) {
+	t.Helper()
+	require.True(t, pubKey.VerifyBytes(vote.SignBytes(testChainID), vote.Signature), "Vote should be signed by %v", pubKey.Address())
+}
+
+func TestRotatingPV(t *testing.T) {
+	home, err := ioutil.TempDir("", "heimdall-key-rotation")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0700))
+
+	keyFile := filepath.Join(home, "config", "priv_validator_key.json")
+	stateFile := filepath.Join(home, "data", "priv_validator_state.json")
+
+	current := privval.GenFilePV(keyFile, stateFile)
+	current.Save()
+	oldPubKey := current.GetPubKey()
+
+	rotation, next, err := PrepareKeyRotation(keyFile, stateFile, 1, 0)
+	require.NoError(t, err)
+	require.True(t, rotation.IsPending())
+	newPubKey := next.GetPubKey()
+
+	// only one rotation at a time
+	_, _, err = PrepareKeyRotation(keyFile, stateFile, 1, 0)
+	require.Error(t, err)
+
+	pv, err := NewRotatingPV(keyFile, stateFile, log.NewNopLogger())
+	require.NoError(t, err)
+	require.True(t, pv.GetPubKey().Equals(oldPubKey))
+
+	// old signer leaves and new signer gets power at height 10
+	pv.OnValidatorUpdates(10, []abci.ValidatorUpdate{
+		{PubKey: tmTypes.TM2PB.PubKey(oldPubKey), Power: 0},
+		{PubKey: tmTypes.TM2PB.PubKey(newPubKey), Power: 10},
+	})
+	require.Equal(t, int64(12), pv.Rotation().ActivationHeight)
+	require.True(t, pv.GetPubKey().Equals(oldPubKey))
+
+	vote, err := signTestVote(t, pv, 11)
+	require.NoError(t, err)
+	requireSignedBy(t, vote, oldPubKey)
+
+	// process wide signer is read concurrently while keys are switched
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = GetPrivKey()
+			_ = GetPubKey()
+		}
+	}()
+
+	// end block of height 11 switches keys before consensus moves to 12
+	pv.OnValidatorUpdates(11, nil)
+	<-done
+	require.True(t, pv.Rotation().Completed)
+	require.True(t, pv.GetPubKey().Equals(newPubKey))
+	require.True(t, GetPubKey().Equals(newPubKey))
+
+	_, err = signTestVote(t, pv, 11)
+	require.Error(t, err, "New key must not sign below activation height")
+
+	vote, err = signTestVote(t, pv, 12)
+	require.NoError(t, err)
+	requireSignedBy(t, vote, newPubKey)
+
+	// key files are moved, old key is kept aside
+	require.True(t, privval.LoadFilePVEmptyState(keyFile, stateFile).GetPubKey().Equals(newPubKey))
+	require.True(t, privval.LoadFilePVEmptyState(RetiredKeyFile(keyFile, 12), stateFile).GetPubKey().Equals(oldPubKey))
+	_, err = os.Stat(NextKeyFile(keyFile))
+	require.True(t, os.IsNotExist(err))
+
+	// restarted node keeps refusing heights below activation
+	pv, err = NewRotatingPV(keyFile, stateFile, log.NewNopLogger())
+	require.NoError(t, err)
+	require.True(t, pv.GetPubKey().Equals(newPubKey))
+	_, err = signTestVote(t, pv, 11)
+	require.Error(t, err)
+}
+
+func TestRotatingPVSignWithoutSwitch(t *testing.T) {
+	home, err := ioutil.TempDir("", "heimdall-key-rotation")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	keyFile := filepath.Join(home, "priv_validator_key.json")
+	stateFile := filepath.Join(home, "priv_validator_state.json")
+
+	current := privval.GenFilePV(keyFile, stateFile)
+	current.Save()
+
+	pv, err := NewRotatingPV(keyFile, stateFile, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// rotation prepared while node is running
+	_, next, err := PrepareKeyRotation(keyFile, stateFile, 1, 0)
+	require.NoError(t, err)
+
+	pv.OnValidatorUpdates(20, []abci.ValidatorUpdate{
+		{PubKey: tmTypes.TM2PB.PubKey(next.GetPubKey()), Power: 5},
+	})
+	require.Equal(t, int64(22), pv.Rotation().ActivationHeight)
+
+	// old key never signs activation height and signing does not switch keys
+	_, err = signTestVote(t, pv, 22)
+	require.Error(t, err)
+	require.False(t, pv.Rotation().Completed)
+	require.True(t, pv.GetPubKey().Equals(current.GetPubKey()))
+	_, err = os.Stat(NextKeyFile(keyFile))
+	require.NoError(t, err, "Staged key should stay in place until end block switches keys")
+
+	// side tx results are signed by the key owning height of the vote
+	sideTxResult := &tmTypes.SideTxResultWithData{Data: []byte("data")}
+	require.NoError(t, pv.SignSideTxResult(sideTxResult))
+	require.True(t, current.GetPubKey().VerifyBytes(sideTxResult.GetBytes(), sideTxResult.Sig))
+
+	pv.OnValidatorUpdates(21, nil)
+	require.True(t, pv.Rotation().Completed)
+
+	require.NoError(t, pv.SignSideTxResult(sideTxResult))
+	require.True(t, next.GetPubKey().VerifyBytes(sideTxResult.GetBytes(), sideTxResult.Sig))
+
+	vote, err := signTestVote(t, pv, 22)
+	require.NoError(t, err)
+	requireSignedBy(t, vote, next.GetPubKey())
+}
+
+func TestRotatingPVReplay(t *testing.T) {
+	home, err := ioutil.TempDir("", "heimdall-key-rotation")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	keyFile := filepath.Join(home, "priv_validator_key.json")
+	stateFile := filepath.Join(home, "priv_validator_state.json")
+
+	current := privval.GenFilePV(keyFile, stateFile)
+	current.Save()
+
+	// key staged at height 100
+	_, next, err := PrepareKeyRotation(keyFile, stateFile, 1, 100)
+	require.NoError(t, err)
+	newKeyUpdate := []abci.ValidatorUpdate{
+		{PubKey: tmTypes.TM2PB.PubKey(next.GetPubKey()), Power: 5},
+	}
+
+	pv, err := NewRotatingPV(keyFile, stateFile, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// replayed blocks from before the key was staged are ignored
+	pv.OnValidatorUpdates(50, newKeyUpdate)
+	require.Zero(t, pv.Rotation().ActivationHeight)
+
+	pv.OnValidatorUpdates(120, newKeyUpdate)
+	require.Equal(t, int64(120), pv.Rotation().ScheduledHeight)
+	require.Equal(t, int64(122), pv.Rotation().ActivationHeight)
+
+	// node restarts and replays blocks (e.g. after a rollback)
+	pv, err = NewRotatingPV(keyFile, stateFile, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, int64(122), pv.Rotation().ActivationHeight)
+
+	// blocks below scheduled height neither switch nor reschedule
+	pv.OnValidatorUpdates(110, newKeyUpdate)
+	pv.OnValidatorUpdates(119, nil)
+	require.False(t, pv.Rotation().Completed)
+	require.Equal(t, int64(122), pv.Rotation().ActivationHeight)
+	require.True(t, pv.GetPubKey().Equals(current.GetPubKey()))
+
+	// replaying the scheduling block keeps activation height
+	pv.OnValidatorUpdates(120, newKeyUpdate)
+	require.False(t, pv.Rotation().Completed)
+	require.Equal(t, int64(122), pv.Rotation().ActivationHeight)
+
+	pv.OnValidatorUpdates(121, nil)
+	require.True(t, pv.Rotation().Completed)
+	require.True(t, pv.GetPubKey().Equals(next.GetPubKey()))
+
+	// replays after the switch change nothing
+	pv.OnValidatorUpdates(120, newKeyUpdate)
+	require.True(t, pv.Rotation().Completed)
+	require.Equal(t, int64(122), pv.Rotation().ActivationHeight)
+	require.True(t, pv.GetPubKey().Equals(next.GetPubKey()))
+}
+
+func TestCancelKeyRotation(t *testing.T) {
+	home, err := ioutil.TempDir("", "heimdall-key-rotation")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	keyFile := filepath.Join(home, "priv_validator_key.json")
+	stateFile := filepath.Join(home, "priv_validator_state.json")
+	privval.GenFilePV(keyFile, stateFile).Save()
+
+	require.Error(t, CancelKeyRotation(keyFile, stateFile))
+
+	_, _, err = PrepareKeyRotation(keyFile, stateFile, 1, 0)
+	require.NoError(t, err)
+	require.NoError(t, CancelKeyRotation(keyFile, stateFile))
+
+	rotation, err := LoadKeyRotation(KeyRotationFile(keyFile))
+	require.NoError(t, err)
+	require.Nil(t, rotation)
+	_, err = os.Stat(NextKeyFile(keyFile))
+	require.True(t, os.IsNotExist(err))
+}
@@ -10,6 +10,8 @@ import (
 
 	heimdalltypes "github.com/maticnetwork/heimdall/types"
 
+	helper "github.com/maticnetwork/heimdall/helper"
+
 	mock "github.com/stretchr/testify/mock"
 
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
@@ -138,6 +140,29 @@ func (_m *IContractCaller) CurrentStateCounter(stateSenderInstance *statesender.
 	return r0
 }
 
+// DecodeDelClaimRewardsEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeDelClaimRewardsEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoDelClaimRewards, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoDelClaimRewards
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoDelClaimRewards); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoDelClaimRewards)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -161,6 +186,52 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 common.Address, _a1 *ty
 	return r0, r1
 }
 
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1, _a2
 func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -368,6 +439,29 @@ func (_m *IContractCaller) GetBalance(address common.Address) (*big.Int, error)
 	return r0, r1
 }
 
+// GetBlockHeaders provides a mock function with given fields: start, end, checkpointLength
+func (_m *IContractCaller) GetBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end, checkpointLength)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end, checkpointLength)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64, uint64) error); ok {
+		r1 = rf(start, end, checkpointLength)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockNumberFromTxHash provides a mock function with given fields: _a0
 func (_m *IContractCaller) GetBlockNumberFromTxHash(_a0 common.Hash) (*big.Int, error) {
 	ret := _m.Called(_a0)
@@ -432,6 +526,29 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
+// GetChildChainCaller provides a mock function with given fields: borChainID
+func (_m *IContractCaller) GetChildChainCaller(borChainID string) (helper.IContractCaller, error) {
+	ret := _m.Called(borChainID)
+
+	var r0 helper.IContractCaller
+	if rf, ok := ret.Get(0).(func(string) helper.IContractCaller); ok {
+		r0 = rf(borChainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helper.IContractCaller)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(borChainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*types.Receipt, error) {
 	ret := _m.Called(_a0, _a1)
@@ -529,6 +646,29 @@ func (_m *IContractCaller) GetLastChildBlock(rootChainInstance *rootchain.Rootch
 	return r0, r1
 }
 
+// GetMainChainBaseFee provides a mock function with given fields:
+func (_m *IContractCaller) GetMainChainBaseFee() (*big.Int, error) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMainChainBlock provides a mock function with given fields: _a0
 func (_m *IContractCaller) GetMainChainBlock(_a0 *big.Int) (*types.Header, error) {
 	ret := _m.Called(_a0)
@@ -777,6 +917,29 @@ func (_m *IContractCaller) GetStakeManagerInstance(stakingManagerAddress common.
 	return r0, r1
 }
 
+// GetStakingEvents provides a mock function with given fields: stakingInfoAddress, fromBlock, toBlock
+func (_m *IContractCaller) GetStakingEvents(stakingInfoAddress common.Address, fromBlock uint64, toBlock uint64) ([]helper.StakingEvent, error) {
+	ret := _m.Called(stakingInfoAddress, fromBlock, toBlock)
+
+	var r0 []helper.StakingEvent
+	if rf, ok := ret.Get(0).(func(common.Address, uint64, uint64) []helper.StakingEvent); ok {
+		r0 = rf(stakingInfoAddress, fromBlock, toBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]helper.StakingEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, uint64, uint64) error); ok {
+		r1 = rf(stakingInfoAddress, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStakingInfoInstance provides a mock function with given fields: stakingInfoAddress
 func (_m *IContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Address) (*stakinginfo.Stakinginfo, error) {
 	ret := _m.Called(stakingInfoAddress)
@@ -867,6 +1030,27 @@ func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID, sta
 	return r0, r1
 }
 
+// GetValidatorNonce provides a mock function with given fields: valID, stakingInfoInstance
+func (_m *IContractCaller) GetValidatorNonce(valID heimdalltypes.ValidatorID, stakingInfoInstance *stakinginfo.Stakinginfo) (uint64, error) {
+	ret := _m.Called(valID, stakingInfoInstance)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) uint64); ok {
+		r0 = rf(valID, stakingInfoInstance)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(heimdalltypes.ValidatorID, *stakinginfo.Stakinginfo) error); ok {
+		r1 = rf(valID, stakingInfoInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetValidatorSetInstance provides a mock function with given fields: validatorSetAddress
 func (_m *IContractCaller) GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error) {
 	ret := _m.Called(validatorSetAddress)
@@ -945,3 +1129,17 @@ func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.I
 
 	return r0
 }
+
+// UpdateSigner provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IContractCaller) UpdateSigner(_a0 *big.Int, _a1 []byte, _a2 common.Address, _a3 *stakemanager.Stakemanager) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, []byte, common.Address, *stakemanager.Stakemanager) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
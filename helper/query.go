@@ -258,13 +258,26 @@ func GetBlockWithClient(client *httpClient.HTTP, height int64) (*tmTypes.Block,
 
 // GetBeginBlockEvents get block through per height
 func GetBeginBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, error) {
+	return getBlockEvents(client, height, true)
+}
+
+// GetEndBlockEvents get end block events of block at height, e.g. events of executed gov proposals
+func GetEndBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, error) {
+	return getBlockEvents(client, height, false)
+}
+
+// getBlockEvents returns begin or end block events of block at height
+func getBlockEvents(client *httpClient.HTTP, height int64, beginBlock bool) ([]abci.Event, error) {
 	c, cancel := context.WithTimeout(context.Background(), CommitTimeout)
 	defer cancel()
 
 	// get block using client
 	blockResults, err := client.BlockResults(&height)
 	if err == nil && blockResults != nil {
-		return blockResults.Results.BeginBlock.GetEvents(), nil
+		if beginBlock {
+			return blockResults.Results.BeginBlock.GetEvents(), nil
+		}
+		return blockResults.Results.EndBlock.GetEvents(), nil
 	}
 
 	// subscriber
@@ -289,7 +302,10 @@ func GetBeginBlockEvents(client *httpClient.HTTP, height int64) ([]abci.Event, e
 			switch t := eventData.(type) {
 			case tmTypes.EventDataNewBlock:
 				if t.Block.Height == height {
-					return t.ResultBeginBlock.GetEvents(), nil
+					if beginBlock {
+						return t.ResultBeginBlock.GetEvents(), nil
+					}
+					return t.ResultEndBlock.GetEvents(), nil
 				}
 			default:
 				return nil, errors.New("timed out waiting for event")
@@ -0,0 +1,278 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// headerCacheSize is the number of bor headers kept in the header cache
+	headerCacheSize = 8192
+	// subtreeCacheSize is the number of merkle subtree hashes kept in the header cache
+	subtreeCacheSize = 8192
+	// headerBatchSize is the number of headers fetched in a single batch call
+	headerBatchSize = 128
+	// parallelSubtreeLevel is the lowest subtree level whose children are hashed concurrently
+	parallelSubtreeLevel = 6
+)
+
+var (
+	sharedHeaderCache     *HeaderCache
+	sharedHeaderCacheOnce sync.Once
+
+	// zeroHashes[level] is the root of a subtree of the given level with empty leaves only
+	zeroHashes [65][32]byte
+)
+
+func init() {
+	for level := 1; level < len(zeroHashes); level++ {
+		copy(zeroHashes[level][:], crypto.Keccak256(zeroHashes[level-1][:], zeroHashes[level-1][:]))
+	}
+}
+
+// cachedHeader is a bor header reduced to its chain link and merkle leaf
+type cachedHeader struct {
+	Hash       common.Hash
+	ParentHash common.Hash
+	Leaf       [32]byte
+}
+
+// subtreeKey identifies a merkle subtree without padding by its level and the hash of its last block.
+// The hash of the last block commits to all blocks below it, so the key is reorg safe.
+type subtreeKey struct {
+	Level     uint
+	LastBlock common.Hash
+}
+
+// HeaderCache caches bor headers by block number and merkle subtree hashes by level and block hash,
+// so that computing the root hash of a checkpoint only fetches and hashes headers not seen before.
+// It is safe for concurrent use.
+type HeaderCache struct {
+	headers  *lru.Cache
+	subtrees *lru.Cache
+}
+
+// NewHeaderCache creates a new header cache
+func NewHeaderCache() *HeaderCache {
+	headers, _ := lru.New(headerCacheSize)
+	subtrees, _ := lru.New(subtreeCacheSize)
+
+	return &HeaderCache{
+		headers:  headers,
+		subtrees: subtrees,
+	}
+}
+
+// GetSharedHeaderCache returns the header cache shared by all contract callers of the process,
+// which lets checkpoint validation reuse the headers fetched by the bridge while proposing
+func GetSharedHeaderCache() *HeaderCache {
+	sharedHeaderCacheOnce.Do(func() {
+		sharedHeaderCache = NewHeaderCache()
+	})
+
+	return sharedHeaderCache
+}
+
+// GetHeaderLeaf returns the merkle leaf of a bor header, hashed the same way bor computes the checkpoint root hash
+func GetHeaderLeaf(header *ethTypes.Header) []byte {
+	return crypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// Add caches a header
+func (hc *HeaderCache) Add(header *ethTypes.Header) {
+	entry := cachedHeader{
+		Hash:       header.Hash(),
+		ParentHash: header.ParentHash,
+	}
+	copy(entry.Leaf[:], GetHeaderLeaf(header))
+
+	hc.headers.Add(header.Number.Uint64(), entry)
+}
+
+// Invalidate removes the cached headers from start to end
+func (hc *HeaderCache) Invalidate(start uint64, end uint64) {
+	for number := start; number <= end; number++ {
+		hc.headers.Remove(number)
+	}
+}
+
+func (hc *HeaderCache) get(number uint64) (cachedHeader, bool) {
+	if entry, ok := hc.headers.Get(number); ok {
+		return entry.(cachedHeader), true
+	}
+
+	return cachedHeader{}, false
+}
+
+// GetRootHash returns the merkle root of the headers from start to end. Cached headers are
+// reused as long as they link to the headers fetched, the header at end is always fetched
+// so that reorgs of cached headers are detected.
+func (hc *HeaderCache) GetRootHash(start uint64, end uint64, fetch func(start uint64, end uint64) ([]*ethTypes.Header, error)) ([]byte, error) {
+	if start > end {
+		return nil, fmt.Errorf("start %v is greater than end %v", start, end)
+	}
+
+	// collect cached headers linked to each other from start
+	entries := make([]cachedHeader, 0, end-start+1)
+	for number := start; number < end; number++ {
+		entry, ok := hc.get(number)
+		if !ok || (len(entries) > 0 && entry.ParentHash != entries[len(entries)-1].Hash) {
+			break
+		}
+		entries = append(entries, entry)
+	}
+
+	first := start + uint64(len(entries))
+	headers, err := fetch(first, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// cached headers were reorged, fetch the whole range again
+	if len(entries) > 0 && headers[0].ParentHash != entries[len(entries)-1].Hash {
+		Logger.Info("Bor reorg detected, invalidating cached headers", "start", start, "end", first-1)
+		hc.Invalidate(start, first-1)
+
+		entries = entries[:0]
+		if headers, err = fetch(start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, header := range headers {
+		hc.Add(header)
+
+		entry, _ := hc.get(header.Number.Uint64())
+		entries = append(entries, entry)
+	}
+
+	if uint64(len(entries)) != end-start+1 {
+		return nil, fmt.Errorf("expected %v headers from %v to %v, got %v", end-start+1, start, end, len(entries))
+	}
+
+	root := hc.subtreeHash(entries, subtreeLevel(uint64(len(entries))), 0)
+	return root[:], nil
+}
+
+// subtreeHash returns the hash of the subtree at level and index, padding leaves beyond entries with empty hashes
+func (hc *HeaderCache) subtreeHash(entries []cachedHeader, level uint, index uint64) [32]byte {
+	low := index << level
+	high := (index + 1) << level
+	if low >= uint64(len(entries)) {
+		return zeroHashes[level]
+	}
+
+	if level == 0 {
+		return entries[low].Leaf
+	}
+
+	// subtrees without padding are cached by the hash of their last block
+	full := high <= uint64(len(entries))
+	key := subtreeKey{Level: level}
+	if full {
+		key.LastBlock = entries[high-1].Hash
+		if hash, ok := hc.subtrees.Get(key); ok {
+			return hash.([32]byte)
+		}
+	}
+
+	var left, right [32]byte
+	if level >= parallelSubtreeLevel {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			left = hc.subtreeHash(entries, level-1, 2*index)
+		}()
+		right = hc.subtreeHash(entries, level-1, 2*index+1)
+		wg.Wait()
+	} else {
+		left = hc.subtreeHash(entries, level-1, 2*index)
+		right = hc.subtreeHash(entries, level-1, 2*index+1)
+	}
+
+	var hash [32]byte
+	copy(hash[:], crypto.Keccak256(left[:], right[:]))
+	if full {
+		hc.subtrees.Add(key, hash)
+	}
+
+	return hash
+}
+
+// subtreeLevel returns the level of the smallest tree holding n leaves
+func subtreeLevel(n uint64) (level uint) {
+	for uint64(1)<<level < n {
+		level++
+	}
+
+	return level
+}
+
+// fetchHeaders fetches the headers from start to end in parallel batch calls
+func fetchHeaders(rpcClient *rpc.Client, start uint64, end uint64) ([]*ethTypes.Header, error) {
+	headers := make([]*ethTypes.Header, end-start+1)
+	elements := make([]rpc.BatchElem, len(headers))
+	for i := range elements {
+		elements[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(start + uint64(i)), false},
+			Result: &headers[i],
+		}
+	}
+
+	var g errgroup.Group
+	for i := 0; i < len(elements); i += headerBatchSize {
+		batch := elements[i:]
+		if len(batch) > headerBatchSize {
+			batch = batch[:headerBatchSize]
+		}
+
+		g.Go(func() error {
+			return rpcClient.BatchCallContext(context.Background(), batch)
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for i, element := range elements {
+		if element.Error != nil {
+			return nil, element.Error
+		}
+
+		if headers[i] == nil {
+			return nil, fmt.Errorf("header %v not found on matic chain", start+uint64(i))
+		}
+	}
+
+	return headers, nil
+}
+
+func appendBytes32(data ...[]byte) []byte {
+	var result []byte
+	for _, v := range data {
+		var padded [32]byte
+		if len(v) <= 32 {
+			copy(padded[32-len(v):], v)
+		}
+		result = append(result, padded[:]...)
+	}
+	return result
+}
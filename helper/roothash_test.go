@@ -0,0 +1,121 @@
+package helper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// genHeaders generates a chain of bor headers from start to end on top of parent
+func genHeaders(parent common.Hash, start uint64, end uint64, seed uint64) []*ethTypes.Header {
+	var headers []*ethTypes.Header
+	for number := start; number <= end; number++ {
+		header := &ethTypes.Header{
+			ParentHash:  parent,
+			Number:      new(big.Int).SetUint64(number),
+			Time:        1000 + number,
+			TxHash:      common.BigToHash(new(big.Int).SetUint64(number + seed)),
+			ReceiptHash: common.BigToHash(new(big.Int).SetUint64(number * seed)),
+		}
+		headers = append(headers, header)
+		parent = header.Hash()
+	}
+
+	return headers
+}
+
+// referenceRootHash computes the root hash of headers level by level
+func referenceRootHash(headers []*ethTypes.Header) []byte {
+	size := 1
+	for size < len(headers) {
+		size *= 2
+	}
+
+	level := make([][]byte, size)
+	for i := range level {
+		level[i] = make([]byte, 32)
+		if i < len(headers) {
+			level[i] = GetHeaderLeaf(headers[i])
+		}
+	}
+
+	for len(level) > 1 {
+		var parents [][]byte
+		for i := 0; i < len(level); i += 2 {
+			parents = append(parents, crypto.Keccak256(level[i], level[i+1]))
+		}
+		level = parents
+	}
+
+	return level[0]
+}
+
+func TestHeaderCacheGetRootHash(t *testing.T) {
+	chain := genHeaders(common.Hash{}, 0, 511, 7)
+
+	var fetched int
+	fetch := func(start uint64, end uint64) ([]*ethTypes.Header, error) {
+		fetched += int(end - start + 1)
+		return chain[start : end+1], nil
+	}
+
+	cache := NewHeaderCache()
+
+	root, err := cache.GetRootHash(10, 300, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[10:301]), root)
+	require.Equal(t, 291, fetched, "All headers should be fetched on first call")
+
+	fetched = 0
+	root, err = cache.GetRootHash(10, 300, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[10:301]), root)
+	require.Equal(t, 1, fetched, "Only the last header should be fetched for a cached range")
+
+	fetched = 0
+	root, err = cache.GetRootHash(10, 310, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[10:311]), root)
+	require.Equal(t, 10, fetched, "Only new headers should be fetched for an extended range")
+
+	root, err = cache.GetRootHash(5, 5, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[5:6]), root)
+
+	_, err = cache.GetRootHash(6, 5, fetch)
+	require.Error(t, err)
+}
+
+func TestHeaderCacheReorg(t *testing.T) {
+	chain := genHeaders(common.Hash{}, 0, 99, 7)
+
+	var fetched int
+	fetch := func(start uint64, end uint64) ([]*ethTypes.Header, error) {
+		fetched += int(end - start + 1)
+		return chain[start : end+1], nil
+	}
+
+	cache := NewHeaderCache()
+
+	_, err := cache.GetRootHash(0, 63, fetch)
+	require.NoError(t, err)
+
+	// reorg from block 40 onwards
+	chain = append(chain[:40:40], genHeaders(chain[39].Hash(), 40, 99, 11)...)
+
+	fetched = 0
+	root, err := cache.GetRootHash(0, 63, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[:64]), root)
+	require.Equal(t, 1+64, fetched, "Whole range should be fetched again after reorg")
+
+	fetched = 0
+	root, err = cache.GetRootHash(0, 63, fetch)
+	require.NoError(t, err)
+	require.Equal(t, referenceRootHash(chain[:64]), root)
+	require.Equal(t, 1, fetched, "Reorged headers should be cached")
+}
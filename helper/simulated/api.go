@@ -0,0 +1,221 @@
+package simulated
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/rlp"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// CallArgs are the arguments of eth_call and eth_estimateGas
+type CallArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+}
+
+// FilterArgs are the arguments of eth_getLogs
+type FilterArgs struct {
+	BlockHash *common.Hash     `json:"blockHash"`
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// ethAPI serves the eth namespace of a simulated chain
+type ethAPI struct {
+	chain *Chain
+}
+
+// ChainId returns chain id
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.chain.ChainID())
+}
+
+// BlockNumber returns latest block number
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.chain.Head().Number.Uint64())
+}
+
+// GasPrice returns suggested gas price
+func (api *ethAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(DefaultGasPrice))
+}
+
+// GetBlockByNumber returns block header, transactions are not served
+func (api *ethAPI) GetBlockByNumber(number rpc.BlockNumber, fullTx bool) (*types.Header, error) {
+	if number < 0 {
+		return api.chain.Head(), nil
+	}
+	return api.chain.HeaderByNumber(uint64(number)), nil
+}
+
+// GetTransactionCount returns nonce of address
+func (api *ethAPI) GetTransactionCount(address common.Address, number rpc.BlockNumber) hexutil.Uint64 {
+	api.chain.mu.RLock()
+	defer api.chain.mu.RUnlock()
+
+	return hexutil.Uint64(api.chain.nonces[address])
+}
+
+// GetCode returns placeholder code for simulated contracts
+func (api *ethAPI) GetCode(address common.Address, number rpc.BlockNumber) hexutil.Bytes {
+	api.chain.mu.RLock()
+	defer api.chain.mu.RUnlock()
+
+	if _, ok := api.chain.contracts[address]; ok {
+		return hexutil.Bytes{0x01}
+	}
+	return hexutil.Bytes{}
+}
+
+// GetBalance returns zero balance, simulated chains do not track value
+func (api *ethAPI) GetBalance(address common.Address, number rpc.BlockNumber) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(0))
+}
+
+// Call executes static call of simulated contract
+func (api *ethAPI) Call(args CallArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	if args.To == nil {
+		return nil, errors.New("missing receiver")
+	}
+
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+
+	var payload []byte
+	if args.Data != nil {
+		payload = *args.Data
+	}
+
+	return api.chain.call(from, *args.To, payload)
+}
+
+// EstimateGas returns fixed gas of simulated contract transactions
+func (api *ethAPI) EstimateGas(args CallArgs) hexutil.Uint64 {
+	return hexutil.Uint64(DefaultTxGas)
+}
+
+// SendRawTransaction mines signed transaction
+func (api *ethAPI) SendRawTransaction(encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return api.chain.sendTransaction(tx)
+}
+
+// GetTransactionByHash returns mined transaction with inclusion info
+func (api *ethAPI) GetTransactionByHash(hash common.Hash) (map[string]interface{}, error) {
+	api.chain.mu.RLock()
+	defer api.chain.mu.RUnlock()
+
+	tx, ok := api.chain.transactions[hash]
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := tx.tx.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	result["blockNumber"] = hexutil.EncodeUint64(tx.block)
+	result["blockHash"] = api.chain.headers[tx.block].Hash()
+	result["from"] = tx.from
+	result["transactionIndex"] = hexutil.Uint(tx.index)
+
+	return result, nil
+}
+
+// GetTransactionReceipt returns receipt of mined transaction
+func (api *ethAPI) GetTransactionReceipt(hash common.Hash) *types.Receipt {
+	return api.chain.Receipt(hash)
+}
+
+// GetLogs returns logs matching filter
+func (api *ethAPI) GetLogs(args FilterArgs) []*types.Log {
+	api.chain.mu.RLock()
+	defer api.chain.mu.RUnlock()
+
+	head := uint64(len(api.chain.headers) - 1)
+	from, to := uint64(0), head
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = uint64(*args.FromBlock)
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = uint64(*args.ToBlock)
+	}
+
+	logs := []*types.Log{}
+	for _, log := range api.chain.logs {
+		if args.BlockHash != nil {
+			if log.BlockHash != *args.BlockHash {
+				continue
+			}
+		} else if log.BlockNumber < from || log.BlockNumber > to {
+			continue
+		}
+
+		if matchLog(log, args.Addresses, args.Topics) {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs
+}
+
+// matchLog checks if log matches addresses and topics of filter
+func matchLog(log *types.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, options := range topics {
+		if len(options) == 0 {
+			continue
+		}
+
+		found := false
+		for _, topic := range options {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
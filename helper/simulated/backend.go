@@ -0,0 +1,76 @@
+package simulated
+
+import (
+	"math/big"
+
+	"github.com/maticnetwork/bor/common"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// Addresses of contracts deployed on simulated main chain
+var (
+	RootChainAddress    = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	StakingInfoAddress  = common.HexToAddress("0x0000000000000000000000000000000000001002")
+	StateSenderAddress  = common.HexToAddress("0x0000000000000000000000000000000000001003")
+	SlashManagerAddress = common.HexToAddress("0x0000000000000000000000000000000000001004")
+)
+
+// Simulated chain defaults
+var (
+	DefaultMainChainID = big.NewInt(5)
+	DefaultBorChainID  = big.NewInt(15001)
+	DefaultHeimdallID  = "heimdall-simulated"
+	MainChainBlockTime = uint64(15)
+	BorChainBlockTime  = uint64(2)
+)
+
+// Backend is a simulated main chain with heimdall contracts deployed and a simulated bor chain as header source
+type Backend struct {
+	MainChain *Chain
+	BorChain  *Chain
+
+	RootChain    *RootChain
+	StakingInfo  *StakingInfo
+	StateSender  *StateSender
+	SlashManager *SlashManager
+}
+
+// NewBackend creates simulated main chain with contracts and bor chain with borBlocks mined
+func NewBackend(borBlocks uint64) *Backend {
+	mainChain := NewChain(DefaultMainChainID, MainChainBlockTime)
+	borChain := NewChain(DefaultBorChainID, BorChainBlockTime)
+	borChain.Mine(borBlocks)
+
+	return &Backend{
+		MainChain: mainChain,
+		BorChain:  borChain,
+
+		RootChain:    NewRootChain(mainChain, RootChainAddress),
+		StakingInfo:  NewStakingInfo(mainChain, StakingInfoAddress),
+		StateSender:  NewStateSender(mainChain, StateSenderAddress),
+		SlashManager: NewSlashManager(mainChain, SlashManagerAddress, DefaultHeimdallID),
+	}
+}
+
+// Close closes simulated chains
+func (b *Backend) Close() {
+	b.MainChain.Close()
+	b.BorChain.Close()
+}
+
+// ContractCaller returns contract caller running against simulated chains, with its own header cache
+func (b *Backend) ContractCaller() (helper.ContractCaller, error) {
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		return contractCaller, err
+	}
+
+	contractCaller.MainChainClient = b.MainChain.Client()
+	contractCaller.MainChainRPC = b.MainChain.RPCClient()
+	contractCaller.MaticChainClient = b.BorChain.Client()
+	contractCaller.MaticChainRPC = b.BorChain.RPCClient()
+	contractCaller.HeaderCache = helper.NewHeaderCache()
+
+	return contractCaller, nil
+}
@@ -0,0 +1,151 @@
+package simulated
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// testSigs are checkpoint signatures, not verified by simulated rootchain
+var testSigs = [][3]*big.Int{{big.NewInt(1), big.NewInt(2), big.NewInt(27)}}
+
+// checkpointData returns abi encoded checkpoint data as signed by validators
+func checkpointData(proposer common.Address, start uint64, end uint64, root common.Hash) []byte {
+	var data []byte
+	for _, word := range [][]byte{
+		proposer.Bytes(),
+		new(big.Int).SetUint64(start).Bytes(),
+		new(big.Int).SetUint64(end).Bytes(),
+		root.Bytes(),
+		common.Hash{}.Bytes(),
+		DefaultBorChainID.Bytes(),
+	} {
+		data = append(data, common.LeftPadBytes(word, 32)...)
+	}
+	return data
+}
+
+func TestSubmitCheckpoint(t *testing.T) {
+	backend := NewBackend(300)
+	defer backend.Close()
+
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	helper.SetTestPrivPubKey(secp256k1.GenPrivKey())
+	proposer := common.BytesToAddress(helper.GetAddress())
+
+	contractCaller, err := backend.ContractCaller()
+	require.NoError(t, err)
+
+	rootChainInstance, err := contractCaller.GetRootChainInstance(RootChainAddress)
+	require.NoError(t, err)
+
+	current, err := contractCaller.CurrentHeaderBlock(rootChainInstance, ChildBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), current)
+
+	// root hash of bor headers
+	require.True(t, contractCaller.CheckIfBlocksExist(255))
+	require.False(t, contractCaller.CheckIfBlocksExist(301))
+	root, err := contractCaller.GetRootHash(0, 255, 1024)
+	require.NoError(t, err)
+
+	// submit checkpoint
+	err = contractCaller.SendCheckpoint(checkpointData(proposer, 0, 255, common.BytesToHash(root)), testSigs, RootChainAddress, rootChainInstance)
+	require.NoError(t, err)
+
+	current, err = contractCaller.CurrentHeaderBlock(rootChainInstance, ChildBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), current)
+
+	headerRoot, start, end, _, headerProposer, err := contractCaller.GetHeaderInfo(1, rootChainInstance, ChildBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, root, headerRoot.Bytes())
+	require.Equal(t, uint64(0), start)
+	require.Equal(t, uint64(255), end)
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(proposer.Bytes()), headerProposer)
+
+	lastChildBlock, err := contractCaller.GetLastChildBlock(rootChainInstance)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), lastChildBlock)
+
+	// decode event from receipt
+	logs, err := backend.MainChain.Client().FilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{RootChainAddress}})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	receipt, err := contractCaller.GetConfirmedTxReceipt(logs[0].TxHash, 0)
+	require.NoError(t, err)
+
+	event, err := contractCaller.DecodeNewHeaderBlockEvent(RootChainAddress, receipt, uint64(logs[0].Index))
+	require.NoError(t, err)
+	require.Equal(t, uint64(ChildBlockInterval), event.HeaderBlockId.Uint64())
+	require.Equal(t, uint64(255), event.End.Uint64())
+	require.Equal(t, proposer, event.Proposer)
+
+	// confirmations
+	require.False(t, contractCaller.IsTxConfirmed(logs[0].TxHash, 5))
+	backend.MainChain.Mine(5)
+	require.True(t, contractCaller.IsTxConfirmed(logs[0].TxHash, 5))
+
+	// discontinuous checkpoint fails
+	err = contractCaller.SendCheckpoint(checkpointData(proposer, 100, 299, common.BytesToHash(root)), testSigs, RootChainAddress, rootChainInstance)
+	require.NoError(t, err)
+	lastChildBlock, err = contractCaller.GetLastChildBlock(rootChainInstance)
+	require.NoError(t, err)
+	require.Equal(t, uint64(255), lastChildBlock)
+}
+
+func TestStakingEvents(t *testing.T) {
+	backend := NewBackend(0)
+	defer backend.Close()
+
+	contractCaller, err := backend.ContractCaller()
+	require.NoError(t, err)
+
+	stakingInfoInstance, err := contractCaller.GetStakingInfoInstance(StakingInfoAddress)
+	require.NoError(t, err)
+
+	signer := common.HexToAddress("0x1234")
+	amount := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+
+	receipt, err := backend.StakingInfo.Stake(1, signer, []byte{0x04}, 1, amount)
+	require.NoError(t, err)
+
+	event, err := contractCaller.DecodeValidatorJoinEvent(StakingInfoAddress, receipt, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), event.ValidatorId.Uint64())
+	require.Equal(t, uint64(1), event.Nonce.Uint64())
+	require.Equal(t, signer, event.Signer)
+
+	validator, err := contractCaller.GetValidatorInfo(1, stakingInfoInstance)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), validator.VotingPower)
+	require.Equal(t, hmTypes.BytesToHeimdallAddress(signer.Bytes()), validator.Signer)
+
+	receipt, err = backend.StakingInfo.UpdateStake(1, new(big.Int).Mul(amount, big.NewInt(2)))
+	require.NoError(t, err)
+
+	update, err := contractCaller.DecodeValidatorStakeUpdateEvent(StakingInfoAddress, receipt, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), update.Nonce.Uint64())
+
+	// state sync
+	stateSenderInstance, err := contractCaller.GetStateSenderInstance(StateSenderAddress)
+	require.NoError(t, err)
+
+	receipt, err = backend.StateSender.SyncState(common.HexToAddress("0x5678"), []byte("data"))
+	require.NoError(t, err)
+
+	synced, err := contractCaller.DecodeStateSyncedEvent(StateSenderAddress, receipt, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), synced.Id.Uint64())
+	require.Equal(t, uint64(1), contractCaller.CurrentStateCounter(stateSenderInstance).Uint64())
+}
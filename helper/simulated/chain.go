@@ -0,0 +1,410 @@
+package simulated
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+const (
+	// DefaultGasLimit is the gas limit of simulated blocks
+	DefaultGasLimit = uint64(10000000)
+	// DefaultGasPrice is the gas price suggested by simulated chains (1 Gwei)
+	DefaultGasPrice = int64(1000000000)
+	// DefaultTxGas is the gas estimated for transactions of simulated contracts
+	DefaultTxGas = uint64(500000)
+)
+
+// SystemAddress is the sender of transactions created by simulated contracts themselves, e.g. events of the staking flow
+var SystemAddress = common.HexToAddress("0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE")
+
+// Contract is a contract simulated in go
+type Contract interface {
+	// ABI returns abi of the contract
+	ABI() *abi.ABI
+	// Call executes method of the contract with unpacked inputs and returns outputs to pack
+	Call(call *Call, method *abi.Method, inputs []interface{}) ([]interface{}, error)
+}
+
+// Call is the execution of a simulated contract
+type Call struct {
+	From    common.Address
+	To      common.Address
+	Header  *types.Header // block the call is executed in
+	Static  bool          // call must not change state
+	Payload []byte
+
+	logs []*types.Log
+}
+
+// Emit logs event of contract at address
+func (call *Call) Emit(address common.Address, contractABI *abi.ABI, name string, args ...interface{}) error {
+	event, ok := contractABI.Events[name]
+	if !ok {
+		return fmt.Errorf("event %v not found", name)
+	}
+
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("event %v expects %v arguments, got %v", name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.Id()}
+	nonIndexed := make([]interface{}, 0, len(args))
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+
+		topic, err := toTopic(args[i])
+		if err != nil {
+			return err
+		}
+		topics = append(topics, topic)
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return err
+	}
+
+	call.logs = append(call.logs, &types.Log{
+		Address: address,
+		Topics:  topics,
+		Data:    data,
+	})
+
+	return nil
+}
+
+// toTopic returns topic of an indexed event argument
+func toTopic(arg interface{}) (common.Hash, error) {
+	switch v := arg.(type) {
+	case *big.Int:
+		return common.BigToHash(v), nil
+	case common.Address:
+		return common.BytesToHash(v.Bytes()), nil
+	case common.Hash:
+		return v, nil
+	case [32]byte:
+		return common.Hash(v), nil
+	default:
+		return common.Hash{}, fmt.Errorf("unsupported indexed argument %T", arg)
+	}
+}
+
+// transaction is a transaction included in a block
+type transaction struct {
+	tx    *types.Transaction
+	from  common.Address
+	block uint64
+	index uint
+}
+
+// Chain is an in-memory chain served over in-process json-rpc, so ethclient and rpc clients can run against it.
+// Every transaction is mined in its own block and executed by the simulated contract at its receiver address.
+type Chain struct {
+	mu sync.RWMutex
+
+	chainID   *big.Int
+	blockTime uint64
+
+	headers      []*types.Header
+	transactions map[common.Hash]*transaction
+	receipts     map[common.Hash]*types.Receipt
+	logs         []*types.Log
+	nonces       map[common.Address]uint64
+	contracts    map[common.Address]Contract
+
+	server *rpc.Server
+	client *rpc.Client
+}
+
+// NewChain creates a chain with a genesis block at current time
+func NewChain(chainID *big.Int, blockTime uint64) *Chain {
+	c := &Chain{
+		chainID:      chainID,
+		blockTime:    blockTime,
+		transactions: make(map[common.Hash]*transaction),
+		receipts:     make(map[common.Hash]*types.Receipt),
+		nonces:       make(map[common.Address]uint64),
+		contracts:    make(map[common.Address]Contract),
+	}
+
+	c.headers = append(c.headers, &types.Header{
+		Number:      big.NewInt(0),
+		Time:        uint64(time.Now().Unix()),
+		Difficulty:  big.NewInt(1),
+		GasLimit:    DefaultGasLimit,
+		UncleHash:   types.EmptyUncleHash,
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+	})
+
+	c.server = rpc.NewServer()
+	if err := c.server.RegisterName("eth", &ethAPI{chain: c}); err != nil {
+		panic(err)
+	}
+	c.client = rpc.DialInProc(c.server)
+
+	return c
+}
+
+// RPCClient returns in-process rpc client of the chain
+func (c *Chain) RPCClient() *rpc.Client {
+	return c.client
+}
+
+// Client returns eth client of the chain
+func (c *Chain) Client() *ethclient.Client {
+	return ethclient.NewClient(c.client)
+}
+
+// Close closes rpc client and server of the chain
+func (c *Chain) Close() {
+	c.client.Close()
+	c.server.Stop()
+}
+
+// ChainID returns chain id
+func (c *Chain) ChainID() *big.Int {
+	return new(big.Int).Set(c.chainID)
+}
+
+// Deploy deploys contract at address
+func (c *Chain) Deploy(address common.Address, contract Contract) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.contracts[address] = contract
+}
+
+// Head returns latest block header
+func (c *Chain) Head() *types.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return types.CopyHeader(c.headers[len(c.headers)-1])
+}
+
+// HeaderByNumber returns block header of number, nil if not mined yet
+func (c *Chain) HeaderByNumber(number uint64) *types.Header {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+	return types.CopyHeader(c.headers[number])
+}
+
+// Receipt returns receipt of transaction
+func (c *Chain) Receipt(txHash common.Hash) *types.Receipt {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.receipts[txHash]
+}
+
+// Mine mines n empty blocks
+func (c *Chain) Mine(n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := uint64(0); i < n; i++ {
+		c.mine(nil, nil)
+	}
+}
+
+// Execute executes fn as a transaction of the system address to contract at address in a new block
+func (c *Chain) Execute(address common.Address, fn func(call *Call) error) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nonce := c.nonces[SystemAddress]
+	tx := types.NewTransaction(nonce, address, big.NewInt(0), DefaultTxGas, big.NewInt(0), nil)
+
+	call := &Call{
+		From:   SystemAddress,
+		To:     address,
+		Header: c.pending(),
+	}
+	if err := fn(call); err != nil {
+		return nil, err
+	}
+
+	c.nonces[SystemAddress] = nonce + 1
+	return c.mine(&transaction{tx: tx, from: SystemAddress}, call.logs), nil
+}
+
+// call executes static call of contract
+func (c *Chain) call(from common.Address, to common.Address, payload []byte) ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.execute(&Call{
+		From:    from,
+		To:      to,
+		Header:  c.headers[len(c.headers)-1],
+		Static:  true,
+		Payload: payload,
+	})
+}
+
+// sendTransaction validates and mines signed transaction
+func (c *Chain) sendTransaction(tx *types.Transaction) (common.Hash, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.NewEIP155Signer(c.chainID)
+	}
+
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if _, ok := c.transactions[tx.Hash()]; ok {
+		return common.Hash{}, errors.New("already known")
+	}
+
+	if nonce := c.nonces[from]; tx.Nonce() != nonce {
+		return common.Hash{}, fmt.Errorf("invalid nonce: have %v, want %v", tx.Nonce(), nonce)
+	}
+	c.nonces[from]++
+
+	var logs []*types.Log
+	failed := tx.To() == nil
+	if !failed {
+		call := &Call{
+			From:    from,
+			To:      *tx.To(),
+			Header:  c.pending(),
+			Payload: tx.Data(),
+		}
+		if _, err := c.execute(call); err != nil {
+			failed = true
+		} else {
+			logs = call.logs
+		}
+	}
+
+	receipt := c.mine(&transaction{tx: tx, from: from}, logs)
+	if failed {
+		receipt.Status = types.ReceiptStatusFailed
+	}
+
+	return tx.Hash(), nil
+}
+
+// execute executes call of the contract at receiver address
+func (c *Chain) execute(call *Call) ([]byte, error) {
+	contract, ok := c.contracts[call.To]
+	if !ok {
+		// no code
+		return nil, nil
+	}
+
+	contractABI := contract.ABI()
+	if len(call.Payload) < 4 {
+		return nil, errors.New("invalid payload")
+	}
+
+	method, err := contractABI.MethodById(call.Payload[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	if call.Static && !method.Const {
+		return nil, fmt.Errorf("method %v is not constant", method.Name)
+	}
+
+	inputs, err := method.Inputs.UnpackValues(call.Payload[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := contract.Call(call, method, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(outputs...)
+}
+
+// pending returns header of next block
+func (c *Chain) pending() *types.Header {
+	parent := c.headers[len(c.headers)-1]
+	number := new(big.Int).Add(parent.Number, big.NewInt(1))
+
+	return &types.Header{
+		ParentHash:  parent.Hash(),
+		UncleHash:   types.EmptyUncleHash,
+		Number:      number,
+		Time:        parent.Time + c.blockTime,
+		Difficulty:  big.NewInt(1),
+		GasLimit:    DefaultGasLimit,
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+		// distinct extra data keeps headers of different chains apart
+		Extra: crypto.Keccak256(c.chainID.Bytes(), number.Bytes()),
+	}
+}
+
+// mine mines next block with optional transaction and its logs
+func (c *Chain) mine(tx *transaction, logs []*types.Log) *types.Receipt {
+	header := c.pending()
+
+	var receipt *types.Receipt
+	if tx != nil {
+		receipt = &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: DefaultTxGas,
+			GasUsed:           DefaultTxGas,
+			TxHash:            tx.tx.Hash(),
+			Logs:              logs,
+			BlockNumber:       new(big.Int).Set(header.Number),
+		}
+		if receipt.Logs == nil {
+			receipt.Logs = []*types.Log{}
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+
+		header.GasUsed = receipt.GasUsed
+		header.Bloom = receipt.Bloom
+		header.TxHash = types.DeriveSha(types.Transactions{tx.tx})
+		header.ReceiptHash = types.DeriveSha(types.Receipts{receipt})
+	}
+
+	// block hash is known after header is complete
+	hash := header.Hash()
+	c.headers = append(c.headers, header)
+
+	if tx != nil {
+		tx.block = header.Number.Uint64()
+		c.transactions[tx.tx.Hash()] = tx
+
+		receipt.BlockHash = hash
+		for i, log := range receipt.Logs {
+			log.BlockNumber = header.Number.Uint64()
+			log.BlockHash = hash
+			log.TxHash = receipt.TxHash
+			log.Index = uint(i)
+			c.logs = append(c.logs, log)
+		}
+		c.receipts[receipt.TxHash] = receipt
+	}
+
+	return receipt
+}
@@ -0,0 +1,546 @@
+package simulated
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/contracts/slashmanager"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+)
+
+// ChildBlockInterval is the header block id interval of the rootchain contract
+const ChildBlockInterval = uint64(10000)
+
+// mustABI parses contract abi
+func mustABI(data string) *abi.ABI {
+	contractABI, err := abi.JSON(strings.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return &contractABI
+}
+
+// errUnsupported returns error of a method not simulated
+func errUnsupported(method *abi.Method) error {
+	return fmt.Errorf("method %v is not simulated", method.Name)
+}
+
+//
+// RootChain
+//
+
+// HeaderBlock is a checkpoint submitted to rootchain contract
+type HeaderBlock struct {
+	Root       [32]byte
+	Start      uint64
+	End        uint64
+	CreatedAt  uint64
+	Proposer   common.Address
+	BorChainID uint64
+	Sigs       [][3]*big.Int
+}
+
+// RootChain simulates the rootchain contract. Checkpoint signatures are recorded, not verified.
+type RootChain struct {
+	mu sync.RWMutex
+
+	abi             *abi.ABI
+	headerBlocks    map[uint64]HeaderBlock
+	nextHeaderBlock uint64
+}
+
+// NewRootChain deploys rootchain contract at address
+func NewRootChain(chain *Chain, address common.Address) *RootChain {
+	r := &RootChain{
+		abi:             mustABI(rootchain.RootchainABI),
+		headerBlocks:    make(map[uint64]HeaderBlock),
+		nextHeaderBlock: ChildBlockInterval,
+	}
+	chain.Deploy(address, r)
+	return r
+}
+
+// ABI returns contract abi
+func (r *RootChain) ABI() *abi.ABI {
+	return r.abi
+}
+
+// HeaderBlock returns checkpoint with header block id
+func (r *RootChain) HeaderBlock(id uint64) (HeaderBlock, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	headerBlock, ok := r.headerBlocks[id]
+	return headerBlock, ok
+}
+
+// CurrentHeaderBlock returns header block id of latest checkpoint
+func (r *RootChain) CurrentHeaderBlock() uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.nextHeaderBlock - ChildBlockInterval
+}
+
+// Call executes method of the contract
+func (r *RootChain) Call(call *Call, method *abi.Method, inputs []interface{}) ([]interface{}, error) {
+	switch method.Name {
+	case "headerBlocks":
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		headerBlock := r.headerBlocks[inputs[0].(*big.Int).Uint64()]
+		return []interface{}{
+			headerBlock.Root,
+			new(big.Int).SetUint64(headerBlock.Start),
+			new(big.Int).SetUint64(headerBlock.End),
+			new(big.Int).SetUint64(headerBlock.CreatedAt),
+			headerBlock.Proposer,
+		}, nil
+
+	case "currentHeaderBlock":
+		return []interface{}{new(big.Int).SetUint64(r.CurrentHeaderBlock())}, nil
+
+	case "getLastChildBlock":
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		return []interface{}{new(big.Int).SetUint64(r.headerBlocks[r.nextHeaderBlock-ChildBlockInterval].End)}, nil
+
+	case "submitCheckpoint":
+		return nil, r.submitCheckpoint(call, inputs[0].([]byte), inputs[1].([][3]*big.Int))
+
+	default:
+		return nil, errUnsupported(method)
+	}
+}
+
+// submitCheckpoint adds checkpoint of abi encoded (proposer, start, end, root hash, account root hash, bor chain id)
+func (r *RootChain) submitCheckpoint(call *Call, data []byte, sigs [][3]*big.Int) error {
+	if len(data) != 6*32 {
+		return errors.New("invalid checkpoint data")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	headerBlock := HeaderBlock{
+		Proposer:   common.BytesToAddress(data[12:32]),
+		Start:      new(big.Int).SetBytes(data[32:64]).Uint64(),
+		End:        new(big.Int).SetBytes(data[64:96]).Uint64(),
+		CreatedAt:  call.Header.Time,
+		BorChainID: new(big.Int).SetBytes(data[160:192]).Uint64(),
+		Sigs:       sigs,
+	}
+	copy(headerBlock.Root[:], data[96:128])
+
+	// checkpoint must continue last child block
+	nextChildBlock := r.headerBlocks[r.nextHeaderBlock-ChildBlockInterval].End
+	if nextChildBlock != 0 {
+		nextChildBlock++
+	}
+	if headerBlock.Start != nextChildBlock || headerBlock.End < headerBlock.Start {
+		return errors.New("invalid checkpoint range")
+	}
+
+	headerBlockID := new(big.Int).SetUint64(r.nextHeaderBlock)
+	if err := call.Emit(call.To, r.abi, "NewHeaderBlock",
+		headerBlock.Proposer,
+		headerBlockID,
+		big.NewInt(0),
+		new(big.Int).SetUint64(headerBlock.Start),
+		new(big.Int).SetUint64(headerBlock.End),
+		headerBlock.Root,
+	); err != nil {
+		return err
+	}
+
+	r.headerBlocks[r.nextHeaderBlock] = headerBlock
+	r.nextHeaderBlock += ChildBlockInterval
+
+	return nil
+}
+
+//
+// StakingInfo
+//
+
+// Staker is a validator of the staking info contract
+type Staker struct {
+	Amount            *big.Int
+	Reward            *big.Int
+	ActivationEpoch   uint64
+	DeactivationEpoch uint64
+	Signer            common.Address
+	Status            uint64
+}
+
+// StakingInfo simulates the staking info contract. Staking flow events are emitted by its go methods.
+type StakingInfo struct {
+	mu sync.RWMutex
+
+	chain   *Chain
+	address common.Address
+	abi     *abi.ABI
+
+	stakers          map[uint64]*Staker
+	nonces           map[uint64]uint64
+	accountStateRoot [32]byte
+}
+
+// NewStakingInfo deploys staking info contract at address
+func NewStakingInfo(chain *Chain, address common.Address) *StakingInfo {
+	s := &StakingInfo{
+		chain:   chain,
+		address: address,
+		abi:     mustABI(stakinginfo.StakinginfoABI),
+		stakers: make(map[uint64]*Staker),
+		nonces:  make(map[uint64]uint64),
+	}
+	chain.Deploy(address, s)
+	return s
+}
+
+// ABI returns contract abi
+func (s *StakingInfo) ABI() *abi.ABI {
+	return s.abi
+}
+
+// SetAccountStateRoot sets account state root
+func (s *StakingInfo) SetAccountStateRoot(root [32]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accountStateRoot = root
+}
+
+// Call executes method of the contract
+func (s *StakingInfo) Call(call *Call, method *abi.Method, inputs []interface{}) ([]interface{}, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch method.Name {
+	case "getStakerDetails":
+		staker, ok := s.stakers[inputs[0].(*big.Int).Uint64()]
+		if !ok {
+			staker = &Staker{Amount: big.NewInt(0), Reward: big.NewInt(0)}
+		}
+		return []interface{}{
+			staker.Amount,
+			staker.Reward,
+			new(big.Int).SetUint64(staker.ActivationEpoch),
+			new(big.Int).SetUint64(staker.DeactivationEpoch),
+			staker.Signer,
+			new(big.Int).SetUint64(staker.Status),
+		}, nil
+
+	case "totalValidatorStake":
+		amount := big.NewInt(0)
+		if staker, ok := s.stakers[inputs[0].(*big.Int).Uint64()]; ok {
+			amount = staker.Amount
+		}
+		return []interface{}{amount}, nil
+
+	case "validatorNonce":
+		return []interface{}{new(big.Int).SetUint64(s.nonces[inputs[0].(*big.Int).Uint64()])}, nil
+
+	case "getAccountStateRoot":
+		return []interface{}{s.accountStateRoot}, nil
+
+	default:
+		return nil, errUnsupported(method)
+	}
+}
+
+// Stake adds validator and emits Staked event
+func (s *StakingInfo) Stake(id uint64, signer common.Address, signerPubkey []byte, activationEpoch uint64, amount *big.Int) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.stakers[id]; ok {
+			return fmt.Errorf("validator %v already staked", id)
+		}
+
+		s.stakers[id] = &Staker{
+			Amount:          new(big.Int).Set(amount),
+			Reward:          big.NewInt(0),
+			ActivationEpoch: activationEpoch,
+			Signer:          signer,
+			Status:          1,
+		}
+		s.nonces[id]++
+
+		return call.Emit(s.address, s.abi, "Staked",
+			signer,
+			new(big.Int).SetUint64(id),
+			new(big.Int).SetUint64(s.nonces[id]),
+			new(big.Int).SetUint64(activationEpoch),
+			amount,
+			s.totalStake(),
+			signerPubkey,
+		)
+	})
+}
+
+// UpdateStake updates stake of validator and emits StakeUpdate event
+func (s *StakingInfo) UpdateStake(id uint64, newAmount *big.Int) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		staker, ok := s.stakers[id]
+		if !ok {
+			return fmt.Errorf("validator %v not staked", id)
+		}
+
+		staker.Amount = new(big.Int).Set(newAmount)
+		s.nonces[id]++
+
+		return call.Emit(s.address, s.abi, "StakeUpdate",
+			new(big.Int).SetUint64(id),
+			new(big.Int).SetUint64(s.nonces[id]),
+			newAmount,
+		)
+	})
+}
+
+// UnstakeInit sets deactivation epoch of validator and emits UnstakeInit event
+func (s *StakingInfo) UnstakeInit(id uint64, deactivationEpoch uint64) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		staker, ok := s.stakers[id]
+		if !ok {
+			return fmt.Errorf("validator %v not staked", id)
+		}
+
+		staker.DeactivationEpoch = deactivationEpoch
+		s.nonces[id]++
+
+		return call.Emit(s.address, s.abi, "UnstakeInit",
+			staker.Signer,
+			new(big.Int).SetUint64(id),
+			new(big.Int).SetUint64(s.nonces[id]),
+			new(big.Int).SetUint64(deactivationEpoch),
+			staker.Amount,
+		)
+	})
+}
+
+// ChangeSigner updates signer of validator and emits SignerChange event
+func (s *StakingInfo) ChangeSigner(id uint64, newSigner common.Address, signerPubkey []byte) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		staker, ok := s.stakers[id]
+		if !ok {
+			return fmt.Errorf("validator %v not staked", id)
+		}
+
+		oldSigner := staker.Signer
+		staker.Signer = newSigner
+		s.nonces[id]++
+
+		return call.Emit(s.address, s.abi, "SignerChange",
+			new(big.Int).SetUint64(id),
+			new(big.Int).SetUint64(s.nonces[id]),
+			oldSigner,
+			newSigner,
+			signerPubkey,
+		)
+	})
+}
+
+// TopUpFee emits TopUpFee event of user
+func (s *StakingInfo) TopUpFee(user common.Address, fee *big.Int) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		return call.Emit(s.address, s.abi, "TopUpFee", user, fee)
+	})
+}
+
+// totalStake returns stake of all validators
+func (s *StakingInfo) totalStake() *big.Int {
+	total := big.NewInt(0)
+	for _, staker := range s.stakers {
+		total.Add(total, staker.Amount)
+	}
+	return total
+}
+
+//
+// StateSender
+//
+
+// StateSender simulates the state sender contract
+type StateSender struct {
+	mu sync.RWMutex
+
+	chain   *Chain
+	address common.Address
+	abi     *abi.ABI
+
+	counter       uint64
+	registrations map[common.Address]common.Address
+}
+
+// NewStateSender deploys state sender contract at address
+func NewStateSender(chain *Chain, address common.Address) *StateSender {
+	s := &StateSender{
+		chain:         chain,
+		address:       address,
+		abi:           mustABI(statesender.StatesenderABI),
+		registrations: make(map[common.Address]common.Address),
+	}
+	chain.Deploy(address, s)
+	return s
+}
+
+// ABI returns contract abi
+func (s *StateSender) ABI() *abi.ABI {
+	return s.abi
+}
+
+// Call executes method of the contract
+func (s *StateSender) Call(call *Call, method *abi.Method, inputs []interface{}) ([]interface{}, error) {
+	switch method.Name {
+	case "counter":
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		return []interface{}{new(big.Int).SetUint64(s.counter)}, nil
+
+	case "registrations":
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+
+		return []interface{}{s.registrations[inputs[0].(common.Address)]}, nil
+
+	case "register":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.registrations[inputs[1].(common.Address)] = inputs[0].(common.Address)
+		return nil, nil
+
+	case "syncState":
+		return nil, s.syncState(call, inputs[0].(common.Address), inputs[1].([]byte))
+
+	default:
+		return nil, errUnsupported(method)
+	}
+}
+
+// SyncState emits StateSynced event of receiver
+func (s *StateSender) SyncState(receiver common.Address, data []byte) (*types.Receipt, error) {
+	return s.chain.Execute(s.address, func(call *Call) error {
+		return s.syncState(call, receiver, data)
+	})
+}
+
+func (s *StateSender) syncState(call *Call, receiver common.Address, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := call.Emit(s.address, s.abi, "StateSynced", new(big.Int).SetUint64(s.counter+1), receiver, data); err != nil {
+		return err
+	}
+	s.counter++
+
+	return nil
+}
+
+//
+// SlashManager
+//
+
+// SlashTick is a slashing tick submitted to slash manager contract
+type SlashTick struct {
+	Data []byte
+	Sigs []byte
+}
+
+// SlashManager simulates the slash manager contract. Slashed amounts are recorded, not decoded.
+type SlashManager struct {
+	mu sync.RWMutex
+
+	abi *abi.ABI
+
+	heimdallID      [32]byte
+	jailCheckpoints uint64
+	proposerRate    uint64
+	reportRate      uint64
+	ticks           []SlashTick
+}
+
+// NewSlashManager deploys slash manager contract at address
+func NewSlashManager(chain *Chain, address common.Address, heimdallID string) *SlashManager {
+	s := &SlashManager{
+		abi:             mustABI(slashmanager.SlashmanagerABI),
+		jailCheckpoints: 5,
+		proposerRate:    50,
+		reportRate:      5,
+	}
+	copy(s.heimdallID[:], heimdallID)
+	chain.Deploy(address, s)
+	return s
+}
+
+// ABI returns contract abi
+func (s *SlashManager) ABI() *abi.ABI {
+	return s.abi
+}
+
+// Ticks returns submitted slashing ticks
+func (s *SlashManager) Ticks() []SlashTick {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]SlashTick(nil), s.ticks...)
+}
+
+// Call executes method of the contract
+func (s *SlashManager) Call(call *Call, method *abi.Method, inputs []interface{}) ([]interface{}, error) {
+	switch method.Name {
+	case "updateSlashedAmounts":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.ticks = append(s.ticks, SlashTick{Data: inputs[0].([]byte), Sigs: inputs[1].([]byte)})
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch method.Name {
+	case "slashingNonce":
+		return []interface{}{big.NewInt(int64(len(s.ticks)))}, nil
+
+	case "jailCheckpoints":
+		return []interface{}{new(big.Int).SetUint64(s.jailCheckpoints)}, nil
+
+	case "proposerRate":
+		return []interface{}{new(big.Int).SetUint64(s.proposerRate)}, nil
+
+	case "reportRate":
+		return []interface{}{new(big.Int).SetUint64(s.reportRate)}, nil
+
+	case "heimdallId":
+		return []interface{}{s.heimdallID}, nil
+
+	default:
+		return nil, errUnsupported(method)
+	}
+}
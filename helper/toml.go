@@ -21,6 +21,9 @@ eth_rpc_url = "{{ .EthRPCUrl }}"
 # RPC endpoint for bor chain
 bor_rpc_url = "{{ .BorRPCUrl }}"
 
+# RPC endpoints for child bor chains, comma separated <bor chain id>=<url> pairs
+child_chain_rpc_urls = "{{ .ChildChainRPCUrls }}"
+
 # RPC endpoint for tendermint
 tendermint_rpc_url = "{{ .TendermintRPCUrl }}"
 
@@ -48,6 +51,22 @@ main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
+##### Checkpoint Sizing Config #####
+# policy to pick checkpoint ranges: static, interval or gas
+checkpoint_sizing_policy = "{{ .CheckpointSizingPolicy }}"
+# target time between checkpoints for interval policy
+checkpoint_target_interval = "{{ .CheckpointTargetInterval }}"
+# main chain base fee (in wei) above which gas policy waits for larger checkpoints
+checkpoint_max_base_fee = "{{ .CheckpointMaxBaseFee }}"
+
+##### Metrics Config #####
+# address of bridge prometheus metrics server, empty disables it
+bridge_prometheus_listen_addr = "{{ .BridgePrometheusListenAddr }}"
+
+##### Invariant Config #####
+# number of blocks between invariant checks, 0 disables them
+invariant_check_period = "{{ .InvariantCheckPeriod }}"
+
 `
 
 var configTemplate *template.Template
@@ -81,7 +81,7 @@ func (c *ContractCaller) SendCheckpoint(signedData []byte, sigs [][3]*big.Int, r
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
+	auth, err := GenerateAuthObj(c.MainChainClient, rootChainAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -114,7 +114,7 @@ func (c *ContractCaller) SendTick(signedData []byte, sigs []byte, slashManagerAd
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), slashManagerAddress, data)
+	auth, err := GenerateAuthObj(c.MainChainClient, slashManagerAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -146,7 +146,7 @@ func (c *ContractCaller) StakeFor(val common.Address, stakeAmount *big.Int, feeA
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), stakeManagerAddress, data)
+	auth, err := GenerateAuthObj(c.MainChainClient, stakeManagerAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
@@ -171,6 +171,31 @@ func (c *ContractCaller) StakeFor(val common.Address, stakeAmount *big.Int, feeA
 	return nil
 }
 
+// UpdateSigner submits a signer key change for a validator
+func (c *ContractCaller) UpdateSigner(validatorID *big.Int, signerPubkey []byte, stakeManagerAddress common.Address, stakeManagerInstance *stakemanager.Stakemanager) error {
+	// pack data based on method definition
+	data, err := c.StakeManagerABI.Pack("updateSigner", validatorID, signerPubkey)
+	if err != nil {
+		Logger.Error("Unable to pack tx for updateSigner", "error", err)
+		return err
+	}
+
+	auth, err := GenerateAuthObj(c.MainChainClient, stakeManagerAddress, data)
+	if err != nil {
+		Logger.Error("Unable to create auth object", "error", err)
+		return err
+	}
+
+	tx, err := stakeManagerInstance.UpdateSigner(auth, validatorID, signerPubkey)
+	if err != nil {
+		Logger.Error("Error while submitting signer update", "error", err)
+		return err
+	}
+
+	Logger.Info("Submitted signer update sucessfully", "txHash", tx.Hash().String())
+	return nil
+}
+
 // ApproveTokens approves matic token for stake
 func (c *ContractCaller) ApproveTokens(amount *big.Int, stakeManager common.Address, tokenAddress common.Address, maticTokenInstance *erc20.Erc20) error {
 	data, err := c.MaticTokenABI.Pack("approve", stakeManager, amount)
@@ -179,7 +204,7 @@ func (c *ContractCaller) ApproveTokens(amount *big.Int, stakeManager common.Addr
 		return err
 	}
 
-	auth, err := GenerateAuthObj(GetMainClient(), tokenAddress, data)
+	auth, err := GenerateAuthObj(c.MainChainClient, tokenAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
 		return err
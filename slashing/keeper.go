@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -238,9 +239,10 @@ func (k *Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashP
 		k.Logger(ctx).Error("Interim slashing the validator. Validator not found", "valID", valID)
 		return uint64(0)
 	}
-	valPower := validator.VotingPower
+	// slash amounts are in whole tokens of stake, not scaled voting power
+	valPower := hmTypes.StakeToTokens(validator.GetStake())
 
-	slashAmountDec := sdk.NewDec(valPower).Mul(slashPercent)
+	slashAmountDec := sdk.NewDecFromBigInt(valPower).Mul(slashPercent)
 	slashAmountInt := slashAmountDec.TruncateInt().Int64()
 
 	k.Logger(ctx).Info("Interim slashing the validator", "valID", valID, "valPower", valPower, "slashPercent", slashPercent, "slashAmountDec", slashAmountDec, "slashAmountInt", slashAmountInt)
@@ -305,9 +307,10 @@ func (k *Keeper) GetTotalSlashedAmount(ctx sdk.Context) uint64 {
 func (k *Keeper) IsSlashedLimitExceeded(ctx sdk.Context) bool {
 	params := k.GetParams(ctx)
 	slashedAmount := k.GetTotalSlashedAmount(ctx)
-	totalPower := k.sk.GetTotalPower(ctx)
+	// total power in whole tokens of stake, as slashed amount
+	totalPower := new(big.Int).Lsh(big.NewInt(k.sk.GetTotalPower(ctx)), uint(k.sk.GetPowerShift(ctx)))
 
-	slashLimitDec := sdk.NewDec(totalPower).Mul(params.SlashFractionLimit)
+	slashLimitDec := sdk.NewDecFromBigInt(totalPower).Mul(params.SlashFractionLimit)
 	slashLimit := slashLimitDec.TruncateInt().Int64()
 
 	k.Logger(ctx).Info("checking if slash-limit exceeded", "totalPower", totalPower, "totalSlashedAmount", slashedAmount, "slashlimit", slashLimit)
@@ -327,7 +330,7 @@ func (k *Keeper) IsJailLimitExceeded(ctx sdk.Context, valSlashingInfo hmTypes.Va
 	slashedAmount := valSlashingInfo.SlashedAmount
 	val, _ := k.sk.GetValidatorFromValID(ctx, valID)
 
-	jailLimitDec := sdk.NewDec(val.VotingPower).Mul(params.JailFractionLimit)
+	jailLimitDec := sdk.NewDecFromBigInt(hmTypes.StakeToTokens(val.GetStake())).Mul(params.JailFractionLimit)
 	jailLimit := jailLimitDec.TruncateInt().Int64()
 
 	k.Logger(ctx).Info("Checking if jail limit is exceeded", "valId", valID, "power", val.VotingPower, "slashedAmount", slashedAmount, "jailLimit", jailLimit, "jailLimitDec", jailLimitDec)
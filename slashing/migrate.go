@@ -0,0 +1,60 @@
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// genesisStateV02 is the slashing genesis as exported by v0.2, with signing infos keyed by
+// signer address or validator id and unordered missed blocks
+type genesisStateV02 struct {
+	Params                types.Params                            `json:"params"`
+	SigningInfos          map[string]hmTypes.ValidatorSigningInfo `json:"signing_infos"`
+	MissedBlocks          map[string][]types.MissedBlock          `json:"missed_blocks"`
+	BufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo        `json:"buffer_val_slash_info"`
+	TickValSlashingInfo   []*hmTypes.ValidatorSlashingInfo        `json:"tick_val_slash_info"`
+	TickCount             uint64                                  `json:"tick_count"`
+}
+
+// MigrateGenesis migrates slashing genesis from v0.2 to v0.3. Signing infos are keyed by
+// validator id and missed blocks are sorted by index.
+func MigrateGenesis(appState map[string]json.RawMessage) (json.RawMessage, error) {
+	var oldState genesisStateV02
+	if err := types.ModuleCdc.UnmarshalJSON(appState[types.ModuleName], &oldState); err != nil {
+		return nil, err
+	}
+
+	signingInfos := make(map[string]hmTypes.ValidatorSigningInfo, len(oldState.SigningInfos))
+	for _, info := range oldState.SigningInfos {
+		signingInfos[info.ValID.String()] = info
+	}
+
+	missedBlocks := make(map[string][]types.MissedBlock, len(oldState.MissedBlocks))
+	for valIDStr, array := range oldState.MissedBlocks {
+		if _, err := strconv.ParseUint(valIDStr, 10, 64); err != nil {
+			return nil, fmt.Errorf("missed blocks are not keyed by validator id: %s", valIDStr)
+		}
+
+		missed := append([]types.MissedBlock{}, array...)
+		sort.Slice(missed, func(i, j int) bool {
+			return missed[i].Index < missed[j].Index
+		})
+		missedBlocks[valIDStr] = missed
+	}
+
+	newState := types.NewGenesisState(
+		oldState.Params,
+		signingInfos,
+		missedBlocks,
+		oldState.BufferValSlashingInfo,
+		oldState.TickValSlashingInfo,
+		oldState.TickCount,
+	)
+
+	return types.ModuleCdc.MarshalJSON(newState)
+}
@@ -19,4 +19,18 @@ const (
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
+	FlagFromEpoch  = "from-epoch"
+	FlagToEpoch    = "to-epoch"
+	FlagEpochs     = "epochs"
+
+	FlagMoniker         = "moniker"
+	FlagWebsite         = "website"
+	FlagSecurityContact = "security-contact"
+	FlagDetails         = "details"
+
+	FlagFromBlock = "from-block"
+	FlagToBlock   = "to-block"
 )
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -10,7 +11,9 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -31,6 +34,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetValidatorHistory(cdc),
+			GetParams(cdc),
+			GetValidatorSetPreview(cdc),
+			GetProposerSchedule(cdc),
+			GetNonceGaps(cdc),
 		)...,
 	)
 
@@ -104,3 +112,160 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetValidatorHistory validator history via id, optionally in height and epoch range
+func GetValidatorHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-history",
+		Short: "show validator power, signer and status over time via validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("validator ID required")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorHistoryParams(
+				hmTypes.ValidatorID(validatorID),
+				viper.GetInt64(FlagFromHeight),
+				viper.GetInt64(FlagToHeight),
+				viper.GetUint64(FlagFromEpoch),
+				viper.GetUint64(FlagToEpoch),
+			))
+			if err != nil {
+				return err
+			}
+
+			// get validator history
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorHistory), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().Int64(FlagFromHeight, 0, "--from-height=<heimdall height here>")
+	cmd.Flags().Int64(FlagToHeight, 0, "--to-height=<heimdall height here, 0 for latest>")
+	cmd.Flags().Uint64(FlagFromEpoch, 0, "--from-epoch=<checkpoint epoch here>")
+	cmd.Flags().Uint64(FlagToEpoch, 0, "--to-epoch=<checkpoint epoch here, 0 for latest>")
+	return cmd
+}
+
+// GetParams staking params
+func GetParams(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "show staking params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetValidatorSetPreview simulated validator set changes of next epochs
+func GetValidatorSetPreview(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-preview",
+		Short: "show validators entering or exiting, total power and proposer order of next epochs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetPreviewParams(viper.GetUint64(FlagEpochs)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetPreview), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagEpochs, types.DefaultPreviewEpochs, "--epochs=<number of epochs to preview>")
+	return cmd
+}
+
+// GetProposerSchedule projected checkpoint proposer schedule of next epochs
+func GetProposerSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-schedule",
+		Short: "show expected checkpoint proposers and share of proposals of validators over next epochs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerScheduleParams(viper.GetUint64(FlagEpochs)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerSchedule), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagEpochs, types.DefaultScheduleEpochs, "--epochs=<number of epochs to schedule>")
+	return cmd
+}
+
+// GetNonceGaps staking events on rootchain missing in heimdall
+func GetNonceGaps(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce-gaps",
+		Short: "show validator nonces behind StakingInfo events on rootchain, with missing staking events in rootchain block range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contractCaller, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			gaps, err := util.GetNonceGaps(
+				cliCtx,
+				&contractCaller,
+				viper.GetUint64(FlagValidatorID),
+				viper.GetUint64(FlagFromBlock),
+				viper.GetUint64(FlagToBlock),
+			)
+			if err != nil {
+				return err
+			}
+
+			res, err := json.Marshal(gaps)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here, 0 for all validators>")
+	cmd.Flags().Uint64(FlagFromBlock, 0, "--from-block=<rootchain block to scan from>")
+	cmd.Flags().Uint64(FlagToBlock, 0, "--to-block=<rootchain block to scan to, 0 for latest>")
+	return cmd
+}
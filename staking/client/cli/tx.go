@@ -40,6 +40,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			SendValidatorDescriptionTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -390,3 +391,49 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendValidatorDescriptionTx sends validator description transaction, signed by validator signer
+func SendValidatorDescriptionTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-description",
+		Short: "Set moniker, website, security contact and details of validator, charged the default tx fee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetUint64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			// draft msg
+			msg := types.NewMsgValidatorDescription(
+				helper.GetFromAddress(cliCtx),
+				validator,
+				types.NewDescription(
+					viper.GetString(FlagMoniker),
+					viper.GetString(FlagWebsite),
+					viper.GetString(FlagSecurityContact),
+					viper.GetString(FlagDetails),
+				),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().String(FlagMoniker, "", "--moniker=<validator name>")
+	cmd.Flags().String(FlagWebsite, "", "--website=<validator website>")
+	cmd.Flags().String(FlagSecurityContact, "", "--security-contact=<security contact email>")
+	cmd.Flags().String(FlagDetails, "", "--details=<validator details>")
+
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error("SendValidatorDescriptionTx | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+	if err := cmd.MarkFlagRequired(FlagMoniker); err != nil {
+		logger.Error("SendValidatorDescriptionTx | MarkFlagRequired | FlagMoniker", "Error", err)
+	}
+
+	return cmd
+}
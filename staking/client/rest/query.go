@@ -28,6 +28,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator-status/{address}",
 		validatorStatusByAddreesHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-exit-status/{address}",
+		validatorExitStatusByAddressHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
@@ -52,6 +56,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/isoldtx",
 		StakingTxStatusHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-history/{id}",
+		validatorHistoryHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set-preview",
+		validatorSetPreviewHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-nonces",
+		validatorNoncesHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/proposer-schedule",
+		proposerScheduleHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // Returns total power of current validator set
@@ -178,6 +202,42 @@ func validatorStatusByAddreesHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+// Returns validator status along with its exit schedule by signer address
+func validatorExitStatusByAddressHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		signerAddress := common.HexToAddress(vars["address"])
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySignerParams(signerAddress.Bytes()))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		statusBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorExitStatus), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator exit status", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no validator found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, statusBytes, "No validator found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, statusBytes)
+	}
+}
+
 // Returns validator information by val ID
 func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -398,3 +458,179 @@ func StakingTxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// Returns history of validator by id, optionally in height and epoch range
+func validatorHistoryHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		query := r.URL.Query()
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get height range, zero upper bound is open
+		var fromHeight, toHeight int64
+		if v := query.Get("from_height"); v != "" {
+			if fromHeight, ok = rest.ParseInt64OrReturnBadRequest(w, v); !ok {
+				return
+			}
+		}
+		if v := query.Get("to_height"); v != "" {
+			if toHeight, ok = rest.ParseInt64OrReturnBadRequest(w, v); !ok {
+				return
+			}
+		}
+
+		// get epoch range, zero upper bound is open
+		var fromEpoch, toEpoch uint64
+		if v := query.Get("from_epoch"); v != "" {
+			if fromEpoch, ok = rest.ParseUint64OrReturnBadRequest(w, v); !ok {
+				return
+			}
+		}
+		if v := query.Get("to_epoch"); v != "" {
+			if toEpoch, ok = rest.ParseUint64OrReturnBadRequest(w, v); !ok {
+				return
+			}
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorHistoryParams(hmTypes.ValidatorID(id), fromHeight, toHeight, fromEpoch, toEpoch))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorHistory), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator history", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns staking params
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns simulated validator set changes of next epochs
+func validatorSetPreviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get epochs, defaults to DefaultPreviewEpochs
+		epochs := types.DefaultPreviewEpochs
+		if epochsStr := r.URL.Query().Get("epochs"); epochsStr != "" {
+			if epochs, ok = rest.ParseUint64OrReturnBadRequest(w, epochsStr); !ok {
+				return
+			}
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetPreviewParams(epochs))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetPreview), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator set preview", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns projected checkpoint proposer schedule and proposal shares of next epochs
+func proposerScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get epochs, defaults to DefaultScheduleEpochs
+		epochs := types.DefaultScheduleEpochs
+		if epochsStr := r.URL.Query().Get("epochs"); epochsStr != "" {
+			if epochs, ok = rest.ParseUint64OrReturnBadRequest(w, epochsStr); !ok {
+				return
+			}
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerScheduleParams(epochs))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerSchedule), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching proposer schedule", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns heimdall nonces of all validators
+func validatorNoncesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorNonces), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator nonces", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -21,6 +21,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/staking/validators/stake", newValidatorStakeUpdateHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc("/staking/validators", newValidatorUpdateHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc("/staking/validators", newValidatorExitHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc("/staking/validators/description", newValidatorDescriptionHandler(cliCtx)).Methods("PUT")
 }
 
 type (
@@ -73,6 +74,17 @@ type (
 		BlockNumber       uint64 `json:"block_number" yaml:"block_number"`
 		Nonce             uint64 `json:"nonce"`
 	}
+
+	// ValidatorDescriptionReq validator description request object, from must be validator signer
+	ValidatorDescriptionReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		ID              uint64 `json:"ID"`
+		Moniker         string `json:"moniker"`
+		Website         string `json:"website"`
+		SecurityContact string `json:"security_contact"`
+		Details         string `json:"details"`
+	}
 )
 
 func newValidatorJoinHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -202,3 +214,28 @@ func newValidatorStakeUpdateHandler(cliCtx context.CLIContext) http.HandlerFunc
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func newValidatorDescriptionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req ValidatorDescriptionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft new msg
+		msg := types.NewMsgValidatorDescription(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ID,
+			types.NewDescription(req.Moniker, req.Website, req.SecurityContact, req.Details),
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
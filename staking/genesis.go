@@ -8,6 +8,10 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetPowerShift(ctx, data.PowerShift)
+	keeper.EnableDescription(ctx)
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(data.CurrentValSet.Validators) == 0 {
@@ -41,14 +45,35 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+
+	for _, d := range data.Descriptions {
+		keeper.SetValidatorDescription(ctx, d.ValidatorID, d.Description)
+	}
+
+	for _, exit := range data.Exits {
+		keeper.SetValidatorExit(ctx, exit)
+	}
+
+	for _, h := range data.History {
+		keeper.SetValidatorHistoryRecord(ctx, h.Record, h.Sequence)
+	}
+	if data.HistoryCount != 0 {
+		keeper.SetValidatorHistoryCount(ctx, data.HistoryCount)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
 	return types.NewGenesisState(
+		keeper.GetParams(ctx),
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
+		keeper.GetAllValidatorDescriptions(ctx),
+		keeper.GetPowerShift(ctx),
+		keeper.GetAllValidatorExits(ctx),
+		keeper.GetAllValidatorHistory(ctx),
+		keeper.GetValidatorHistoryCount(ctx),
 	)
 }
@@ -65,10 +65,22 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	// validator set
 	validatorSet := hmTypes.NewValidatorSet(validators)
 
-	genesisState := types.NewGenesisState(validators, *validatorSet, stakingSequence)
+	descriptions := []types.ValidatorDescription{
+		{ValidatorID: validators[1].ID, Description: types.NewDescription("validator-1", "https://example.com", "security@example.com", "")},
+	}
+
+	history := []types.ValidatorHistoryWithSequence{
+		types.NewValidatorHistoryWithSequence(0, types.NewValidatorHistoryRecord(*validators[1], 1, 0, types.HistoryEventJoin)),
+		types.NewValidatorHistoryWithSequence(1, types.NewValidatorHistoryRecord(*validators[1], 2, 0, types.HistoryEventStakeUpdate)),
+	}
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), validators, *validatorSet, stakingSequence, descriptions, 0, nil, history, 3)
 	staking.InitGenesis(ctx, app.StakingKeeper, genesisState)
 
 	actualParams := staking.ExportGenesis(ctx, app.StakingKeeper)
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, 5, len(actualParams.Validators))
+	require.Equal(t, descriptions, actualParams.Descriptions)
+	require.Equal(t, history, actualParams.History)
+	require.Equal(t, uint64(3), actualParams.HistoryCount)
 }
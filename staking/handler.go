@@ -28,6 +28,8 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 			return HandleMsgSignerUpdate(ctx, msg, k, contractCaller)
 		case types.MsgStakeUpdate:
 			return HandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+		case types.MsgValidatorDescription:
+			return HandleMsgValidatorDescription(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in staking module").Result()
 		}
@@ -269,3 +271,41 @@ func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keepe
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// HandleMsgValidatorDescription msg validator description
+func HandleMsgValidatorDescription(ctx sdk.Context, msg types.MsgValidatorDescription, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("✅ Handling validator description msg", "validatorId", msg.ID, "moniker", msg.Description.Moniker)
+
+	if !k.IsDescriptionEnabled(ctx) {
+		k.Logger(ctx).Error("Validator descriptions are not enabled")
+		return hmCommon.ErrDescriptionDisabled(k.Codespace()).Result()
+	}
+
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// only validator signer can set description
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Description not signed by validator signer", "validatorId", msg.ID, "signer", validator.Signer.String(), "from", msg.From.String())
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	k.SetValidatorDescription(ctx, msg.ID, msg.Description)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorDescription,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(msg.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyMoniker, msg.Description.Moniker),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
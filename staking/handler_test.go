@@ -3,6 +3,7 @@ package staking_test
 import (
 	"math/big"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -367,3 +368,36 @@ func (suite *HandlerTestSuite) TestTopupSuccessBeforeValidatorJoin() {
 	require.True(t, result.IsOK(), "expected validator stake update to be ok, got %v", result)
 
 }
+
+func (suite *HandlerTestSuite) TestHandleMsgValidatorDescription() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+
+	description := types.NewDescription("validator-0", "https://example.com", "security@example.com", "test validator")
+
+	// only validator signer can set description
+	msg := types.NewMsgValidatorDescription(validators[1].Signer, validators[0].ID.Uint64(), description)
+	got := suite.handler(ctx, msg)
+	require.False(t, got.IsOK(), "expected description by other signer to fail")
+	require.Equal(t, errs.CodeValSignerMismatch, got.Code)
+
+	msg = types.NewMsgValidatorDescription(validators[0].Signer, validators[0].ID.Uint64(), description)
+	got = suite.handler(ctx, msg)
+	require.True(t, got.IsOK(), "expected validator description to be ok, got %v", got)
+
+	stored, ok := keeper.GetValidatorDescription(ctx, validators[0].ID)
+	require.True(t, ok)
+	require.Equal(t, description, stored)
+
+	// unknown validator
+	msg = types.NewMsgValidatorDescription(validators[0].Signer, 100, description)
+	got = suite.handler(ctx, msg)
+	require.Equal(t, errs.CodeNoValidator, got.Code)
+
+	// length limits
+	description.Details = strings.Repeat("a", types.MaxDetailsLength+1)
+	require.Error(t, types.NewMsgValidatorDescription(validators[0].Signer, validators[0].ID.Uint64(), description).ValidateBasic())
+	require.Error(t, types.NewMsgValidatorDescription(validators[0].Signer, validators[0].ID.Uint64(), types.Description{}).ValidateBasic())
+}
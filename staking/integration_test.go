@@ -18,9 +18,15 @@ import (
 func createTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, context.CLIContext) {
 	genesisState := app.NewDefaultGenesisState()
 	stakingGenesis := stakingTypes.NewGenesisState(
+		stakingTypes.DefaultGenesisState().Params,
 		stakingTypes.DefaultGenesisState().Validators,
 		stakingTypes.DefaultGenesisState().CurrentValSet,
-		stakingTypes.DefaultGenesisState().StakingSequences)
+		stakingTypes.DefaultGenesisState().StakingSequences,
+		stakingTypes.DefaultGenesisState().Descriptions,
+		stakingTypes.DefaultGenesisState().PowerShift,
+		stakingTypes.DefaultGenesisState().Exits,
+		stakingTypes.DefaultGenesisState().History,
+		stakingTypes.DefaultGenesisState().HistoryCount)
 
 	app := app.Setup(isCheckTx)
 	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
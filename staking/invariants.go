@@ -0,0 +1,96 @@
+package staking
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "signer-mapping", SignerMappingInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "validator-set", ValidatorSetInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SignerMappingInvariant(keeper)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ValidatorSetInvariant(keeper)(ctx)
+	}
+}
+
+// SignerMappingInvariant checks that every validator maps back to its signer
+// through its validator id. Signers replaced by signer update keep their record
+// without power, and their id maps to the new signer.
+func SignerMappingInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		for _, validator := range keeper.GetAllValidators(ctx) {
+			signer, ok := keeper.GetSignerFromValidatorID(ctx, validator.ID)
+			if ok && validator.Signer.EthAddress() == signer {
+				continue
+			}
+
+			// retired signer, id has to map to a stored validator
+			if ok && validator.VotingPower == 0 && validator.EndEpoch != 0 {
+				if _, err := keeper.GetValidatorInfo(ctx, signer.Bytes()); err == nil {
+					continue
+				}
+			}
+
+			broken++
+			msg += fmt.Sprintf("\tvalidator %v with signer %v maps to signer %v\n", validator.ID, validator.Signer, signer.Hex())
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "signer mapping",
+			fmt.Sprintf("%d validators do not map back to their signer\n%s", broken, msg)), broken != 0
+	}
+}
+
+// ValidatorSetInvariant checks that the stored validator set holds exactly the
+// current validators with their voting power
+func ValidatorSetInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		ackCount := keeper.moduleCommunicator.GetACKCount(ctx)
+		validatorSet := keeper.GetValidatorSet(ctx)
+
+		for _, validator := range keeper.GetAllValidators(ctx) {
+			_, setValidator := validatorSet.GetByAddress(validator.Signer.Bytes())
+			isCurrent := validator.IsCurrentValidator(ackCount)
+
+			switch {
+			case isCurrent && setValidator == nil:
+				broken++
+				msg += fmt.Sprintf("\tcurrent validator %v with signer %v is missing in validator set\n", validator.ID, validator.Signer)
+			case !isCurrent && setValidator != nil:
+				broken++
+				msg += fmt.Sprintf("\tvalidator %v with signer %v is in validator set but not current\n", validator.ID, validator.Signer)
+			case setValidator != nil && setValidator.VotingPower != validator.VotingPower:
+				broken++
+				msg += fmt.Sprintf("\tvalidator %v has power %d in validator set, expected %d\n", validator.ID, setValidator.VotingPower, validator.VotingPower)
+			}
+		}
+
+		for _, setValidator := range validatorSet.Validators {
+			if _, err := keeper.GetValidatorInfo(ctx, setValidator.Signer.Bytes()); err != nil {
+				broken++
+				msg += fmt.Sprintf("\tvalidator set signer %v is not a validator\n", setValidator.Signer)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator set",
+			fmt.Sprintf("%d validators do not match validator set\n%s", broken, msg)), broken != 0
+	}
+}
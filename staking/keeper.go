@@ -1,8 +1,11 @@
 package staking
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"math/big"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +26,18 @@ var (
 	ValidatorMapKey        = []byte{0x22} // prefix for each key for validator map
 	CurrentValidatorSetKey = []byte{0x23} // Key to store current validator set
 	StakingSequenceKey     = []byte{0x24} // prefix for each key for staking sequence map
+
+	ValidatorHistoryKey       = []byte{0x25} // prefix for each key to a validator history record
+	ValidatorHistoryHeightKey = []byte{0x26} // prefix for each key to a validator history record by height, used for pruning
+	ValidatorHistoryCountKey  = []byte{0x27} // key to store number of validator history records ever written
+
+	ValidatorDescriptionKey = []byte{0x28} // prefix for each key to a validator description
+	DescriptionEnabledKey   = []byte{0x2C} // key to mark validator descriptions are accepted
+
+	PowerShiftKey = []byte{0x29} // key to store power shift scaling stake to voting power
+
+	ValidatorExitKey      = []byte{0x2A} // prefix for each key to a validator exit record
+	ValidatorExitQueueKey = []byte{0x2B} // prefix for each key to a validator exit by epoch of its next status change
 )
 
 // ModuleCommunicator manages different module interaction
@@ -32,6 +47,7 @@ type ModuleCommunicator interface {
 	GetCoins(ctx sdk.Context, addr hmTypes.HeimdallAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, from hmTypes.HeimdallAddress, to hmTypes.HeimdallAddress, amt sdk.Coins) sdk.Error
 	CreateValiatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, valSigningInfo hmTypes.ValidatorSigningInfo)
+	GetCheckpointAckedHeight(ctx sdk.Context, number uint64) (int64, bool)
 }
 
 // Keeper stores all related data
@@ -94,6 +110,36 @@ func GetStakingSequenceKey(sequence string) []byte {
 	return append(StakingSequenceKey, []byte(sequence)...)
 }
 
+// GetValidatorHistoryPrefix returns prefix of history records of validator
+func GetValidatorHistoryPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorHistoryKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorHistoryKey returns key of history record of validator, records are ordered by height and sequence
+func GetValidatorHistoryKey(valID hmTypes.ValidatorID, height int64, sequence uint64) []byte {
+	return append(append(GetValidatorHistoryPrefix(valID), sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetValidatorHistoryHeightKey returns key of history record by height
+func GetValidatorHistoryHeightKey(height int64, sequence uint64) []byte {
+	return append(append(ValidatorHistoryHeightKey, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetValidatorDescriptionKey returns key of description of validator
+func GetValidatorDescriptionKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorDescriptionKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorExitKey returns key of exit record of validator
+func GetValidatorExitKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorExitKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorExitQueueKey returns key of exit of validator changing status at epoch
+func GetValidatorExitQueueKey(epoch uint64, valID hmTypes.ValidatorID) []byte {
+	return append(append(ValidatorExitQueueKey, sdk.Uint64ToBigEndian(epoch)...), sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
 // AddValidator adds validator indexed with address
 func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) error {
 	// TODO uncomment
@@ -440,6 +486,648 @@ func (k *Keeper) IterateStakingSequencesAndApplyFn(ctx sdk.Context, f func(seque
 	}
 }
 
+//
+// Validator history
+//
+
+// IsValidatorHistoryTracked returns true once validator history is written, which starts with the
+// history params set at genesis or by validator history upgrade
+func (k *Keeper) IsValidatorHistoryTracked(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyHistoryRetention)
+}
+
+// AddValidatorHistory appends state of validator after a change to validator history.
+// Nothing is written until validator history is tracked.
+func (k *Keeper) AddValidatorHistory(ctx sdk.Context, validator hmTypes.Validator, event string) {
+	if !k.IsValidatorHistoryTracked(ctx) {
+		return
+	}
+
+	// sequence keeps records at same height in order
+	sequence := k.GetValidatorHistoryCount(ctx)
+	k.SetValidatorHistoryCount(ctx, sequence+1)
+
+	record := types.NewValidatorHistoryRecord(validator, ctx.BlockHeight(), k.moduleCommunicator.GetACKCount(ctx), event)
+	k.SetValidatorHistoryRecord(ctx, record, sequence)
+}
+
+// SetValidatorHistoryRecord sets history record with sequence
+func (k *Keeper) SetValidatorHistoryRecord(ctx sdk.Context, record types.ValidatorHistoryRecord, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorHistoryKey(record.ID, record.Height, sequence)
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(record))
+	store.Set(GetValidatorHistoryHeightKey(record.Height, sequence), key)
+}
+
+// GetValidatorHistoryCount returns number of validator history records ever written
+func (k *Keeper) GetValidatorHistoryCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(ValidatorHistoryCountKey) {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(store.Get(ValidatorHistoryCountKey))
+}
+
+// SetValidatorHistoryCount sets number of validator history records ever written
+func (k *Keeper) SetValidatorHistoryCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(ValidatorHistoryCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// GetAllValidatorHistory returns all validator history records with their sequence, ordered by height
+func (k *Keeper) GetAllValidatorHistory(ctx sdk.Context) (history []types.ValidatorHistoryWithSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorHistoryHeightKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// height key ends with sequence of record
+		sequence := binary.BigEndian.Uint64(iterator.Key()[len(iterator.Key())-8:])
+
+		var record types.ValidatorHistoryRecord
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iterator.Value()), &record)
+		history = append(history, types.NewValidatorHistoryWithSequence(sequence, record))
+	}
+
+	return history
+}
+
+// GetValidatorHistory returns history records of validator in height and epoch range, zero upper bounds are open
+func (k *Keeper) GetValidatorHistory(ctx sdk.Context, valID hmTypes.ValidatorID, fromHeight int64, toHeight int64, fromEpoch uint64, toEpoch uint64) (records []types.ValidatorHistoryRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	// records are ordered by height
+	start := GetValidatorHistoryKey(valID, fromHeight, 0)
+	end := sdk.PrefixEndBytes(GetValidatorHistoryPrefix(valID))
+	if toHeight != 0 {
+		end = GetValidatorHistoryKey(valID, toHeight+1, 0)
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	records = []types.ValidatorHistoryRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorHistoryRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if record.InRange(fromHeight, toHeight, fromEpoch, toEpoch) {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// PruneValidatorHistory removes history records older than history retention, at most history prune limit per call
+func (k *Keeper) PruneValidatorHistory(ctx sdk.Context) (pruned uint64) {
+	// history params are not set until validator history upgrade, later params may
+	// still be missing so history params are read on their own
+	if !k.paramSpace.Has(ctx, types.KeyHistoryRetention) {
+		return 0
+	}
+
+	var params types.Params
+	k.paramSpace.Get(ctx, types.KeyHistoryRetention, &params.HistoryRetention)
+	k.paramSpace.Get(ctx, types.KeyHistoryPruneLimit, &params.HistoryPruneLimit)
+	if params.HistoryRetention == 0 || uint64(ctx.BlockHeight()) <= params.HistoryRetention {
+		return 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := uint64(ctx.BlockHeight()) - params.HistoryRetention
+	iterator := store.Iterator(ValidatorHistoryHeightKey, GetValidatorHistoryHeightKey(int64(cutoff), 0))
+
+	// collect keys first, store must not be written while iterating
+	var keys [][]byte
+	for ; iterator.Valid() && pruned < params.HistoryPruneLimit; iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...), append([]byte{}, iterator.Value()...))
+		pruned++
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if pruned > 0 {
+		k.Logger(ctx).Debug("Pruned validator history", "records", pruned, "cutoffHeight", cutoff)
+	}
+
+	return pruned
+}
+
+//
+// Params
+//
+
+// SetParams sets the staking module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the staking module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// history params are not set until validator history upgrade,
+	// withdrawal delay until validator exit status upgrade
+	params = types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyHistoryRetention, &params.HistoryRetention)
+	k.paramSpace.GetIfExists(ctx, types.KeyHistoryPruneLimit, &params.HistoryPruneLimit)
+	params.WithdrawalDelay = k.GetWithdrawalDelay(ctx)
+	return
+}
+
+//
+// Validator description
+//
+
+// IsDescriptionEnabled returns true once validator descriptions are accepted, which starts at
+// genesis or with validator description upgrade
+func (k *Keeper) IsDescriptionEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(DescriptionEnabledKey)
+}
+
+// EnableDescription starts accepting validator descriptions
+func (k *Keeper) EnableDescription(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(DescriptionEnabledKey, DefaultValue)
+}
+
+// SetValidatorDescription sets description of validator
+func (k *Keeper) SetValidatorDescription(ctx sdk.Context, valID hmTypes.ValidatorID, description types.Description) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorDescriptionKey(valID), k.cdc.MustMarshalBinaryBare(description))
+}
+
+// GetValidatorDescription returns description of validator, if set
+func (k *Keeper) GetValidatorDescription(ctx sdk.Context, valID hmTypes.ValidatorID) (description types.Description, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorDescriptionKey(valID))
+	if bz == nil {
+		return description, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &description)
+	return description, true
+}
+
+// GetAllValidatorDescriptions returns descriptions of all validators
+func (k *Keeper) GetAllValidatorDescriptions(ctx sdk.Context) (descriptions []types.ValidatorDescription) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorDescriptionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var description types.Description
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &description)
+
+		valID := hmTypes.ValidatorID(binary.BigEndian.Uint64(iterator.Key()[len(ValidatorDescriptionKey):]))
+		descriptions = append(descriptions, types.ValidatorDescription{ValidatorID: valID, Description: description})
+	}
+
+	return descriptions
+}
+
+// WithDescription returns validator along with its description
+func (k *Keeper) WithDescription(ctx sdk.Context, validator hmTypes.Validator) *types.ValidatorWithDescription {
+	result := &types.ValidatorWithDescription{Validator: validator}
+	if description, ok := k.GetValidatorDescription(ctx, validator.ID); ok {
+		result.Description = &description
+	}
+
+	return result
+}
+
+//
+// Voting power scaling
+//
+
+// GetPowerShift returns power shift scaling stake of validators to voting power
+func (k *Keeper) GetPowerShift(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PowerShiftKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPowerShift sets power shift scaling stake of validators to voting power
+func (k *Keeper) SetPowerShift(ctx sdk.Context, shift uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PowerShiftKey, sdk.Uint64ToBigEndian(shift))
+}
+
+// IsPowerScalingEnabled returns true once voting power is scaled from stake, which starts with the
+// power shift set at genesis or by power scaling upgrade
+func (k *Keeper) IsPowerScalingEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(PowerShiftKey)
+}
+
+// SetValidatorStake sets stake of validator and its voting power at power shift. Until power scaling
+// is enabled stake is not stored and voting power is stake in whole tokens.
+func (k *Keeper) SetValidatorStake(ctx sdk.Context, validator *hmTypes.Validator, stake *big.Int) {
+	if !k.IsPowerScalingEnabled(ctx) {
+		validator.VotingPower = hmTypes.StakeToTokens(stake).Int64()
+		return
+	}
+
+	validator.SetStake(stake, k.GetPowerShift(ctx))
+}
+
+// UpdatePowerShift raises power shift when total stake of validators outgrows MaxTotalVotingPower at current shift,
+// and rescales voting power of validators from their stake. Returns true if validators were rescaled.
+// Power shift never decreases, so voting power of a validator only changes with its own stake or on a rescale.
+// Nothing is rescaled until power scaling is enabled.
+func (k *Keeper) UpdatePowerShift(ctx sdk.Context) bool {
+	if !k.IsPowerScalingEnabled(ctx) {
+		return false
+	}
+
+	// validators without power, like previous signers, are not part of any set. Staked
+	// validators keep minimum power of 1 on rescale, so they never lose power here.
+	totalStake := big.NewInt(0)
+	staked := 0
+	validators := k.GetAllValidators(ctx)
+	for _, validator := range validators {
+		if validator.VotingPower > 0 {
+			totalStake.Add(totalStake, validator.GetStake())
+			staked++
+		}
+	}
+
+	shift := k.GetPowerShift(ctx)
+	requiredShift := hmTypes.RequiredPowerShift(totalStake, staked)
+	if requiredShift <= shift {
+		return false
+	}
+
+	k.Logger(ctx).Info("Rescaling voting power of validators", "totalStake", totalStake, "prevShift", shift, "shift", requiredShift)
+	k.SetPowerShift(ctx, requiredShift)
+
+	// validator set picks up rescaled power on next validator set update
+	for _, validator := range validators {
+		if validator.VotingPower == 0 {
+			continue
+		}
+
+		validator.SetStake(validator.GetStake(), requiredShift)
+		if err := k.AddValidator(ctx, *validator); err != nil {
+			k.Logger(ctx).Error("Unable to rescale voting power of validator", "error", err, "validatorId", validator.ID)
+		}
+	}
+
+	return true
+}
+
+//
+// Validator exit
+//
+
+// IsValidatorExitTracked returns true once exits are tracked, which starts with the withdrawal
+// delay param set at genesis or by validator exit status upgrade
+func (k *Keeper) IsValidatorExitTracked(ctx sdk.Context) bool {
+	return k.paramSpace.Has(ctx, types.KeyWithdrawalDelay)
+}
+
+// SetValidatorExit sets exit record of validator and queues it at epoch of its next status change
+func (k *Keeper) SetValidatorExit(ctx sdk.Context, exit types.ValidatorExit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorExitKey(exit.ID), k.cdc.MustMarshalBinaryBare(exit))
+
+	if epoch := exit.NextTransitionEpoch(k.GetWithdrawalDelay(ctx)); epoch != 0 {
+		store.Set(GetValidatorExitQueueKey(epoch, exit.ID), DefaultValue)
+	}
+}
+
+// GetValidatorExit returns exit record of validator, if exit was requested
+func (k *Keeper) GetValidatorExit(ctx sdk.Context, valID hmTypes.ValidatorID) (exit types.ValidatorExit, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorExitKey(valID))
+	if bz == nil {
+		return exit, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &exit)
+	return exit, true
+}
+
+// GetAllValidatorExits returns exit records of all validators
+func (k *Keeper) GetAllValidatorExits(ctx sdk.Context) (exits []types.ValidatorExit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorExitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exit types.ValidatorExit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &exit)
+		exits = append(exits, exit)
+	}
+
+	return exits
+}
+
+// GetWithdrawalDelay returns withdrawal delay of stake manager, default until it is set by validator exit status upgrade
+func (k *Keeper) GetWithdrawalDelay(ctx sdk.Context) uint64 {
+	withdrawalDelay := types.DefaultWithdrawalDelay
+	k.paramSpace.GetIfExists(ctx, types.KeyWithdrawalDelay, &withdrawalDelay)
+	return withdrawalDelay
+}
+
+// UpdateValidatorExits moves exit records queued up to current epoch to their status at current ack count
+// and returns the updated ones. Unbonded exits are not queued anymore. Validator set is updated before,
+// so exited validators have left it at this height. Nothing is updated until exits are tracked.
+func (k *Keeper) UpdateValidatorExits(ctx sdk.Context) (updated []types.ValidatorExit) {
+	if !k.IsValidatorExitTracked(ctx) {
+		return nil
+	}
+
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	withdrawalDelay := k.GetWithdrawalDelay(ctx)
+
+	// current epoch will be ack count + 1
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(ValidatorExitQueueKey, GetValidatorExitQueueKey(ackCount+2, 0))
+
+	// collect keys first, store must not be written while iterating
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		valID := hmTypes.ValidatorID(binary.BigEndian.Uint64(key[len(ValidatorExitQueueKey)+8:]))
+		exit, ok := k.GetValidatorExit(ctx, valID)
+		if !ok {
+			continue
+		}
+
+		status := exit.NextStatus(ackCount, withdrawalDelay)
+		if status == exit.Status {
+			// withdrawal delay changed since exit was queued
+			k.SetValidatorExit(ctx, exit)
+			continue
+		}
+
+		if exit.Status == types.ValidatorStatusExiting {
+			exit.ExitedHeight = ctx.BlockHeight()
+		}
+		if status == types.ValidatorStatusUnbondedProjected {
+			exit.UnbondedHeight = ctx.BlockHeight()
+		}
+		exit.Status = status
+
+		k.SetValidatorExit(ctx, exit)
+		updated = append(updated, exit)
+	}
+
+	return updated
+}
+
+// BackfillValidatorExits creates exit records of validators which requested exit before exits were tracked.
+// Heights of those are unknown and left as 0.
+func (k *Keeper) BackfillValidatorExits(ctx sdk.Context) {
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	withdrawalDelay := k.GetWithdrawalDelay(ctx)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.EndEpoch == 0 {
+			continue
+		}
+
+		// signers replaced by signer update are not validators anymore
+		if signer, ok := k.GetSignerFromValidatorID(ctx, validator.ID); !ok || !bytes.Equal(signer.Bytes(), validator.Signer.Bytes()) {
+			continue
+		}
+
+		if _, ok := k.GetValidatorExit(ctx, validator.ID); ok {
+			continue
+		}
+
+		exit := types.ValidatorExit{ID: validator.ID, DeactivationEpoch: validator.EndEpoch}
+		exit.Status = exit.NextStatus(ackCount, withdrawalDelay)
+		k.SetValidatorExit(ctx, exit)
+	}
+}
+
+// GetValidatorStatus returns status of validator along with its exit schedule
+func (k *Keeper) GetValidatorStatus(ctx sdk.Context, validator hmTypes.Validator) types.ValidatorStatusInfo {
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+	info := types.ValidatorStatusInfo{
+		ID:           validator.ID,
+		Signer:       validator.Signer,
+		Status:       types.ValidatorStatus(validator, ackCount),
+		IsCurrent:    validator.IsCurrentValidator(ackCount),
+		Jailed:       validator.Jailed,
+		CurrentEpoch: ackCount + 1,
+		StartEpoch:   validator.StartEpoch,
+	}
+
+	if info.Status == types.ValidatorStatusPending {
+		info.ProjectedStartHeight = k.projectEpochHeight(ctx, validator.StartEpoch)
+	}
+
+	exit, ok := k.GetValidatorExit(ctx, validator.ID)
+	if !ok {
+		return info
+	}
+
+	// validator leaves validator set on ack of last checkpoint before deactivation epoch
+	info.Status = exit.Status
+	info.DeactivationEpoch = exit.DeactivationEpoch
+	info.UnbondEpoch = exit.UnbondEpoch(k.GetWithdrawalDelay(ctx))
+	info.LastCheckpoint = exit.DeactivationEpoch - 1
+	info.LastCheckpointAcked = ackCount >= info.LastCheckpoint
+	info.RequestedHeight = exit.RequestedHeight
+	info.ExitedHeight = exit.ExitedHeight
+	info.UnbondedHeight = exit.UnbondedHeight
+
+	if exit.Status == types.ValidatorStatusExiting {
+		info.ProjectedExitHeight = k.projectEpochHeight(ctx, info.DeactivationEpoch)
+	}
+	if exit.Status != types.ValidatorStatusUnbondedProjected {
+		info.ProjectedUnbondHeight = k.projectEpochHeight(ctx, info.UnbondEpoch)
+	}
+
+	return info
+}
+
+// projectEpochHeight returns height at which epoch starts, which is ack height of checkpoint before it.
+// Heights of future epochs are projected from average interval of latest acks, 0 if it is unknown.
+func (k *Keeper) projectEpochHeight(ctx sdk.Context, epoch uint64) int64 {
+	if epoch <= 1 {
+		return 0
+	}
+
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+	if epoch-1 <= ackCount {
+		height, _ := k.moduleCommunicator.GetCheckpointAckedHeight(ctx, epoch-1)
+		return height
+	}
+
+	lastHeight, ok := k.moduleCommunicator.GetCheckpointAckedHeight(ctx, ackCount)
+	if !ok {
+		return 0
+	}
+
+	// metadata of checkpoints acked before analytics may be missing
+	firstHeight, acks := lastHeight, uint64(0)
+	for acks < types.ProjectionAckWindow && ackCount-acks > 1 {
+		height, ok := k.moduleCommunicator.GetCheckpointAckedHeight(ctx, ackCount-acks-1)
+		if !ok {
+			break
+		}
+
+		firstHeight = height
+		acks++
+	}
+
+	if acks == 0 {
+		return 0
+	}
+
+	interval := (lastHeight - firstHeight) / int64(acks)
+	return lastHeight + int64(epoch-1-ackCount)*interval
+}
+
+//
+// Validator set preview
+//
+
+// GetValidatorSetPreview simulates validator set changes of end blocker over next epochs from current staking state.
+// First preview is the current epoch, with changes pending until next end block. Every later epoch rotates proposer
+// on checkpoint ack before applying validator updates, same as checkpoint ack and end blocker do.
+func (k *Keeper) GetValidatorSetPreview(ctx sdk.Context, epochs uint64) []types.ValidatorSetPreview {
+	previews := make([]types.ValidatorSetPreview, 0, epochs)
+	k.simulateEpochs(ctx, epochs, func(preview types.ValidatorSetPreview, validatorSet *hmTypes.ValidatorSet) {
+		if !validatorSet.IsNilOrEmpty() {
+			preview.TotalPower = validatorSet.TotalVotingPower()
+
+			proposers := validatorSet.Copy()
+			for j := 0; j < proposers.Size(); j++ {
+				preview.Proposers = append(preview.Proposers, proposers.GetProposer().Signer)
+				proposers.IncrementProposerPriority(1)
+			}
+		}
+
+		previews = append(previews, preview)
+	})
+
+	return previews
+}
+
+// GetProposerSchedule projects checkpoint proposer of next epochs with scheduled joins, exits and pending stake updates,
+// along with share of proposals of every validator in the set during those epochs.
+func (k *Keeper) GetProposerSchedule(ctx sdk.Context, epochs uint64) types.ProposerSchedule {
+	schedule := types.ProposerSchedule{
+		Epochs: epochs,
+		Slots:  []types.ProposerSlot{},
+		Shares: []types.ProposerShare{},
+	}
+
+	// validators in set at any epoch, in order of appearance
+	shareIndex := make(map[hmTypes.ValidatorID]int)
+	addShare := func(validator hmTypes.Validator) {
+		if _, ok := shareIndex[validator.ID]; !ok {
+			shareIndex[validator.ID] = len(schedule.Shares)
+			schedule.Shares = append(schedule.Shares, types.ProposerShare{
+				ValidatorID: validator.ID,
+				Signer:      validator.Signer,
+				Epochs:      []uint64{},
+			})
+		}
+	}
+
+	for _, validator := range k.GetValidatorSet(ctx).Validators {
+		addShare(*validator)
+	}
+
+	k.simulateEpochs(ctx, epochs, func(preview types.ValidatorSetPreview, validatorSet *hmTypes.ValidatorSet) {
+		for _, validator := range preview.Entering {
+			addShare(validator)
+		}
+
+		if validatorSet.IsNilOrEmpty() {
+			return
+		}
+
+		proposer := validatorSet.GetProposer()
+		schedule.Slots = append(schedule.Slots, types.ProposerSlot{
+			Epoch:       preview.Epoch,
+			ValidatorID: proposer.ID,
+			Signer:      proposer.Signer,
+		})
+
+		addShare(*proposer)
+		share := &schedule.Shares[shareIndex[proposer.ID]]
+		share.Proposals++
+		share.Epochs = append(share.Epochs, preview.Epoch)
+	})
+
+	for i := range schedule.Shares {
+		schedule.Shares[i].Share = sdk.NewDec(int64(schedule.Shares[i].Proposals)).QuoInt64(int64(epochs))
+	}
+
+	return schedule
+}
+
+// simulateEpochs simulates validator set of next epochs from current staking state, calling fn with
+// changes and validator set of every epoch. Validator set passed to fn must not be modified.
+func (k *Keeper) simulateEpochs(ctx sdk.Context, epochs uint64, fn func(types.ValidatorSetPreview, *hmTypes.ValidatorSet)) {
+	validatorSet := k.GetValidatorSet(ctx)
+	validators := k.GetAllValidators(ctx)
+	ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+	for i := uint64(0); i < epochs; i++ {
+		epoch := ackCount + i
+
+		// checkpoint ack rotates proposer
+		if i > 0 && !validatorSet.IsNilOrEmpty() {
+			validatorSet.IncrementProposerPriority(1)
+		}
+
+		preview := types.ValidatorSetPreview{
+			Epoch:     epoch,
+			Entering:  []hmTypes.Validator{},
+			Exiting:   []hmTypes.Validator{},
+			Updated:   []hmTypes.Validator{},
+			Proposers: []hmTypes.HeimdallAddress{},
+		}
+
+		updates := helper.GetUpdatedValidators(&validatorSet, validators, epoch)
+		for _, update := range updates {
+			_, current := validatorSet.GetByAddress(update.Signer.Bytes())
+			switch {
+			case current == nil:
+				preview.Entering = append(preview.Entering, *update)
+			case update.VotingPower == 0:
+				preview.Exiting = append(preview.Exiting, *current.Copy())
+			default:
+				preview.Updated = append(preview.Updated, *update)
+			}
+		}
+
+		if len(updates) > 0 {
+			if err := validatorSet.UpdateWithChangeSet(updates); err != nil {
+				k.Logger(ctx).Error("Unable to simulate validator set changes", "epoch", epoch, "error", err)
+				return
+			}
+
+			validatorSet.IncrementProposerPriority(1)
+		}
+
+		fn(preview, &validatorSet)
+	}
+}
+
 // Slashing api's
 // AddValidatorSigningInfo creates a signing info for validator
 func (k *Keeper) AddValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, valSigningInfo hmTypes.ValidatorSigningInfo) error {
@@ -457,20 +1145,25 @@ func (k *Keeper) Slash(ctx sdk.Context, valSlashingInfo hmTypes.ValidatorSlashin
 		return errors.New("validator not found")
 	}
 
-	updatedPower := int64(0)
-	// calculate power after slash
-	if validator.VotingPower >= int64(valSlashingInfo.SlashedAmount) {
-		updatedPower = validator.VotingPower - int64(valSlashingInfo.SlashedAmount)
+	// calculate stake after slash, slashed amount is in whole tokens
+	prevPower := validator.VotingPower
+	updatedStake := validator.GetStake()
+	slashedStake := new(big.Int).Mul(new(big.Int).SetUint64(valSlashingInfo.SlashedAmount), hmTypes.PowerReduction)
+	if updatedStake.Cmp(slashedStake) >= 0 {
+		updatedStake.Sub(updatedStake, slashedStake)
+	} else {
+		updatedStake.SetInt64(0)
 	}
 
-	k.Logger(ctx).Info("slashAmount", valSlashingInfo.SlashedAmount, "prevPower", validator.VotingPower, "updatedPower", updatedPower)
-
 	// update power and jail status.
-	validator.VotingPower = updatedPower
+	k.SetValidatorStake(ctx, &validator, updatedStake)
 	validator.Jailed = valSlashingInfo.IsJailed
 
+	k.Logger(ctx).Info("slashAmount", valSlashingInfo.SlashedAmount, "prevPower", prevPower, "updatedPower", validator.VotingPower)
+
 	// add updated validator to store with new key
 	k.AddValidator(ctx, validator)
+	k.AddValidatorHistory(ctx, validator, types.HistoryEventSlash)
 	k.Logger(ctx).Debug("updated validator with slashed voting power and jail status", "validator", validator)
 	return nil
 }
@@ -494,6 +1187,7 @@ func (k *Keeper) Unjail(ctx sdk.Context, valID hmTypes.ValidatorID) {
 
 	// add updated validator to store with new key
 	k.AddValidator(ctx, validator)
+	k.AddValidatorHistory(ctx, validator, types.HistoryEventUnjail)
 	return
 
 }
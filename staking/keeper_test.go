@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"math/big"
 	"math/rand"
 	"testing"
 	"time"
@@ -11,7 +12,10 @@ import (
 	"github.com/maticnetwork/heimdall/helper"
 
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/staking"
 	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 
 	"github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -341,6 +345,57 @@ func (suite *KeeperTestSuite) TestGetValidatorFromValID() {
 	require.Equal(t, validators[0], valInfo)
 }
 
+func (suite *KeeperTestSuite) TestUpdatePowerShift() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validators := keeper.GetCurrentValidators(ctx)
+
+	// stake fits at current shift
+	require.False(t, keeper.UpdatePowerShift(ctx))
+	require.Equal(t, uint64(0), keeper.GetPowerShift(ctx))
+
+	// stake of validator grows beyond max total voting power
+	whale := validators[0]
+	whale.SetStake(new(big.Int).Mul(big.NewInt(hmTypes.MaxTotalVotingPower), hmTypes.PowerReduction), keeper.GetPowerShift(ctx))
+	require.NoError(t, keeper.AddValidator(ctx, whale))
+
+	require.True(t, keeper.UpdatePowerShift(ctx))
+	require.Equal(t, uint64(1), keeper.GetPowerShift(ctx))
+	require.False(t, keeper.UpdatePowerShift(ctx))
+
+	// every validator is rescaled from its stake
+	totalPower := int64(0)
+	for _, validator := range keeper.GetAllValidators(ctx) {
+		require.Equal(t, hmTypes.StakeToPower(validator.GetStake(), 1), validator.VotingPower)
+		totalPower += validator.VotingPower
+	}
+	require.True(t, totalPower <= hmTypes.MaxTotalVotingPower)
+
+	val, _ := keeper.GetValidatorFromValID(ctx, validators[1].ID)
+	require.Equal(t, int64(5), val.VotingPower)
+
+	// slashed amount is in whole tokens of stake
+	require.NoError(t, keeper.Slash(ctx, hmTypes.NewValidatorSlashingInfo(val.ID, 4, false)))
+	val, _ = keeper.GetValidatorFromValID(ctx, val.ID)
+	require.Equal(t, new(big.Int).Mul(big.NewInt(6), hmTypes.PowerReduction), val.GetStake())
+	require.Equal(t, int64(3), val.VotingPower)
+
+	// validator with a single token keeps minimum power and stays in the set
+	minnow := validators[2]
+	minnow.SetStake(hmTypes.PowerReduction, keeper.GetPowerShift(ctx))
+	require.NoError(t, keeper.AddValidator(ctx, minnow))
+
+	whale.SetStake(new(big.Int).Mul(big.NewInt(hmTypes.MaxTotalVotingPower), new(big.Int).Lsh(hmTypes.PowerReduction, 10)), keeper.GetPowerShift(ctx))
+	require.NoError(t, keeper.AddValidator(ctx, whale))
+	require.True(t, keeper.UpdatePowerShift(ctx))
+	require.Equal(t, uint64(11), keeper.GetPowerShift(ctx))
+
+	minnow, _ = keeper.GetValidatorFromValID(ctx, minnow.ID)
+	require.Equal(t, int64(1), minnow.VotingPower)
+	require.False(t, keeper.UpdatePowerShift(ctx), "Minimum power should not rescale again")
+}
+
 func (suite *KeeperTestSuite) TestGetLastUpdated() {
 	t, app, ctx := suite.T(), suite.app, suite.ctx
 	keeper := app.StakingKeeper
@@ -368,3 +423,235 @@ func (suite *KeeperTestSuite) TestGetSpanEligibleValidators() {
 	validators := keeper.GetSpanEligibleValidators(ctx)
 	require.LessOrEqual(t, len(validators), 4)
 }
+
+func (suite *KeeperTestSuite) TestValidatorHistory() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validator := keeper.GetCurrentValidators(ctx)[0]
+
+	// join at height 1, epoch 0
+	ctx = ctx.WithBlockHeight(1)
+	keeper.AddValidatorHistory(ctx, validator, stakingTypes.HistoryEventJoin)
+
+	// stake update at height 5, epoch 1
+	ctx = ctx.WithBlockHeight(5)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 1)
+	validator.VotingPower = 20
+	keeper.AddValidatorHistory(ctx, validator, stakingTypes.HistoryEventStakeUpdate)
+
+	// slash at height 10, epoch 2
+	ctx = ctx.WithBlockHeight(10)
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2)
+	validator.Jailed = true
+	keeper.AddValidatorHistory(ctx, validator, stakingTypes.HistoryEventSlash)
+
+	records := keeper.GetValidatorHistory(ctx, validator.ID, 0, 0, 0, 0)
+	require.Len(t, records, 3)
+	require.Equal(t, stakingTypes.HistoryEventJoin, records[0].Event)
+	require.Equal(t, int64(1), records[0].Height)
+	require.Equal(t, int64(20), records[1].VotingPower)
+	require.Equal(t, uint64(1), records[1].Epoch)
+	require.Equal(t, stakingTypes.ValidatorStatusJailed, records[2].Status)
+
+	// height range
+	records = keeper.GetValidatorHistory(ctx, validator.ID, 2, 5, 0, 0)
+	require.Len(t, records, 1)
+	require.Equal(t, stakingTypes.HistoryEventStakeUpdate, records[0].Event)
+
+	// epoch range
+	records = keeper.GetValidatorHistory(ctx, validator.ID, 0, 0, 2, 0)
+	require.Len(t, records, 1)
+	require.Equal(t, stakingTypes.HistoryEventSlash, records[0].Event)
+
+	// other validator has no history
+	require.Empty(t, keeper.GetValidatorHistory(ctx, hmTypes.NewValidatorID(100), 0, 0, 0, 0))
+}
+
+func (suite *KeeperTestSuite) TestPruneValidatorHistory() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validator := keeper.GetCurrentValidators(ctx)[0]
+
+	for height := int64(1); height <= 10; height++ {
+		keeper.AddValidatorHistory(ctx.WithBlockHeight(height), validator, stakingTypes.HistoryEventStakeUpdate)
+	}
+
+	// history is kept forever by default
+	require.Equal(t, uint64(0), keeper.PruneValidatorHistory(ctx.WithBlockHeight(100)))
+
+	keeper.SetParams(ctx, stakingTypes.NewParams(5, 2, stakingTypes.DefaultWithdrawalDelay))
+
+	// records below height 10 - 5 are pruned, two at a time
+	ctx = ctx.WithBlockHeight(10)
+	require.Equal(t, uint64(2), keeper.PruneValidatorHistory(ctx))
+	require.Equal(t, uint64(2), keeper.PruneValidatorHistory(ctx))
+	require.Equal(t, uint64(0), keeper.PruneValidatorHistory(ctx))
+
+	records := keeper.GetValidatorHistory(ctx, validator.ID, 0, 0, 0, 0)
+	require.Len(t, records, 6)
+	require.Equal(t, int64(5), records[0].Height)
+}
+
+func (suite *KeeperTestSuite) TestGetValidatorSetPreview() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// validators active until epoch 10
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	// validator joining at epoch 3
+	joining := stakingSim.GenRandomVal(1, 3, 20, 0, false, 10)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+
+	previews := keeper.GetValidatorSetPreview(ctx, 12)
+	require.Len(t, previews, 12)
+
+	// no pending changes
+	require.Equal(t, uint64(0), previews[0].Epoch)
+	require.False(t, previews[0].HasChanges())
+	require.Equal(t, int64(40), previews[0].TotalPower)
+	require.Len(t, previews[0].Proposers, 4)
+
+	// validator enters once ack count reaches 2
+	require.False(t, previews[1].HasChanges())
+	require.Len(t, previews[2].Entering, 1)
+	require.Equal(t, joining.Signer, previews[2].Entering[0].Signer)
+	require.Equal(t, int64(60), previews[2].TotalPower)
+	require.Len(t, previews[2].Proposers, 5)
+
+	// validators leave once ack count reaches 9
+	require.Len(t, previews[9].Exiting, 4)
+	require.Equal(t, int64(10), previews[9].Exiting[0].VotingPower)
+	require.Equal(t, int64(20), previews[9].TotalPower)
+	require.Equal(t, []hmTypes.HeimdallAddress{joining.Signer}, previews[9].Proposers)
+	require.False(t, previews[10].HasChanges())
+
+	// store is not modified
+	require.Len(t, keeper.GetCurrentValidators(ctx), 4)
+	require.Equal(t, 4, len(keeper.GetValidatorSet(ctx).Validators))
+}
+
+func (suite *KeeperTestSuite) TestGetProposerSchedule() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// validators active until epoch 10
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	// validator joining at epoch 3
+	joining := stakingSim.GenRandomVal(1, 3, 20, 0, false, 10)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+
+	schedule := keeper.GetProposerSchedule(ctx, 12)
+	require.Equal(t, uint64(12), schedule.Epochs)
+	require.Len(t, schedule.Slots, 12)
+	require.Len(t, schedule.Shares, 5)
+
+	// proposers match head of previewed proposer order
+	previews := keeper.GetValidatorSetPreview(ctx, 12)
+	for i, slot := range schedule.Slots {
+		require.Equal(t, previews[i].Epoch, slot.Epoch)
+		require.Equal(t, previews[i].Proposers[0], slot.Signer)
+	}
+
+	// only joining validator proposes after others exit
+	for _, slot := range schedule.Slots[9:] {
+		require.Equal(t, joining.ID, slot.ValidatorID)
+	}
+
+	// proposals add up to all epochs
+	proposals := uint64(0)
+	for _, share := range schedule.Shares {
+		require.Len(t, share.Epochs, int(share.Proposals))
+		proposals += share.Proposals
+	}
+	require.Equal(t, uint64(12), proposals)
+}
+
+func (suite *KeeperTestSuite) TestValidatorExitStatus() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.StakingKeeper
+
+	// validators active until epoch 10
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	keeper.SetParams(ctx, stakingTypes.NewParams(0, 100, 5))
+
+	// checkpoints acked every 100 blocks
+	for number := uint64(1); number <= 10; number++ {
+		app.CheckpointKeeper.SetMetadata(ctx, checkpointTypes.CheckpointMetadata{Number: number, AckedHeight: int64(number) * 100})
+	}
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 3)
+
+	// validators with end epoch are exiting after backfill
+	keeper.BackfillValidatorExits(ctx)
+	exits := keeper.GetAllValidatorExits(ctx)
+	require.Len(t, exits, 4)
+	for _, exit := range exits {
+		require.Equal(t, stakingTypes.ValidatorStatusExiting, exit.Status)
+		require.Equal(t, uint64(10), exit.DeactivationEpoch)
+		require.Equal(t, int64(0), exit.RequestedHeight)
+	}
+
+	// validator requests exit at epoch 6
+	validator := keeper.GetCurrentValidators(ctx)[0]
+	validator.EndEpoch = 6
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	keeper.SetValidatorExit(ctx, stakingTypes.NewValidatorExit(validator.ID, 6, 250))
+
+	status := keeper.GetValidatorStatus(ctx, validator)
+	require.Equal(t, stakingTypes.ValidatorStatusExiting, status.Status)
+	require.True(t, status.IsCurrent)
+	require.Equal(t, uint64(4), status.CurrentEpoch)
+	require.Equal(t, uint64(11), status.UnbondEpoch)
+	require.Equal(t, uint64(5), status.LastCheckpoint)
+	require.False(t, status.LastCheckpointAcked)
+	require.Equal(t, int64(250), status.RequestedHeight)
+	require.Equal(t, int64(500), status.ProjectedExitHeight)
+	require.Equal(t, int64(1000), status.ProjectedUnbondHeight)
+	require.Empty(t, keeper.UpdateValidatorExits(ctx))
+
+	// validator leaves validator set on ack of its last checkpoint
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 5)
+	updated := keeper.UpdateValidatorExits(ctx.WithBlockHeight(500))
+	require.Len(t, updated, 1)
+	require.Equal(t, validator.ID, updated[0].ID)
+
+	status = keeper.GetValidatorStatus(ctx, validator)
+	require.Equal(t, stakingTypes.ValidatorStatusExited, status.Status)
+	require.False(t, status.IsCurrent)
+	require.True(t, status.LastCheckpointAcked)
+	require.Equal(t, int64(500), status.ExitedHeight)
+	require.Equal(t, int64(0), status.ProjectedExitHeight)
+	require.Equal(t, int64(1000), status.ProjectedUnbondHeight)
+
+	// unbonding completes withdrawal delay after deactivation epoch, others leave validator set
+	app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 10)
+	require.Len(t, keeper.UpdateValidatorExits(ctx.WithBlockHeight(1000)), 4)
+	require.Empty(t, keeper.UpdateValidatorExits(ctx.WithBlockHeight(1001)))
+
+	// only exits still changing status are queued, unbonded exits are never scanned again
+	store := ctx.KVStore(app.GetKey(stakingTypes.StoreKey))
+	require.False(t, store.Has(staking.GetValidatorExitQueueKey(11, validator.ID)))
+	for _, exit := range keeper.GetAllValidatorExits(ctx) {
+		if exit.ID != validator.ID {
+			require.True(t, store.Has(staking.GetValidatorExitQueueKey(15, exit.ID)))
+		}
+	}
+
+	status = keeper.GetValidatorStatus(ctx, validator)
+	require.Equal(t, stakingTypes.ValidatorStatusUnbondedProjected, status.Status)
+	require.Equal(t, int64(500), status.ExitedHeight)
+	require.Equal(t, int64(1000), status.UnbondedHeight)
+	require.Equal(t, int64(0), status.ProjectedUnbondHeight)
+
+	for _, exit := range keeper.GetAllValidatorExits(ctx) {
+		if exit.ID != validator.ID {
+			require.Equal(t, stakingTypes.ValidatorStatusExited, exit.Status)
+			require.Equal(t, int64(1000), exit.ExitedHeight)
+		}
+	}
+}
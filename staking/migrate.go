@@ -0,0 +1,36 @@
+package staking
+
+import (
+	"encoding/json"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// genesisStateV02 is the staking genesis as exported by v0.2, before staking had params
+type genesisStateV02 struct {
+	Validators       []*hmTypes.Validator `json:"validators"`
+	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set"`
+	StakingSequences []string             `json:"staking_sequences"`
+}
+
+// MigrateGenesis migrates staking genesis from v0.2 to v0.3. Params are set to their defaults
+// as InitGenesis stores them unconditionally.
+func MigrateGenesis(appState map[string]json.RawMessage) (json.RawMessage, error) {
+	var oldState genesisStateV02
+	if err := types.ModuleCdc.UnmarshalJSON(appState[types.ModuleName], &oldState); err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalJSON(types.NewGenesisState(
+		types.DefaultParams(),
+		oldState.Validators,
+		oldState.CurrentValSet,
+		oldState.StakingSequences,
+		nil,
+		0,
+		nil,
+		nil,
+		0,
+	))
+}
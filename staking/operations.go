@@ -0,0 +1,337 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/simulation"
+	stakingSim "github.com/maticnetwork/heimdall/staking/simulation"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	simTypes "github.com/maticnetwork/heimdall/types/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgValidatorJoin = "op_weight_msg_validator_join"
+	OpWeightMsgStakeUpdate   = "op_weight_msg_stake_update"
+	OpWeightMsgSignerUpdate  = "op_weight_msg_signer_update"
+	OpWeightMsgValidatorExit = "op_weight_msg_validator_exit"
+
+	DefaultWeightMsgValidatorJoin = 20
+	DefaultWeightMsgStakeUpdate   = 40
+	DefaultWeightMsgSignerUpdate  = 15
+	DefaultWeightMsgValidatorExit = 10
+)
+
+const (
+	// validators never exit below this number of bonded validators so that blocks keep getting proposed
+	minSimValidators = 3
+
+	// joins and signer updates stop once this many validator records exist, which
+	// keeps power shift and validator set updates cheap over long simulations
+	maxSimValidatorRecords = 50
+)
+
+// WeightedOperations returns all the operations from the staking module with their respective weights
+func WeightedOperations(appParams simTypes.AppParams, cdc *codec.Codec, k Keeper) simulation.WeightedOperations {
+	var weightMsgValidatorJoin int
+	appParams.GetOrGenerate(cdc, OpWeightMsgValidatorJoin, &weightMsgValidatorJoin, nil,
+		func(_ *rand.Rand) {
+			weightMsgValidatorJoin = DefaultWeightMsgValidatorJoin
+		},
+	)
+
+	var weightMsgStakeUpdate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgStakeUpdate, &weightMsgStakeUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgStakeUpdate = DefaultWeightMsgStakeUpdate
+		},
+	)
+
+	var weightMsgSignerUpdate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSignerUpdate, &weightMsgSignerUpdate, nil,
+		func(_ *rand.Rand) {
+			weightMsgSignerUpdate = DefaultWeightMsgSignerUpdate
+		},
+	)
+
+	var weightMsgValidatorExit int
+	appParams.GetOrGenerate(cdc, OpWeightMsgValidatorExit, &weightMsgValidatorExit, nil,
+		func(_ *rand.Rand) {
+			weightMsgValidatorExit = DefaultWeightMsgValidatorExit
+		},
+	)
+
+	// all operations share one fake main chain
+	contractCaller := stakingSim.NewContractCaller()
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgValidatorJoin, SimulateMsgValidatorJoin(k, contractCaller)),
+		simulation.NewWeightedOperation(weightMsgStakeUpdate, SimulateMsgStakeUpdate(k, contractCaller)),
+		simulation.NewWeightedOperation(weightMsgSignerUpdate, SimulateMsgSignerUpdate(k, contractCaller)),
+		simulation.NewWeightedOperation(weightMsgValidatorExit, SimulateMsgValidatorExit(k, contractCaller)),
+	}
+}
+
+// SimulateMsgValidatorJoin generates a MsgValidatorJoin for a new validator with random stake
+func SimulateMsgValidatorJoin(k Keeper, contractCaller *stakingSim.ContractCaller) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		validators := k.GetAllValidators(ctx)
+		if len(validators) >= maxSimValidatorRecords {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		var id uint64
+		for _, validator := range validators {
+			if validator.ID.Uint64() >= id {
+				id = validator.ID.Uint64() + 1
+			}
+		}
+
+		pubkey, signer := randomSigner(r)
+		amount := randomStakeAmount(r)
+		activationEpoch := k.moduleCommunicator.GetACKCount(ctx) + 1
+
+		event := &stakinginfo.StakinginfoStaked{
+			Signer:          signer,
+			ValidatorId:     new(big.Int).SetUint64(id),
+			Nonce:           big.NewInt(0),
+			ActivationEpoch: new(big.Int).SetUint64(activationEpoch),
+			Amount:          amount,
+			Total:           amount,
+			SignerPubkey:    pubkey.Bytes()[1:],
+		}
+		tampered := tamperEvent(r, event.Nonce)
+
+		receipt, logIndex := contractCaller.AddTx(r, event)
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgValidatorJoin(
+			from.Address,
+			id,
+			activationEpoch,
+			sdk.NewIntFromBigInt(amount),
+			pubkey,
+			hmTypes.BytesToHeimdallHash(receipt.TxHash.Bytes()),
+			logIndex,
+			receipt.BlockNumber.Uint64(),
+			0,
+		)
+
+		return simulateSideMsg(ctx, k, contractCaller, msg, tampered)
+	}
+}
+
+// SimulateMsgStakeUpdate generates a MsgStakeUpdate with random stake for a random validator
+func SimulateMsgStakeUpdate(k Keeper, contractCaller *stakingSim.ContractCaller) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		validator, ok := randomValidator(r, k, ctx, false)
+		if !ok {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		amount := randomStakeAmount(r)
+		nonce := validator.Nonce + 1
+
+		event := &stakinginfo.StakinginfoStakeUpdate{
+			ValidatorId: new(big.Int).SetUint64(validator.ID.Uint64()),
+			Nonce:       new(big.Int).SetUint64(nonce),
+			NewAmount:   amount,
+		}
+		tampered := tamperEvent(r, event.Nonce)
+
+		receipt, logIndex := contractCaller.AddTx(r, event)
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgStakeUpdate(
+			from.Address,
+			validator.ID.Uint64(),
+			sdk.NewIntFromBigInt(amount),
+			hmTypes.BytesToHeimdallHash(receipt.TxHash.Bytes()),
+			logIndex,
+			receipt.BlockNumber.Uint64(),
+			nonce,
+		)
+
+		return simulateSideMsg(ctx, k, contractCaller, msg, tampered)
+	}
+}
+
+// SimulateMsgSignerUpdate generates a MsgSignerUpdate with a new random signer for a random validator
+func SimulateMsgSignerUpdate(k Keeper, contractCaller *stakingSim.ContractCaller) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		// every signer update leaves the old signer record behind
+		if len(k.GetAllValidators(ctx)) >= maxSimValidatorRecords {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator, ok := randomValidator(r, k, ctx, false)
+		if !ok {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		pubkey, signer := randomSigner(r)
+		nonce := validator.Nonce + 1
+
+		event := &stakinginfo.StakinginfoSignerChange{
+			ValidatorId:  new(big.Int).SetUint64(validator.ID.Uint64()),
+			Nonce:        new(big.Int).SetUint64(nonce),
+			OldSigner:    validator.Signer.EthAddress(),
+			NewSigner:    signer,
+			SignerPubkey: pubkey.Bytes()[1:],
+		}
+		tampered := tamperEvent(r, event.Nonce)
+
+		receipt, logIndex := contractCaller.AddTx(r, event)
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgSignerUpdate(
+			from.Address,
+			validator.ID.Uint64(),
+			pubkey,
+			hmTypes.BytesToHeimdallHash(receipt.TxHash.Bytes()),
+			logIndex,
+			receipt.BlockNumber.Uint64(),
+			nonce,
+		)
+
+		return simulateSideMsg(ctx, k, contractCaller, msg, tampered)
+	}
+}
+
+// SimulateMsgValidatorExit generates a MsgValidatorExit for a random bonded validator
+func SimulateMsgValidatorExit(k Keeper, contractCaller *stakingSim.ContractCaller) simTypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simTypes.Account, chainID string) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+		ackCount := k.moduleCommunicator.GetACKCount(ctx)
+
+		var bonded int
+		for _, validator := range k.GetAllValidators(ctx) {
+			if validator.IsCurrentValidator(ackCount) {
+				bonded++
+			}
+		}
+
+		if bonded <= minSimValidators {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator, ok := randomValidator(r, k, ctx, true)
+		if !ok {
+			return simTypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// deactivate in current epoch so that validator leaves the set at end block
+		deactivationEpoch := ackCount + 1
+		nonce := validator.Nonce + 1
+
+		event := &stakinginfo.StakinginfoUnstakeInit{
+			User:              validator.Signer.EthAddress(),
+			ValidatorId:       new(big.Int).SetUint64(validator.ID.Uint64()),
+			Nonce:             new(big.Int).SetUint64(nonce),
+			DeactivationEpoch: new(big.Int).SetUint64(deactivationEpoch),
+			Amount:            validator.GetStake(),
+		}
+		tampered := tamperEvent(r, event.Nonce)
+
+		receipt, logIndex := contractCaller.AddTx(r, event)
+		from, _ := simTypes.RandomAcc(r, accs)
+		msg := types.NewMsgValidatorExit(
+			from.Address,
+			validator.ID.Uint64(),
+			deactivationEpoch,
+			hmTypes.BytesToHeimdallHash(receipt.TxHash.Bytes()),
+			logIndex,
+			receipt.BlockNumber.Uint64(),
+			nonce,
+		)
+
+		return simulateSideMsg(ctx, k, contractCaller, msg, tampered)
+	}
+}
+
+// simulateSideMsg runs msg through msg handler, side handler and post handler with
+// the side handler vote. State is written only if all of them succeed. A tampered
+// event must be rejected by the side handler and a matching one approved.
+func simulateSideMsg(ctx sdk.Context, k Keeper, contractCaller *stakingSim.ContractCaller, msg sdk.Msg, tampered bool) (simTypes.OperationMsg, []simTypes.FutureOperation, error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	if res := NewHandler(k, contractCaller)(cacheCtx, msg); !res.IsOK() {
+		return simTypes.NewOperationMsg(msg, false, res.Log), nil, nil
+	}
+
+	sideRes := NewSideTxHandler(k, contractCaller)(cacheCtx, msg)
+	approved := sideRes.Result == abci.SideTxResultType_Yes
+	if approved == tampered {
+		return simTypes.NoOpMsg(types.ModuleName), nil, fmt.Errorf("side handler voted %v on msg %v with tampered event %v", sideRes.Result, msg.Type(), tampered)
+	}
+
+	if !approved {
+		return simTypes.NewOperationMsg(msg, false, "tampered event rejected"), nil, nil
+	}
+
+	if res := NewPostTxHandler(k, contractCaller)(cacheCtx, msg, sideRes.Result); !res.IsOK() {
+		return simTypes.NewOperationMsg(msg, false, res.Log), nil, nil
+	}
+
+	write()
+	return simTypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomValidator returns a random validator, optionally only one which has not started unbonding
+func randomValidator(r *rand.Rand, k Keeper, ctx sdk.Context, bondedOnly bool) (hmTypes.Validator, bool) {
+	var validators []*hmTypes.Validator
+	for _, validator := range k.GetAllValidators(ctx) {
+		// skip signers retired by signer update
+		if current, ok := k.GetValidatorFromValID(ctx, validator.ID); !ok || current.Signer != validator.Signer {
+			continue
+		}
+
+		if bondedOnly && validator.EndEpoch != 0 {
+			continue
+		}
+
+		validators = append(validators, validator)
+	}
+
+	if len(validators) == 0 {
+		return hmTypes.Validator{}, false
+	}
+
+	return *validators[r.Intn(len(validators))], true
+}
+
+// randomSigner returns a random signer pubkey and address
+func randomSigner(r *rand.Rand) (hmTypes.PubKey, common.Address) {
+	seed := make([]byte, 32)
+	r.Read(seed)
+
+	pubkey := secp256k1.GenPrivKeySecp256k1(seed).PubKey().(secp256k1.PubKeySecp256k1)
+	signerPubkey := hmTypes.NewPubKey(pubkey[:])
+
+	return signerPubkey, common.BytesToAddress(signerPubkey.Address().Bytes())
+}
+
+// randomStakeAmount returns a random stake between 1 and 10000 tokens
+func randomStakeAmount(r *rand.Rand) *big.Int {
+	decimals18 := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	amount := new(big.Int).Mul(big.NewInt(int64(simTypes.RandIntBetween(r, 1, 10000))), decimals18)
+
+	return amount.Add(amount, big.NewInt(r.Int63n(decimals18.Int64())))
+}
+
+// tamperEvent changes event nonce once in a while so that it no longer matches the msg
+func tamperEvent(r *rand.Rand, nonce *big.Int) bool {
+	if r.Intn(10) != 0 {
+		return false
+	}
+
+	nonce.Add(nonce, big.NewInt(1))
+	return true
+}
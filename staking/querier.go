@@ -26,6 +26,8 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryValidator(ctx, req, keeper)
 		case types.QueryValidatorStatus:
 			return handleQueryValidatorStatus(ctx, req, keeper)
+		case types.QueryValidatorExitStatus:
+			return handleQueryValidatorExitStatus(ctx, req, keeper)
 		case types.QueryProposer:
 			return handleQueryProposer(ctx, req, keeper)
 		case types.QueryCurrentProposer:
@@ -34,6 +36,16 @@ func NewQuerier(keeper Keeper, contractCaller helper.IContractCaller) sdk.Querie
 			return handleQueryStakingSequence(ctx, req, keeper, contractCaller)
 		case types.QueryTotalValidatorPower:
 			return handleQueryTotalValidatorPower(ctx, req, keeper)
+		case types.QueryValidatorHistory:
+			return handleQueryValidatorHistory(ctx, req, keeper)
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryValidatorSetPreview:
+			return handleQueryValidatorSetPreview(ctx, req, keeper)
+		case types.QueryValidatorNonces:
+			return handleQueryValidatorNonces(ctx, req, keeper)
+		case types.QueryProposerSchedule:
+			return handleQueryProposerSchedule(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -55,8 +67,19 @@ func handleQueryCurrentValidatorSet(ctx sdk.Context, req abci.RequestQuery, keep
 	// get validator set
 	validatorSet := keeper.GetValidatorSet(ctx)
 
+	// add descriptions of validators
+	result := types.ValidatorSetWithDescriptions{
+		Validators: make([]*types.ValidatorWithDescription, 0, len(validatorSet.Validators)),
+	}
+	for _, validator := range validatorSet.Validators {
+		result.Validators = append(result.Validators, keeper.WithDescription(ctx, *validator))
+	}
+	if validatorSet.Proposer != nil {
+		result.Proposer = keeper.WithDescription(ctx, *validatorSet.Proposer)
+	}
+
 	// json record
-	bz, err := json.Marshal(validatorSet)
+	bz, err := json.Marshal(result)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -96,7 +119,7 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	}
 
 	// json record
-	bz, err := json.Marshal(validator)
+	bz, err := json.Marshal(keeper.WithDescription(ctx, validator))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -120,6 +143,31 @@ func handleQueryValidatorStatus(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 }
 
+func handleQueryValidatorExitStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySignerParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// get validator by signer address
+	validator, err := keeper.GetValidatorInfo(ctx, params.SignerAddress)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest("No validator found")
+	}
+
+	// signer replaced by signer update reports status of its validator
+	if current, ok := keeper.GetValidatorFromValID(ctx, validator.ID); ok {
+		validator = current
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetValidatorStatus(ctx, validator))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryProposer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params types.QueryProposerParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -193,3 +241,85 @@ func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper K
 
 	return bz, nil
 }
+
+func handleQueryValidatorHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorHistoryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.ToHeight != 0 && params.FromHeight > params.ToHeight {
+		return nil, sdk.ErrUnknownRequest("from_height must not be greater than to_height")
+	}
+
+	if params.ToEpoch != 0 && params.FromEpoch > params.ToEpoch {
+		return nil, sdk.ErrUnknownRequest("from_epoch must not be greater than to_epoch")
+	}
+
+	records := keeper.GetValidatorHistory(ctx, params.ValidatorID, params.FromHeight, params.ToHeight, params.FromEpoch, params.ToEpoch)
+
+	// json record
+	bz, err := json.Marshal(records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetPreview(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSetPreviewParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Epochs == 0 || params.Epochs > types.MaxPreviewEpochs {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("epochs must be between 1 and %d", types.MaxPreviewEpochs))
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetValidatorSetPreview(ctx, params.Epochs))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerSchedule(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerScheduleParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Epochs == 0 || params.Epochs > types.MaxScheduleEpochs {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("epochs must be between 1 and %d", types.MaxScheduleEpochs))
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetProposerSchedule(ctx, params.Epochs))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorNonces(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	nonces := []types.ValidatorNonce{}
+	for _, validator := range keeper.GetAllValidators(ctx) {
+		nonces = append(nonces, types.ValidatorNonce{ValidatorID: validator.ID, Nonce: validator.Nonce})
+	}
+
+	// json record
+	bz, err := json.Marshal(nonces)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -9,9 +10,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/app"
 	chSim "github.com/maticnetwork/heimdall/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
 	"github.com/maticnetwork/heimdall/staking/types"
@@ -148,6 +151,47 @@ func (suite *QuerierTestSuite) TestHandleQueryValidatorStatus() {
 
 	// check response is not nil
 	require.NotNil(t, res)
+
+	var status bool
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.True(t, status)
+
+	// unknown signer is not a current validator
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySignerParams(hmTypes.SampleHeimdallAddress("0x1").Bytes()))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.False(t, status)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorExitStatus() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validators := keeper.GetAllValidators(ctx)
+
+	path := []string{types.QueryValidatorExitStatus}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorExitStatus)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQuerySignerParams(validators[0].Signer.Bytes())),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var status types.ValidatorStatusInfo
+	require.NoError(t, json.Unmarshal(res, &status))
+	require.Equal(t, validators[0].ID, status.ID)
+	require.Equal(t, types.ValidatorStatusActive, status.Status)
+	require.True(t, status.IsCurrent)
+
+	// unknown signer
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySignerParams(hmTypes.SampleHeimdallAddress("0x1").Bytes()))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
 }
 
 func (suite *QuerierTestSuite) TestHandleQueryProposer() {
@@ -231,3 +275,156 @@ func (suite *QuerierTestSuite) TestHandleQueryStakingSequence() {
 	require.NotNil(t, res)
 	require.Equal(t, sequence.String(), string(res))
 }
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorHistory() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	validator := keeper.GetAllValidators(ctx)[0]
+
+	keeper.AddValidatorHistory(ctx.WithBlockHeight(1), *validator, types.HistoryEventJoin)
+	keeper.AddValidatorHistory(ctx.WithBlockHeight(2), *validator, types.HistoryEventStakeUpdate)
+
+	path := []string{types.QueryValidatorHistory}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorHistory)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorHistoryParams(validator.ID, 2, 0, 0, 0)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var records []types.ValidatorHistoryRecord
+	require.NoError(t, json.Unmarshal(res, &records))
+	require.Len(t, records, 1)
+	require.Equal(t, types.HistoryEventStakeUpdate, records[0].Event)
+	require.Equal(t, validator.Signer, records[0].Signer)
+
+	// invalid range
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryValidatorHistoryParams(validator.ID, 5, 2, 0, 0))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorSetPreview() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+
+	path := []string{types.QueryValidatorSetPreview}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetPreview)
+
+	req := abci.RequestQuery{
+		Path: route,
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorSetPreviewParams(3)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var previews []types.ValidatorSetPreview
+	require.NoError(t, json.Unmarshal(res, &previews))
+	require.Len(t, previews, 3)
+
+	// epochs out of range
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryValidatorSetPreviewParams(types.MaxPreviewEpochs + 1))
+	_, err = querier(ctx, path, req)
+	require.Error(t, err)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorDescription() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validator := keeper.GetCurrentValidators(ctx)[0]
+
+	description := types.NewDescription("validator-0", "https://example.com", "", "")
+	keeper.SetValidatorDescription(ctx, validator.ID, description)
+
+	// validator by id
+	res, err := querier(ctx, []string{types.QueryValidator}, abci.RequestQuery{
+		Data: app.Codec().MustMarshalJSON(types.NewQueryValidatorParams(validator.ID)),
+	})
+	require.NoError(t, err)
+
+	var result types.ValidatorWithDescription
+	require.NoError(t, json.Unmarshal(res, &result))
+	require.Equal(t, validator.Signer, result.Signer)
+	require.Equal(t, &description, result.Description)
+
+	// validator set
+	res, err = querier(ctx, []string{types.QueryCurrentValidatorSet}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var validatorSet types.ValidatorSetWithDescriptions
+	require.NoError(t, json.Unmarshal(res, &validatorSet))
+	require.Len(t, validatorSet.Validators, 2)
+	for _, v := range validatorSet.Validators {
+		if v.ID == validator.ID {
+			require.Equal(t, &description, v.Description)
+		} else {
+			require.Nil(t, v.Description)
+		}
+	}
+
+	// response is still a validator set
+	var plainSet hmTypes.ValidatorSet
+	require.NoError(t, json.Unmarshal(res, &plainSet))
+	require.Len(t, plainSet.Validators, 2)
+}
+
+func (suite *QuerierTestSuite) TestHandleQueryValidatorNonces() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+	keeper := app.StakingKeeper
+	chSim.LoadValidatorSet(2, t, keeper, ctx, false, 10)
+	validators := keeper.GetAllValidators(ctx)
+
+	res, err := querier(ctx, []string{types.QueryValidatorNonces}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var nonces []types.ValidatorNonce
+	require.NoError(t, json.Unmarshal(res, &nonces))
+	require.Len(t, nonces, 2)
+
+	heimdallNonces := make(map[hmTypes.ValidatorID]uint64)
+	for _, nonce := range nonces {
+		heimdallNonces[nonce.ValidatorID] = nonce.Nonce
+	}
+
+	// first validator is behind rootchain by two nonces, new validator is staked only on rootchain
+	behind, synced, staked := validators[0], validators[1], hmTypes.ValidatorID(100)
+	require.Equal(t, behind.Nonce, heimdallNonces[behind.ID])
+	rootChainNonces := map[hmTypes.ValidatorID]uint64{
+		behind.ID: behind.Nonce + 2,
+		synced.ID: synced.Nonce,
+		staked:    1,
+	}
+
+	txHash := common.HexToHash("0x123")
+	gaps := types.NewNonceGaps(heimdallNonces, rootChainNonces, []helper.StakingEvent{
+		{Name: "SignerChange", ValidatorID: behind.ID.Uint64(), Nonce: behind.Nonce + 2, TxHash: txHash, LogIndex: 1, BlockNumber: 15},
+		{Name: "StakeUpdate", ValidatorID: behind.ID.Uint64(), Nonce: behind.Nonce + 1, TxHash: txHash, LogIndex: 0, BlockNumber: 15},
+		{Name: "StakeUpdate", ValidatorID: behind.ID.Uint64(), Nonce: behind.Nonce, TxHash: txHash, LogIndex: 2, BlockNumber: 12},
+		{Name: "StakeUpdate", ValidatorID: synced.ID.Uint64(), Nonce: synced.Nonce, TxHash: txHash, LogIndex: 3, BlockNumber: 12},
+		{Name: "Staked", ValidatorID: staked.Uint64(), Nonce: 1, TxHash: txHash, LogIndex: 4, BlockNumber: 16},
+	})
+	require.Len(t, gaps, 2)
+	require.Equal(t, behind.ID, gaps[0].ValidatorID)
+	require.Equal(t, []uint64{behind.Nonce + 1, behind.Nonce + 2}, gaps[0].MissingNonces)
+
+	// only events of missing nonces, in nonce order
+	require.Len(t, gaps[0].Events, 2)
+	require.Equal(t, "StakeUpdate", gaps[0].Events[0].Event)
+	require.Equal(t, "SignerChange", gaps[0].Events[1].Event)
+	require.Equal(t, hmTypes.BytesToHeimdallHash(txHash.Bytes()), gaps[0].Events[1].TxHash)
+
+	// validator missing in heimdall has heimdall nonce 0
+	require.Equal(t, staked, gaps[1].ValidatorID)
+	require.Equal(t, uint64(0), gaps[1].HeimdallNonce)
+	require.Len(t, gaps[1].Events, 1)
+	require.Equal(t, "Staked", gaps[1].Events[0].Event)
+}
@@ -335,9 +335,8 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 	pubkey := msg.SignerPubKey
 	signer := pubkey.Address()
 
-	// get voting power from amount
-	votingPower, err := helper.GetPowerFromAmount(msg.Amount.BigInt())
-	if err != nil {
+	// check amount is at least one token
+	if _, err := helper.GetPowerFromAmount(msg.Amount.BigInt()); err != nil {
 		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.Amount, msg.ID)).Result()
 	}
 
@@ -347,23 +346,30 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 		StartEpoch:  msg.ActivationEpoch,
 		EndEpoch:    0,
 		Nonce:       msg.Nonce,
-		VotingPower: votingPower.Int64(),
 		PubKey:      pubkey,
 		Signer:      hmTypes.BytesToHeimdallAddress(signer.Bytes()),
 		LastUpdated: "",
 	}
 
+	// set stake and voting power from amount
+	k.SetValidatorStake(ctx, &newValidator, msg.Amount.BigInt())
+
 	// update last updated
 	newValidator.LastUpdated = sequence.String()
 
 	// add validator to store
 	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
-	err = k.AddValidator(ctx, newValidator)
+	err := k.AddValidator(ctx, newValidator)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
 		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
 	}
 
+	// rescale voting power of validators if total stake outgrew power shift
+	if k.UpdatePowerShift(ctx) {
+		newValidator, _ = k.GetValidatorFromValID(ctx, newValidator.ID)
+	}
+
 	// Add Validator signing info. It is required for slashing module
 	k.Logger(ctx).Debug("Adding signing info for new validator")
 	valSigningInfo := hmTypes.NewValidatorSigningInfo(newValidator.ID, ctx.BlockHeight(), int64(0), int64(0))
@@ -375,6 +381,7 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k Keeper, msg types.MsgValidato
 
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.AddValidatorHistory(ctx, newValidator, types.HistoryEventJoin)
 	k.Logger(ctx).Debug("✅ New validator successfully joined", "validator", strconv.FormatUint(newValidator.ID.Uint64(), 10))
 
 	// TX bytes
@@ -435,21 +442,26 @@ func PostHandleMsgStakeUpdate(ctx sdk.Context, k Keeper, msg types.MsgStakeUpdat
 	validator.Nonce = msg.Nonce
 
 	// set validator amount
-	p, err := helper.GetPowerFromAmount(msg.NewAmount.BigInt())
-	if err != nil {
+	if _, err := helper.GetPowerFromAmount(msg.NewAmount.BigInt()); err != nil {
 		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.NewAmount, msg.ID)).Result()
 	}
-	validator.VotingPower = p.Int64()
+	k.SetValidatorStake(ctx, &validator, msg.NewAmount.BigInt())
 
 	// save validator
-	err = k.AddValidator(ctx, validator)
+	err := k.AddValidator(ctx, validator)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
 		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
 	}
 
+	// rescale voting power of validators if total stake outgrew power shift
+	if k.UpdatePowerShift(ctx) {
+		validator, _ = k.GetValidatorFromValID(ctx, validator.ID)
+	}
+
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.AddValidatorHistory(ctx, validator, types.HistoryEventStakeUpdate)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -551,6 +563,8 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k Keeper, msg types.MsgSignerUpd
 
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.AddValidatorHistory(ctx, *oldValidator, types.HistoryEventSignerUpdate)
+	k.AddValidatorHistory(ctx, validator, types.HistoryEventSignerUpdate)
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -633,6 +647,12 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k Keeper, msg types.MsgValidato
 
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.AddValidatorHistory(ctx, validator, types.HistoryEventExit)
+
+	// track exit until unbonding, once validator exit status upgrade has run
+	if msg.DeactivationEpoch != 0 && k.IsValidatorExitTracked(ctx) {
+		k.SetValidatorExit(ctx, types.NewValidatorExit(validator.ID, msg.DeactivationEpoch, ctx.BlockHeight()))
+	}
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -1287,6 +1287,11 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgValidatorExit() {
 		currentVals := keeper.GetCurrentValidators(ctx)
 		require.Equal(t, 4, len(currentVals), "No of current validators should exist before epoch passes")
 
+		exit, ok := keeper.GetValidatorExit(ctx, validators[0].ID)
+		require.True(t, ok, "Exit should be tracked")
+		require.Equal(t, types.ValidatorStatusExiting, exit.Status)
+		require.Equal(t, uint64(10), exit.DeactivationEpoch)
+
 		app.CheckpointKeeper.UpdateACKCountWithValue(ctx, 20)
 		currentVals = keeper.GetCurrentValidators(ctx)
 		require.Equal(t, 3, len(currentVals), "No of current validators should reduce after epoch passes")
@@ -0,0 +1,117 @@
+package simulation
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// ContractCaller fakes the main chain for simulated staking messages. Every
+// simulated L1 transaction gets a random hash and a fresh block number, and the
+// staking event recorded with it is returned by the matching decode call.
+// Calls not used by staking side handlers are not implemented.
+type ContractCaller struct {
+	helper.IContractCaller
+
+	blockNumber uint64
+	txs         map[common.Hash]mainchainTx
+}
+
+type mainchainTx struct {
+	receipt  *ethTypes.Receipt
+	logIndex uint64
+	event    interface{}
+}
+
+// NewContractCaller creates a fake main chain contract caller
+func NewContractCaller() *ContractCaller {
+	return &ContractCaller{
+		txs: make(map[common.Hash]mainchainTx),
+	}
+}
+
+// AddTx records a main chain tx emitting event and returns its receipt and log index
+func (c *ContractCaller) AddTx(r *rand.Rand, event interface{}) (*ethTypes.Receipt, uint64) {
+	var txHash common.Hash
+	r.Read(txHash[:])
+
+	c.blockNumber += uint64(1 + r.Intn(10))
+	receipt := &ethTypes.Receipt{
+		TxHash:      txHash,
+		BlockNumber: new(big.Int).SetUint64(c.blockNumber),
+	}
+
+	logIndex := uint64(r.Intn(10))
+	c.txs[txHash] = mainchainTx{
+		receipt:  receipt,
+		logIndex: logIndex,
+		event:    event,
+	}
+
+	return receipt, logIndex
+}
+
+// GetConfirmedTxReceipt returns receipt of a recorded tx
+func (c *ContractCaller) GetConfirmedTxReceipt(txHash common.Hash, _ uint64) (*ethTypes.Receipt, error) {
+	tx, ok := c.txs[txHash]
+	if !ok {
+		return nil, errors.New("Tx not found")
+	}
+
+	return tx.receipt, nil
+}
+
+// DecodeValidatorJoinEvent returns recorded staked event
+func (c *ContractCaller) DecodeValidatorJoinEvent(_ common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStaked, error) {
+	event, ok := c.getEvent(receipt, logIndex).(*stakinginfo.StakinginfoStaked)
+	if !ok {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorStakeUpdateEvent returns recorded stake update event
+func (c *ContractCaller) DecodeValidatorStakeUpdateEvent(_ common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
+	event, ok := c.getEvent(receipt, logIndex).(*stakinginfo.StakinginfoStakeUpdate)
+	if !ok {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeSignerUpdateEvent returns recorded signer change event
+func (c *ContractCaller) DecodeSignerUpdateEvent(_ common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoSignerChange, error) {
+	event, ok := c.getEvent(receipt, logIndex).(*stakinginfo.StakinginfoSignerChange)
+	if !ok {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorExitEvent returns recorded unstake init event
+func (c *ContractCaller) DecodeValidatorExitEvent(_ common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	event, ok := c.getEvent(receipt, logIndex).(*stakinginfo.StakinginfoUnstakeInit)
+	if !ok {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+func (c *ContractCaller) getEvent(receipt *ethTypes.Receipt, logIndex uint64) interface{} {
+	tx, ok := c.txs[receipt.TxHash]
+	if !ok || tx.logIndex != logIndex {
+		return nil
+	}
+
+	return tx.event
+}
@@ -27,7 +27,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	for i := 0; i < len(validators); i++ {
 		// validator
 		validators[i] = hmTypes.NewValidator(
-			hmTypes.NewValidatorID(uint64(int64(i))),
+			hmTypes.NewValidatorID(uint64(int64(i+1))), // validator IDs start at 1 on stake manager
 			0,
 			0,
 			1,
@@ -40,6 +40,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// validator set
 	validatorSet := hmTypes.NewValidatorSet(validators)
 
-	genesisState := types.NewGenesisState(validators, *validatorSet, stakingSequence)
+	genesisState := types.NewGenesisState(types.DefaultParams(), validators, *validatorSet, stakingSequence, nil, 0, nil, nil, 0)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
 }
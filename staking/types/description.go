@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Length limits of validator description fields
+const (
+	MaxMonikerLength         = 70
+	MaxWebsiteLength         = 140
+	MaxSecurityContactLength = 140
+	MaxDetailsLength         = 280
+)
+
+// Description is the public description of a validator, set by its signer
+type Description struct {
+	Moniker         string `json:"moniker"`
+	Website         string `json:"website"`
+	SecurityContact string `json:"security_contact"`
+	Details         string `json:"details"`
+}
+
+// NewDescription creates a new Description
+func NewDescription(moniker string, website string, securityContact string, details string) Description {
+	return Description{
+		Moniker:         moniker,
+		Website:         website,
+		SecurityContact: securityContact,
+		Details:         details,
+	}
+}
+
+// Validate checks moniker is set and field lengths are within limits
+func (d Description) Validate() error {
+	if strings.TrimSpace(d.Moniker) == "" {
+		return errors.New("moniker cannot be empty")
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"moniker", d.Moniker, MaxMonikerLength},
+		{"website", d.Website, MaxWebsiteLength},
+		{"security contact", d.SecurityContact, MaxSecurityContactLength},
+		{"details", d.Details, MaxDetailsLength},
+	} {
+		if len(field.value) > field.max {
+			return fmt.Errorf("invalid %s length; got: %d, max: %d", field.name, len(field.value), field.max)
+		}
+	}
+
+	return nil
+}
+
+// ValidatorDescription is the description of a validator in genesis
+type ValidatorDescription struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+	Description Description         `json:"description"`
+}
+
+// ValidatorWithDescription is a validator with its description, if set
+type ValidatorWithDescription struct {
+	hmTypes.Validator
+	Description *Description `json:"description,omitempty"`
+}
+
+// ValidatorSetWithDescriptions is a validator set with descriptions of its validators
+type ValidatorSetWithDescriptions struct {
+	Validators []*ValidatorWithDescription `json:"validators"`
+	Proposer   *ValidatorWithDescription   `json:"proposer"`
+}
@@ -8,12 +8,17 @@ var (
 	EventTypeStakeUpdate   = "stake-update"
 	EventTypeValidatorExit = "validator-exit"
 
+	EventTypeValidatorDescription = "validator-description"
+	EventTypeValidatorStatus      = "validator-status"
+
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyValidatorNonce    = "validator-nonce"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyMoniker           = "moniker"
+	AttributeKeyStatus            = "status"
 
 	AttributeValueCategory = ModuleName
 )
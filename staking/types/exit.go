@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Exit statuses of validator, along with exited of history statuses. Unbonding is not
+// reported by stake manager events, it is projected from the withdrawal delay param.
+const (
+	ValidatorStatusExiting           = "exiting"            // exit requested, still in validator set until end epoch
+	ValidatorStatusUnbondedProjected = "unbonded-projected" // withdrawal delay after end epoch passed, stake can be claimed on stake manager
+)
+
+// ProjectionAckWindow is the number of latest checkpoint acks averaged to project heights of epochs
+const ProjectionAckWindow = uint64(10)
+
+// ValidatorExit tracks a validator from exit request on stake manager until its unbonding completes
+type ValidatorExit struct {
+	ID                hmTypes.ValidatorID `json:"ID"`
+	Status            string              `json:"status"`
+	DeactivationEpoch uint64              `json:"deactivation_epoch"`
+	RequestedHeight   int64               `json:"requested_height"` // height at which exit was accepted, 0 if unknown
+	ExitedHeight      int64               `json:"exited_height"`    // height at which validator left validator set, 0 if unknown
+	UnbondedHeight    int64               `json:"unbonded_height"`  // height at which projected unbonding was reached, 0 if unknown
+}
+
+// NewValidatorExit creates a new ValidatorExit of exit requested at height
+func NewValidatorExit(valID hmTypes.ValidatorID, deactivationEpoch uint64, height int64) ValidatorExit {
+	return ValidatorExit{
+		ID:                valID,
+		Status:            ValidatorStatusExiting,
+		DeactivationEpoch: deactivationEpoch,
+		RequestedHeight:   height,
+	}
+}
+
+// UnbondEpoch returns epoch at which unbonding completes with withdrawal delay
+func (e ValidatorExit) UnbondEpoch(withdrawalDelay uint64) uint64 {
+	return e.DeactivationEpoch + withdrawalDelay
+}
+
+// NextStatus returns status of exit at checkpoint ack count. Validator leaves validator set at
+// deactivation epoch and is projected to be unbonded once withdrawal delay after it passed.
+func (e ValidatorExit) NextStatus(ackCount uint64, withdrawalDelay uint64) string {
+	// current epoch will be ack count + 1
+	currentEpoch := ackCount + 1
+
+	switch {
+	case currentEpoch >= e.UnbondEpoch(withdrawalDelay):
+		return ValidatorStatusUnbondedProjected
+	case currentEpoch >= e.DeactivationEpoch:
+		return ValidatorStatusExited
+	default:
+		return ValidatorStatusExiting
+	}
+}
+
+// NextTransitionEpoch returns epoch at which status of exit changes next, 0 once unbonded
+func (e ValidatorExit) NextTransitionEpoch(withdrawalDelay uint64) uint64 {
+	switch e.Status {
+	case ValidatorStatusExiting:
+		return e.DeactivationEpoch
+	case ValidatorStatusExited:
+		return e.UnbondEpoch(withdrawalDelay)
+	default:
+		return 0
+	}
+}
+
+// Validate checks status and deactivation epoch of exit
+func (e ValidatorExit) Validate() error {
+	switch e.Status {
+	case ValidatorStatusExiting, ValidatorStatusExited, ValidatorStatusUnbondedProjected:
+	default:
+		return fmt.Errorf("invalid exit status of validator %v: %s", e.ID, e.Status)
+	}
+
+	if e.DeactivationEpoch == 0 {
+		return fmt.Errorf("invalid deactivation epoch of validator %v", e.ID)
+	}
+
+	return nil
+}
+
+// ValidatorStatusInfo is the status of a validator along with its exit schedule. Epochs are
+// checkpoint ack count + 1, projected heights are estimated from average interval of recent acks.
+// Unbonding is projected from withdrawal delay, stake is unbonded on stake manager once claimed.
+type ValidatorStatusInfo struct {
+	ID           hmTypes.ValidatorID     `json:"ID"`
+	Signer       hmTypes.HeimdallAddress `json:"signer"`
+	Status       string                  `json:"status"`
+	IsCurrent    bool                    `json:"is_current"` // validator is in current validator set
+	Jailed       bool                    `json:"jailed"`
+	CurrentEpoch uint64                  `json:"current_epoch"`
+	StartEpoch   uint64                  `json:"start_epoch"`
+
+	DeactivationEpoch   uint64 `json:"deactivation_epoch,omitempty"`
+	UnbondEpoch         uint64 `json:"unbond_epoch,omitempty"`
+	LastCheckpoint      uint64 `json:"last_checkpoint,omitempty"` // last checkpoint validator can sign
+	LastCheckpointAcked bool   `json:"last_checkpoint_acked"`
+
+	RequestedHeight int64 `json:"requested_height,omitempty"`
+	ExitedHeight    int64 `json:"exited_height,omitempty"`
+	UnbondedHeight  int64 `json:"unbonded_height,omitempty"`
+
+	ProjectedStartHeight  int64 `json:"projected_start_height,omitempty"`
+	ProjectedExitHeight   int64 `json:"projected_exit_height,omitempty"`
+	ProjectedUnbondHeight int64 `json:"projected_unbond_height,omitempty"`
+}
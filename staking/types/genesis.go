@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -34,32 +35,55 @@ func (v *GenesisValidator) HeimdallValidator() hmTypes.Validator {
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
-	Validators       []*hmTypes.Validator `json:"validators" yaml:"validators"`
-	CurrentValSet    hmTypes.ValidatorSet `json:"current_val_set" yaml:"current_val_set"`
-	StakingSequences []string             `json:"staking_sequences" yaml:"staking_sequences"`
+	Params           Params                 `json:"params" yaml:"params"`
+	Validators       []*hmTypes.Validator   `json:"validators" yaml:"validators"`
+	CurrentValSet    hmTypes.ValidatorSet   `json:"current_val_set" yaml:"current_val_set"`
+	StakingSequences []string               `json:"staking_sequences" yaml:"staking_sequences"`
+	Descriptions     []ValidatorDescription `json:"descriptions" yaml:"descriptions"`
+	PowerShift       uint64                 `json:"power_shift" yaml:"power_shift"`
+	Exits            []ValidatorExit        `json:"exits" yaml:"exits"`
+
+	History      []ValidatorHistoryWithSequence `json:"history" yaml:"history"`
+	HistoryCount uint64                         `json:"history_count" yaml:"history_count"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
+	params Params,
 	validators []*hmTypes.Validator,
 	currentValSet hmTypes.ValidatorSet,
 	stakingSequences []string,
+	descriptions []ValidatorDescription,
+	powerShift uint64,
+	exits []ValidatorExit,
+	history []ValidatorHistoryWithSequence,
+	historyCount uint64,
 ) GenesisState {
 	return GenesisState{
+		Params:           params,
 		Validators:       validators,
 		CurrentValSet:    currentValSet,
 		StakingSequences: stakingSequences,
+		Descriptions:     descriptions,
+		PowerShift:       powerShift,
+		Exits:            exits,
+		History:          history,
+		HistoryCount:     historyCount,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil)
+	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil, nil, 0, nil, nil, 0)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, validator := range data.Validators {
 		if !validator.ValidateBasic() {
 			return errors.New("Invalid validator")
@@ -71,6 +95,29 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, d := range data.Descriptions {
+		if err := d.Description.Validate(); err != nil {
+			return fmt.Errorf("Invalid description of validator %v: %v", d.ValidatorID, err)
+		}
+	}
+
+	for _, exit := range data.Exits {
+		if err := exit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	sequences := make(map[uint64]bool)
+	for _, h := range data.History {
+		if h.Sequence >= data.HistoryCount {
+			return fmt.Errorf("Invalid validator history sequence %v, history count is %v", h.Sequence, data.HistoryCount)
+		}
+		if sequences[h.Sequence] {
+			return fmt.Errorf("Duplicate validator history sequence %v", h.Sequence)
+		}
+		sequences[h.Sequence] = true
+	}
+
 	return nil
 }
 
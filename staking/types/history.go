@@ -0,0 +1,100 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Events of validator history records
+const (
+	HistoryEventSnapshot           = "snapshot" // state of validator when history was enabled
+	HistoryEventJoin               = "join"
+	HistoryEventStakeUpdate        = "stake-update"
+	HistoryEventSignerUpdate       = "signer-update"
+	HistoryEventExit               = "exit"
+	HistoryEventSlash              = "slash"
+	HistoryEventUnjail             = "unjail"
+	HistoryEventValidatorSetChange = "validator-set-change"
+)
+
+// Validator status in history records
+const (
+	ValidatorStatusActive   = "active"
+	ValidatorStatusPending  = "pending" // start epoch not reached yet
+	ValidatorStatusJailed   = "jailed"
+	ValidatorStatusExited   = "exited"   // end epoch reached
+	ValidatorStatusInactive = "inactive" // no voting power
+)
+
+// ValidatorHistoryRecord is the state of a validator after a change at a heimdall height
+type ValidatorHistoryRecord struct {
+	ID          hmTypes.ValidatorID     `json:"ID"`
+	Height      int64                   `json:"height"`
+	Epoch       uint64                  `json:"epoch"` // checkpoint ack count at height
+	Event       string                  `json:"event"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	VotingPower int64                   `json:"power"`
+	StartEpoch  uint64                  `json:"startEpoch"`
+	EndEpoch    uint64                  `json:"endEpoch"`
+	Nonce       uint64                  `json:"nonce"`
+	Jailed      bool                    `json:"jailed"`
+	Status      string                  `json:"status"`
+}
+
+// NewValidatorHistoryRecord creates a new ValidatorHistoryRecord of validator at height and epoch
+func NewValidatorHistoryRecord(validator hmTypes.Validator, height int64, epoch uint64, event string) ValidatorHistoryRecord {
+	return ValidatorHistoryRecord{
+		ID:          validator.ID,
+		Height:      height,
+		Epoch:       epoch,
+		Event:       event,
+		Signer:      validator.Signer,
+		VotingPower: validator.VotingPower,
+		StartEpoch:  validator.StartEpoch,
+		EndEpoch:    validator.EndEpoch,
+		Nonce:       validator.Nonce,
+		Jailed:      validator.Jailed,
+		Status:      ValidatorStatus(validator, epoch),
+	}
+}
+
+// ValidatorHistoryWithSequence is a validator history record along with sequence it was written with
+type ValidatorHistoryWithSequence struct {
+	Sequence uint64                 `json:"sequence" yaml:"sequence"`
+	Record   ValidatorHistoryRecord `json:"record" yaml:"record"`
+}
+
+// NewValidatorHistoryWithSequence creates a new ValidatorHistoryWithSequence
+func NewValidatorHistoryWithSequence(sequence uint64, record ValidatorHistoryRecord) ValidatorHistoryWithSequence {
+	return ValidatorHistoryWithSequence{
+		Sequence: sequence,
+		Record:   record,
+	}
+}
+
+// ValidatorStatus returns status of validator at checkpoint ack count
+func ValidatorStatus(validator hmTypes.Validator, ackCount uint64) string {
+	// current epoch will be ack count + 1
+	currentEpoch := ackCount + 1
+
+	switch {
+	case validator.Jailed:
+		return ValidatorStatusJailed
+	case validator.IsCurrentValidator(ackCount):
+		return ValidatorStatusActive
+	case validator.EndEpoch != 0 && validator.EndEpoch <= currentEpoch:
+		return ValidatorStatusExited
+	case validator.StartEpoch > currentEpoch:
+		return ValidatorStatusPending
+	default:
+		return ValidatorStatusInactive
+	}
+}
+
+// InRange checks if record is in height and epoch range, zero upper bounds are open
+func (r ValidatorHistoryRecord) InRange(fromHeight int64, toHeight int64, fromEpoch uint64, toEpoch uint64) bool {
+	if r.Height < fromHeight || (toHeight != 0 && r.Height > toHeight) {
+		return false
+	}
+
+	return r.Epoch >= fromEpoch && (toEpoch == 0 || r.Epoch <= toEpoch)
+}
@@ -372,3 +372,62 @@ func (msg MsgValidatorExit) GetSideSignBytes() []byte {
 func (msg MsgValidatorExit) GetNonce() uint64 {
 	return msg.Nonce
 }
+
+//
+// Validator description
+//
+
+var _ sdk.Msg = &MsgValidatorDescription{}
+
+// MsgValidatorDescription sets description of validator, signed by validator signer. It is charged
+// the default tx fee (auth param tx_fees) like any other tx, there is no separate description fee.
+type MsgValidatorDescription struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Description Description             `json:"description"`
+}
+
+// NewMsgValidatorDescription creates new validator-description msg
+func NewMsgValidatorDescription(from hmTypes.HeimdallAddress, id uint64, description Description) MsgValidatorDescription {
+	return MsgValidatorDescription{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Description: description,
+	}
+}
+
+func (msg MsgValidatorDescription) Type() string {
+	return "validator-description"
+}
+
+func (msg MsgValidatorDescription) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorDescription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorDescription) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorDescription) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid signer %v", msg.From.String())
+	}
+
+	if err := msg.Description.Validate(); err != nil {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid description: %v", err)
+	}
+
+	return nil
+}
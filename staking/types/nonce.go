@@ -0,0 +1,95 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MaxNonceGapBlockRange is the max number of rootchain blocks scanned for missing staking events
+const MaxNonceGapBlockRange = uint64(100000)
+
+// ValidatorNonce is the heimdall nonce of a validator
+type ValidatorNonce struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+	Nonce       uint64              `json:"nonce"`
+}
+
+// MissingStakingEvent is a StakingInfo event on rootchain not processed by heimdall
+type MissingStakingEvent struct {
+	Nonce       uint64               `json:"nonce"`
+	Event       string               `json:"event"`
+	TxHash      hmTypes.HeimdallHash `json:"tx_hash"`
+	LogIndex    uint64               `json:"log_index"`
+	BlockNumber uint64               `json:"block_number"`
+}
+
+// NonceGap is the difference between heimdall and rootchain nonce of a validator
+type NonceGap struct {
+	ValidatorID    hmTypes.ValidatorID   `json:"validator_id"`
+	HeimdallNonce  uint64                `json:"heimdall_nonce"`
+	RootChainNonce uint64                `json:"rootchain_nonce"`
+	MissingNonces  []uint64              `json:"missing_nonces"`
+	Events         []MissingStakingEvent `json:"events"` // missing events found in block range, ordered by nonce
+}
+
+// NewNonceGaps compares heimdall nonces with rootchain nonces, ordered by validator ID.
+// A validator missing in heimdall nonces has heimdall nonce 0. Rootchain events of missing nonces are added to the gaps.
+func NewNonceGaps(heimdallNonces map[hmTypes.ValidatorID]uint64, rootChainNonces map[hmTypes.ValidatorID]uint64, events []helper.StakingEvent) []NonceGap {
+	ids := make([]hmTypes.ValidatorID, 0, len(rootChainNonces))
+	for id := range rootChainNonces {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	gaps := []NonceGap{}
+	gapIndex := make(map[hmTypes.ValidatorID]int)
+	for _, id := range ids {
+		heimdallNonce, rootChainNonce := heimdallNonces[id], rootChainNonces[id]
+		if rootChainNonce <= heimdallNonce {
+			continue
+		}
+
+		gap := NonceGap{
+			ValidatorID:    id,
+			HeimdallNonce:  heimdallNonce,
+			RootChainNonce: rootChainNonce,
+			Events:         []MissingStakingEvent{},
+		}
+		for nonce := heimdallNonce + 1; nonce <= rootChainNonce; nonce++ {
+			gap.MissingNonces = append(gap.MissingNonces, nonce)
+		}
+
+		gapIndex[id] = len(gaps)
+		gaps = append(gaps, gap)
+	}
+
+	for _, event := range events {
+		index, ok := gapIndex[hmTypes.ValidatorID(event.ValidatorID)]
+		if !ok {
+			continue
+		}
+
+		gap := &gaps[index]
+		if event.Nonce <= gap.HeimdallNonce || event.Nonce > gap.RootChainNonce {
+			continue
+		}
+
+		gap.Events = append(gap.Events, MissingStakingEvent{
+			Nonce:       event.Nonce,
+			Event:       event.Name,
+			TxHash:      hmTypes.BytesToHeimdallHash(event.TxHash.Bytes()),
+			LogIndex:    event.LogIndex,
+			BlockNumber: event.BlockNumber,
+		})
+	}
+
+	for i := range gaps {
+		sort.SliceStable(gaps[i].Events, func(a, b int) bool {
+			return gaps[i].Events[a].Nonce < gaps[i].Events[b].Nonce
+		})
+	}
+
+	return gaps
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+
 	"github.com/maticnetwork/heimdall/params/subspace"
 )
 
@@ -8,14 +12,93 @@ const (
 
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultHistoryRetention keeps validator history forever
+	DefaultHistoryRetention = uint64(0)
+
+	// DefaultHistoryPruneLimit - max validator history records pruned per block
+	DefaultHistoryPruneLimit = uint64(100)
+
+	// DefaultWithdrawalDelay - checkpoints after deactivation epoch until stake can be claimed on stake manager
+	DefaultWithdrawalDelay = uint64(80)
 )
 
 // ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
 var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
 
+// Parameter keys
+var (
+	KeyHistoryRetention  = []byte("HistoryRetention")
+	KeyHistoryPruneLimit = []byte("HistoryPruneLimit")
+	KeyWithdrawalDelay   = []byte("WithdrawalDelay")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the staking module.
+type Params struct {
+	// heimdall blocks validator history records are kept for, 0 keeps them forever
+	HistoryRetention uint64 `json:"history_retention" yaml:"history_retention"`
+	// max validator history records pruned per block
+	HistoryPruneLimit uint64 `json:"history_prune_limit" yaml:"history_prune_limit"`
+	// checkpoints after deactivation epoch until unbonding completes, mirrors withdrawalDelay of stake manager
+	WithdrawalDelay uint64 `json:"withdrawal_delay" yaml:"withdrawal_delay"`
+}
+
+// NewParams creates a new Params object
+func NewParams(historyRetention uint64, historyPruneLimit uint64, withdrawalDelay uint64) Params {
+	return Params{
+		HistoryRetention:  historyRetention,
+		HistoryPruneLimit: historyPruneLimit,
+		WithdrawalDelay:   withdrawalDelay,
+	}
+}
+
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable(
 		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
-	)
+	).RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of staking module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeyHistoryRetention, &p.HistoryRetention},
+		{KeyHistoryPruneLimit, &p.HistoryPruneLimit},
+		{KeyWithdrawalDelay, &p.WithdrawalDelay},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultHistoryRetention, DefaultHistoryPruneLimit, DefaultWithdrawalDelay)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("HistoryRetention: %d\n", p.HistoryRetention))
+	sb.WriteString(fmt.Sprintf("HistoryPruneLimit: %d\n", p.HistoryPruneLimit))
+	sb.WriteString(fmt.Sprintf("WithdrawalDelay: %d\n", p.WithdrawalDelay))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.HistoryRetention != 0 && p.HistoryPruneLimit == 0 {
+		return fmt.Errorf("history_prune_limit must be positive when history_retention is set")
+	}
+
+	return nil
 }
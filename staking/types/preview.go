@@ -0,0 +1,26 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Limits of validator set preview
+const (
+	DefaultPreviewEpochs = uint64(10)
+	MaxPreviewEpochs     = uint64(100)
+)
+
+// ValidatorSetPreview is the simulated validator set at a checkpoint epoch
+type ValidatorSetPreview struct {
+	Epoch      uint64                    `json:"epoch"`    // checkpoint ack count
+	Entering   []hmTypes.Validator       `json:"entering"` // validators joining set at epoch
+	Exiting    []hmTypes.Validator       `json:"exiting"`  // validators leaving set at epoch, with power before leaving
+	Updated    []hmTypes.Validator       `json:"updated"`  // validators with changed power at epoch
+	TotalPower int64                     `json:"totalPower"`
+	Proposers  []hmTypes.HeimdallAddress `json:"proposers"` // proposer order, starting with proposer of next checkpoint
+}
+
+// HasChanges returns true if validator set changes at epoch
+func (p ValidatorSetPreview) HasChanges() bool {
+	return len(p.Entering) > 0 || len(p.Exiting) > 0 || len(p.Updated) > 0
+}
@@ -10,11 +10,17 @@ const (
 	QuerySigner               = "signer"
 	QueryValidator            = "validator"
 	QueryValidatorStatus      = "validator-status"
+	QueryValidatorExitStatus  = "validator-exit-status"
 	QueryProposer             = "proposer"
 	QueryTotalValidatorPower  = "total-val-power"
 	QueryCurrentProposer      = "current-proposer"
 	QueryProposerBonusPercent = "proposer-bonus-percent"
 	QueryStakingSequence      = "staking-sequence"
+	QueryValidatorHistory     = "validator-history"
+	QueryParams               = "params"
+	QueryValidatorSetPreview  = "validator-set-preview"
+	QueryValidatorNonces      = "validator-nonces"
+	QueryProposerSchedule     = "proposer-schedule"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -72,3 +78,44 @@ func NewQueryValidatorStatusParams(signerAddress []byte) QueryValidatorStatusPar
 func NewQueryStakingSequenceParams(txHash string, logIndex uint64) QueryStakingSequenceParams {
 	return QueryStakingSequenceParams{TxHash: txHash, LogIndex: logIndex}
 }
+
+// QueryValidatorHistoryParams defines the params for querying validator history by height or epoch range.
+// Zero upper bounds are open.
+type QueryValidatorHistoryParams struct {
+	ValidatorID types.ValidatorID `json:"validator_id"`
+	FromHeight  int64             `json:"from_height"`
+	ToHeight    int64             `json:"to_height"`
+	FromEpoch   uint64            `json:"from_epoch"`
+	ToEpoch     uint64            `json:"to_epoch"`
+}
+
+// NewQueryValidatorHistoryParams creates a new instance of QueryValidatorHistoryParams.
+func NewQueryValidatorHistoryParams(validatorID types.ValidatorID, fromHeight int64, toHeight int64, fromEpoch uint64, toEpoch uint64) QueryValidatorHistoryParams {
+	return QueryValidatorHistoryParams{
+		ValidatorID: validatorID,
+		FromHeight:  fromHeight,
+		ToHeight:    toHeight,
+		FromEpoch:   fromEpoch,
+		ToEpoch:     toEpoch,
+	}
+}
+
+// QueryValidatorSetPreviewParams defines the params for previewing validator set changes of next epochs.
+type QueryValidatorSetPreviewParams struct {
+	Epochs uint64 `json:"epochs"`
+}
+
+// NewQueryValidatorSetPreviewParams creates a new instance of QueryValidatorSetPreviewParams.
+func NewQueryValidatorSetPreviewParams(epochs uint64) QueryValidatorSetPreviewParams {
+	return QueryValidatorSetPreviewParams{Epochs: epochs}
+}
+
+// QueryProposerScheduleParams defines the params for projecting checkpoint proposer schedule of next epochs.
+type QueryProposerScheduleParams struct {
+	Epochs uint64 `json:"epochs"`
+}
+
+// NewQueryProposerScheduleParams creates a new instance of QueryProposerScheduleParams.
+func NewQueryProposerScheduleParams(epochs uint64) QueryProposerScheduleParams {
+	return QueryProposerScheduleParams{Epochs: epochs}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Limits of proposer schedule
+const (
+	DefaultScheduleEpochs = uint64(48)
+	MaxScheduleEpochs     = uint64(1000)
+)
+
+// ProposerSlot is the expected checkpoint proposer at an epoch
+type ProposerSlot struct {
+	Epoch       uint64                  `json:"epoch"` // checkpoint ack count
+	ValidatorID hmTypes.ValidatorID     `json:"ID"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+}
+
+// ProposerShare is the expected share of checkpoint proposals of a validator over schedule epochs
+type ProposerShare struct {
+	ValidatorID hmTypes.ValidatorID     `json:"ID"`
+	Signer      hmTypes.HeimdallAddress `json:"signer"`
+	Proposals   uint64                  `json:"proposals"`
+	Share       sdk.Dec                 `json:"share"`  // proposals over schedule epochs
+	Epochs      []uint64                `json:"epochs"` // epochs of proposer turns
+}
+
+// ProposerSchedule is the projected checkpoint proposer schedule of next epochs
+type ProposerSchedule struct {
+	Epochs uint64          `json:"epochs"`
+	Slots  []ProposerSlot  `json:"slots"`
+	Shares []ProposerShare `json:"shares"`
+}
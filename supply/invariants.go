@@ -0,0 +1,57 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/supply/types"
+)
+
+// RegisterInvariants registers all supply invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupplyInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the supply module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalSupplyInvariant(keeper)(ctx)
+	}
+}
+
+// TotalSupplyInvariant checks that the total supply equals the coins held by all accounts,
+// module accounts included. Fee tokens withdrawn to dividend accounts leave the accounts,
+// so the fee token supply is checked by the topup invariants instead.
+func TotalSupplyInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedTotal sdk.Coins
+		keeper.ak.IterateAccounts(ctx, func(acc authTypes.Account) bool {
+			expectedTotal = expectedTotal.Add(acc.GetCoins())
+			return false
+		})
+
+		supply := keeper.GetSupply(ctx)
+
+		total := withoutDenom(supply.Total, authTypes.FeeToken)
+		expectedTotal = withoutDenom(expectedTotal, authTypes.FeeToken)
+		broken := !total.IsEqual(expectedTotal)
+
+		return sdk.FormatInvariant(types.ModuleName, "total supply",
+			fmt.Sprintf("\tsum of accounts coins: %v\n\tsupply.Total:          %v\n",
+				expectedTotal, total)), broken
+	}
+}
+
+// withoutDenom returns the coins without the given denom
+func withoutDenom(coins sdk.Coins, denom string) sdk.Coins {
+	result := sdk.NewCoins()
+	for _, coin := range coins {
+		if coin.Denom != denom {
+			result = append(result, coin)
+		}
+	}
+
+	return result
+}
@@ -1,6 +1,8 @@
 package topup
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/topup/types"
 )
@@ -12,12 +14,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	}
 
 	// Add genesis dividend accounts
+	feesCollected := big.NewInt(0)
 	for _, dividendAccount := range data.DividentAccounts {
 		if err := keeper.AddDividendAccount(ctx, dividendAccount); err != nil {
 			panic((err))
 		}
+
+		if fee, ok := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10); ok {
+			feesCollected.Add(feesCollected, fee)
+		}
 	}
 
+	// genesis fees collected are the fees of genesis dividend accounts
+	keeper.SetFeesCollected(ctx, feesCollected)
+
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
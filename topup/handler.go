@@ -98,6 +98,8 @@ func HandleMsgWithdrawFee(ctx sdk.Context, k Keeper, msg types.MsgWithdrawFee) s
 		return err.Result()
 	}
 
+	k.AddFeesCollected(ctx, feeAmount)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeFeeWithdraw,
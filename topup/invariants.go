@@ -0,0 +1,55 @@
+package topup
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all topup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dividend-accounts", DividendAccountsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the topup module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return DividendAccountsInvariant(keeper)(ctx)
+	}
+}
+
+// DividendAccountsInvariant checks that every dividend account holds a valid, non-negative fee amount
+// and that the sum of dividend accounts matches the fees collected. Fees collected are not tracked
+// on chains started before they were, so the sum is checked only if they are.
+func DividendAccountsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		dividends := big.NewInt(0)
+		var invalid []string
+
+		keeper.IterateDividendAccountsByPrefixAndApplyFn(ctx, DividendAccountMapKey, func(dividendAccount hmTypes.DividendAccount) error {
+			fee, ok := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10)
+			if !ok || fee.Sign() < 0 {
+				invalid = append(invalid, dividendAccount.User.String())
+				return nil
+			}
+
+			dividends.Add(dividends, fee)
+			return nil
+		})
+
+		broken := len(invalid) != 0
+
+		feesCollected, tracked := keeper.GetFeesCollected(ctx)
+		if tracked && feesCollected.Cmp(dividends) != 0 {
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dividend accounts",
+			fmt.Sprintf("\tsum of dividend accounts: %v\n\tfees collected:           %v\n\tinvalid dividend accounts: %v\n",
+				dividends, feesCollected, invalid)), broken
+	}
+}
@@ -24,6 +24,8 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+
+	FeesCollectedKey = []byte{0x83} // key for fees withdrawn to dividend accounts
 )
 
 // Keeper stores all related data
@@ -124,6 +126,31 @@ func (keeper *Keeper) HasTopupSequence(ctx sdk.Context, sequence string) bool {
 	return store.Has(GetTopupSequenceKey(sequence))
 }
 
+// GetFeesCollected returns the fees withdrawn to dividend accounts and whether they are tracked.
+// They are tracked from genesis, chains started before keep them untracked until their next genesis.
+func (keeper *Keeper) GetFeesCollected(ctx sdk.Context) (*big.Int, bool) {
+	store := ctx.KVStore(keeper.key)
+	if !store.Has(FeesCollectedKey) {
+		return nil, false
+	}
+
+	fees, ok := big.NewInt(0).SetString(string(store.Get(FeesCollectedKey)), 10)
+	return fees, ok
+}
+
+// SetFeesCollected sets the fees withdrawn to dividend accounts
+func (keeper *Keeper) SetFeesCollected(ctx sdk.Context, fees *big.Int) {
+	store := ctx.KVStore(keeper.key)
+	store.Set(FeesCollectedKey, []byte(fees.String()))
+}
+
+// AddFeesCollected adds fee withdrawn to dividend accounts, if fees collected are tracked
+func (keeper *Keeper) AddFeesCollected(ctx sdk.Context, fee *big.Int) {
+	if fees, ok := keeper.GetFeesCollected(ctx); ok {
+		keeper.SetFeesCollected(ctx, fees.Add(fees, fee))
+	}
+}
+
 // GetDividendAccountMapKey returns dividend account map
 func GetDividendAccountMapKey(address []byte) []byte {
 	return append(DividendAccountMapKey, address...)
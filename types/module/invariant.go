@@ -0,0 +1,54 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvarRoute is an invariant registered under a module route
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns the route of the invariant prefixed by its module name
+func (i InvarRoute) FullRoute() string {
+	return i.ModuleName + "/" + i.Route
+}
+
+// InvariantRegistry holds the invariants of all modules, in registration order
+type InvariantRegistry struct {
+	routes []InvarRoute
+}
+
+// NewInvariantRegistry creates a new, empty invariant registry
+func NewInvariantRegistry() *InvariantRegistry {
+	return &InvariantRegistry{}
+}
+
+// RegisterRoute registers an invariant of a module, implements sdk.InvariantRegistry
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns all registered invariants
+func (ir *InvariantRegistry) Routes() []InvarRoute {
+	return ir.routes
+}
+
+// CheckInvariants runs all invariants and returns the messages of the broken ones
+func (ir *InvariantRegistry) CheckInvariants(ctx sdk.Context) (broken []string) {
+	for _, route := range ir.routes {
+		// run on a cached context so that invariants never write state
+		cacheCtx, _ := ctx.CacheContext()
+		if msg, isBroken := route.Invar(cacheCtx); isBroken {
+			broken = append(broken, msg)
+		}
+	}
+
+	return broken
+}
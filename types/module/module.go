@@ -15,6 +15,10 @@ type HeimdallModuleBasic interface {
 	VerifyGenesis(map[string]json.RawMessage) error
 }
 
+// GenesisMigration migrates the genesis state of a module to a newer version. It is given the
+// whole app state of the previous version and returns the new genesis state of its module.
+type GenesisMigration func(appState map[string]json.RawMessage) (json.RawMessage, error)
+
 // SideModule is the standard form for side tx elements of an application module
 type SideModule interface {
 	NewSideTxHandler() types.SideTxHandler
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"math/big"
+)
+
+// PowerReduction is the amount of stake, in wei, per unit of unscaled voting power
+var PowerReduction = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// StakeToTokens returns stake in whole tokens, the unscaled voting power of stake
+func StakeToTokens(stake *big.Int) *big.Int {
+	return new(big.Int).Quo(stake, PowerReduction)
+}
+
+// StakeToPower returns voting power of stake at power shift.
+//
+// Voting power is stake in whole tokens divided by 2^shift. The shift is shared by
+// all validators and picked with RequiredPowerShift, so that total voting power of
+// the set stays within MaxTotalVotingPower however large stake grows, while every
+// validator keeps its share of power up to rounding. A validator with at least one
+// whole token keeps power of 1 however large the shift, so rescaling never drops it
+// out of the set.
+func StakeToPower(stake *big.Int, shift uint64) int64 {
+	tokens := StakeToTokens(stake)
+	power := new(big.Int).Rsh(tokens, uint(shift))
+	if power.Sign() == 0 && tokens.Sign() > 0 {
+		return 1
+	}
+
+	// shift from RequiredPowerShift of total stake never gets here
+	if power.Cmp(big.NewInt(MaxTotalVotingPower)) > 0 {
+		return MaxTotalVotingPower
+	}
+
+	return power.Int64()
+}
+
+// RequiredPowerShift returns smallest power shift keeping total voting power of validators within MaxTotalVotingPower.
+// Sum of voting powers of stakes is at most voting power of their sum, plus one per validator raised to minimum
+// power, so it bounds total power of the set.
+func RequiredPowerShift(totalStake *big.Int, validators int) uint64 {
+	tokens := StakeToTokens(totalStake)
+	maxPower := big.NewInt(MaxTotalVotingPower - int64(validators))
+
+	shift := uint64(0)
+	for new(big.Int).Rsh(tokens, uint(shift)).Cmp(maxPower) > 0 {
+		shift++
+	}
+
+	return shift
+}
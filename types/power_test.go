@@ -0,0 +1,125 @@
+package types
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tokens returns stake of n whole tokens
+func tokens(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), PowerReduction)
+}
+
+func TestStakeToPower(t *testing.T) {
+	require.Equal(t, int64(0), StakeToPower(big.NewInt(1), 0))
+	require.Equal(t, int64(0), StakeToPower(big.NewInt(1), 10))
+	require.Equal(t, int64(10), StakeToPower(tokens(10), 0))
+	require.Equal(t, int64(2), StakeToPower(tokens(10), 2))
+
+	// staked validators keep minimum power
+	require.Equal(t, int64(1), StakeToPower(tokens(1), 0))
+	require.Equal(t, int64(1), StakeToPower(tokens(10), 4))
+	require.Equal(t, int64(1), StakeToPower(tokens(10), 63))
+
+	// stake beyond int64 tokens
+	stake := new(big.Int).Lsh(tokens(3), 70)
+	require.Equal(t, int64(3), StakeToPower(stake, 70))
+	require.Equal(t, MaxTotalVotingPower, StakeToPower(stake, 0))
+}
+
+func TestRequiredPowerShift(t *testing.T) {
+	require.Equal(t, uint64(0), RequiredPowerShift(big.NewInt(0), 0))
+	require.Equal(t, uint64(0), RequiredPowerShift(tokens(MaxTotalVotingPower), 0))
+	require.Equal(t, uint64(1), RequiredPowerShift(tokens(MaxTotalVotingPower+1), 0))
+
+	// room for validators raised to minimum power
+	require.Equal(t, uint64(0), RequiredPowerShift(tokens(MaxTotalVotingPower-2), 2))
+	require.Equal(t, uint64(1), RequiredPowerShift(tokens(MaxTotalVotingPower-2), 3))
+
+	total := new(big.Int).Lsh(tokens(MaxTotalVotingPower), 100)
+	shift := RequiredPowerShift(total, 0)
+	require.Equal(t, uint64(100), shift)
+	require.Equal(t, MaxTotalVotingPower, StakeToPower(total, shift))
+}
+
+func TestValidatorStake(t *testing.T) {
+	// validator stored before stake was tracked
+	validator := Validator{VotingPower: 10}
+	require.Equal(t, tokens(10), validator.GetStake())
+
+	validator.SetStake(tokens(40), 2)
+	require.Equal(t, tokens(40).String(), validator.Stake)
+	require.Equal(t, int64(10), validator.VotingPower)
+	require.Equal(t, tokens(40), validator.GetStake())
+}
+
+// randomStakeSet returns validator set of random big stakes scaled by required power shift
+func randomStakeSet(r *rand.Rand) (*ValidatorSet, map[ValidatorID]*big.Int, *big.Int) {
+	n := 2 + r.Intn(20)
+
+	// token unit between 1 and 10^40 tokens, total stake far beyond int64
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(r.Intn(40))), nil)
+	stakes := make(map[ValidatorID]*big.Int, n)
+	totalStake := big.NewInt(0)
+	for i := 1; i <= n; i++ {
+		stake := new(big.Int).Mul(tokens(1+r.Int63n(1000000)), unit)
+		stakes[ValidatorID(i)] = stake
+		totalStake.Add(totalStake, stake)
+	}
+
+	shift := RequiredPowerShift(totalStake, n)
+	validators := make([]*Validator, 0, n)
+	for id, stake := range stakes {
+		validator := &Validator{ID: id, Signer: BytesToHeimdallAddress([]byte{byte(id)})}
+		validator.SetStake(stake, shift)
+		validators = append(validators, validator)
+	}
+
+	return NewValidatorSet(validators), stakes, totalStake
+}
+
+func TestScaledPowerPreservesShare(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		set, stakes, totalStake := randomStakeSet(r)
+		require.True(t, set.TotalVotingPower() <= MaxTotalVotingPower)
+
+		// share of power differs from share of stake by less than a unit of power per validator, up to float precision
+		delta := float64(len(set.Validators))/float64(set.TotalVotingPower()) + 1e-12
+		total := new(big.Float).SetInt64(set.TotalVotingPower())
+		for _, validator := range set.Validators {
+			powerShare, _ := new(big.Float).Quo(new(big.Float).SetInt64(validator.VotingPower), total).Float64()
+			stakeShare, _ := new(big.Float).Quo(new(big.Float).SetInt(stakes[validator.ID]), new(big.Float).SetInt(totalStake)).Float64()
+			require.InDelta(t, stakeShare, powerShare, delta)
+		}
+	}
+}
+
+func TestProposerFairnessWithScaledPower(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	rounds := 5000
+	for i := 0; i < 20; i++ {
+		set, _, _ := randomStakeSet(r)
+		totalPower := set.TotalVotingPower()
+
+		proposals := make(map[ValidatorID]int)
+		for j := 0; j < rounds; j++ {
+			proposals[set.GetProposer().ID]++
+			set.IncrementProposerPriority(1)
+
+			// priorities stay within window, never clipped
+			for _, validator := range set.Validators {
+				require.True(t, validator.ProposerPriority <= 3*totalPower && validator.ProposerPriority >= -3*totalPower)
+			}
+		}
+
+		// every validator proposes its share of rounds, within a proposal
+		for _, validator := range set.Validators {
+			expected := float64(rounds) * float64(validator.VotingPower) / float64(totalPower)
+			require.InDelta(t, expected, float64(proposals[validator.ID]), 1)
+		}
+	}
+}
@@ -19,6 +19,8 @@ import (
 // (Proof of 1 is tricky, left to the reader).
 // It could be higher, but this is sufficiently large for our purposes,
 // and leaves room for defensive purposes.
+// Voting power of validators is scaled by a set wide power shift (see StakeToPower),
+// so total voting power stays within it however large stake grows.
 // PriorityWindowSizeFactor - is a constant that when multiplied with the total voting power gives
 // the maximum allowed distance between validator priorities.
 
@@ -116,11 +118,14 @@ func (vals *ValidatorSet) RescalePriorities(diffMax int64) {
 	// Calculating ceil(diff/diffMax):
 	// Re-normalization is performed by dividing by an integer for simplicity.
 	// NOTE: This may make debugging priority issues easier as well.
+	// diff of int64 priorities may not fit in int64, so ratio is computed on big ints.
 	diff := computeMaxMinPriorityDiff(vals)
-	ratio := (diff + diffMax - 1) / diffMax
-	if diff > diffMax {
+	bigDiffMax := big.NewInt(diffMax)
+	if diff.Cmp(bigDiffMax) > 0 {
+		ratio := new(big.Int).Add(diff, bigDiffMax)
+		ratio.Sub(ratio, big.NewInt(1)).Quo(ratio, bigDiffMax)
 		for _, val := range vals.Validators {
-			val.ProposerPriority /= ratio
+			val.ProposerPriority = new(big.Int).Quo(big.NewInt(val.ProposerPriority), ratio).Int64()
 		}
 	}
 }
@@ -156,7 +161,7 @@ func (vals *ValidatorSet) computeAvgProposerPriority() int64 {
 }
 
 // Compute the difference between the max and min ProposerPriority of that set.
-func computeMaxMinPriorityDiff(vals *ValidatorSet) int64 {
+func computeMaxMinPriorityDiff(vals *ValidatorSet) *big.Int {
 	if vals.IsNilOrEmpty() {
 		panic("empty validator set")
 	}
@@ -170,12 +175,7 @@ func computeMaxMinPriorityDiff(vals *ValidatorSet) int64 {
 			max = v.ProposerPriority
 		}
 	}
-	diff := max - min
-	if diff < 0 {
-		return -1 * diff
-	} else {
-		return diff
-	}
+	return new(big.Int).Sub(big.NewInt(max), big.NewInt(min))
 }
 
 func (vals *ValidatorSet) getValWithMostPriority() *Validator {
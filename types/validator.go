@@ -17,13 +17,15 @@ type Validator struct {
 	StartEpoch  uint64          `json:"startEpoch"`
 	EndEpoch    uint64          `json:"endEpoch"`
 	Nonce       uint64          `json:"nonce"`
-	VotingPower int64           `json:"power"` // TODO add 10^-18 here so that we dont overflow easily
+	VotingPower int64           `json:"power"` // stake scaled by power shift, see StakeToPower
 	PubKey      PubKey          `json:"pubKey"`
 	Signer      HeimdallAddress `json:"signer"`
 	LastUpdated string          `json:"last_updated"`
 
 	Jailed           bool  `json:"jailed"`
 	ProposerPriority int64 `json:"accum"`
+
+	Stake string `json:"stake,omitempty"` // staked amount in wei
 }
 
 // NewValidator func creates a new validator,
@@ -48,6 +50,22 @@ func NewValidator(
 	}
 }
 
+// GetStake returns staked amount of validator. Validators stored before stake was tracked,
+// when voting power was not scaled, have the stake of their voting power.
+func (v *Validator) GetStake() *big.Int {
+	if stake, ok := new(big.Int).SetString(v.Stake, 10); ok {
+		return stake
+	}
+
+	return new(big.Int).Mul(big.NewInt(v.VotingPower), PowerReduction)
+}
+
+// SetStake sets staked amount of validator and its voting power at power shift
+func (v *Validator) SetStake(stake *big.Int, shift uint64) {
+	v.Stake = stake.String()
+	v.VotingPower = StakeToPower(stake, shift)
+}
+
 // SortValidatorByAddress sorts a slice of validators by address
 // to sort it we compare the values of the Signer(HeimdallAddress i.e. [20]byte)
 func SortValidatorByAddress(a []Validator) []Validator {
@@ -0,0 +1,33 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker checks if a scheduled upgrade is due at the current height.
+//
+// If the running binary has no handler registered for a due plan, the node
+// halts before processing the block so it can be restarted with the new
+// binary, which then replays the block and applies the store migrations.
+// Handlers usually ship with the binary that schedules their plan, so a
+// handler for a plan that is not due yet is expected. Code behind such a
+// handler stays inert until the handler has run.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if plan.ShouldExecute(ctx) {
+		if !k.HasHandler(plan.Name) {
+			upgradeMsg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+			k.Logger(ctx).Error(upgradeMsg)
+			panic(upgradeMsg)
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+		k.ApplyUpgrade(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), plan)
+	}
+}
@@ -0,0 +1,10 @@
+package cli
+
+const (
+	FlagValidatorID   = "validator-id"
+	FlagTitle         = "title"
+	FlagDescription   = "description"
+	FlagDeposit       = "deposit"
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "info"
+)
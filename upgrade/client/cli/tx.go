@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+var logger = helper.Logger.With("module", "upgrade/client/cli")
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade along with an initial deposit.
+Once the upgrade height is reached, nodes running a binary without a handler
+for the upgrade name halt, and must be restarted with the new binary.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v0.3.0 --upgrade-height=1000000 --info="<binary link>" --title="Upgrade" --description="Upgrade to v0.3.0" --deposit="1000000000000000000matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(viper.GetString(FlagTitle), viper.GetString(FlagDescription), plan)
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "--upgrade-height=<height at which upgrade is applied>")
+	cmd.Flags().String(FlagUpgradeInfo, "", "--info=<info for the upgrade plan, eg. binary download links>")
+	addProposalFlags(cmd, "GetCmdSubmitUpgradeProposal")
+	if err := cmd.MarkFlagRequired(FlagUpgradeHeight); err != nil {
+		logger.Error("GetCmdSubmitUpgradeProposal | MarkFlagRequired | FlagUpgradeHeight", "Error", err)
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a cancel software upgrade proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to cancel the scheduled software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the scheduled software upgrade along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade --title="Cancel upgrade" --description="Cancel v0.3.0" --deposit="1000000000000000000matic" --validator-id=1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content := types.NewCancelSoftwareUpgradeProposal(viper.GetString(FlagTitle), viper.GetString(FlagDescription))
+
+			return submitProposal(cliCtx, content)
+		},
+	}
+
+	addProposalFlags(cmd, "GetCmdSubmitCancelUpgradeProposal")
+
+	return cmd
+}
+
+func submitProposal(cliCtx context.CLIContext, content govTypes.Content) error {
+	validatorID := viper.GetUint64(FlagValidatorID)
+	if validatorID == 0 {
+		return fmt.Errorf("Valid validator ID required")
+	}
+
+	deposit, err := sdk.ParseCoins(viper.GetString(FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	from := helper.GetFromAddress(cliCtx)
+
+	// create submit proposal
+	msg := govTypes.NewMsgSubmitProposal(content, deposit, from, hmTypes.NewValidatorID(validatorID))
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+}
+
+func addProposalFlags(cmd *cobra.Command, name string) {
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
+	if err := cmd.MarkFlagRequired(FlagValidatorID); err != nil {
+		logger.Error(name+" | MarkFlagRequired | FlagValidatorID", "Error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	govRest "github.com/maticnetwork/heimdall/gov/client/rest"
+	govTypes "github.com/maticnetwork/heimdall/gov/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// PlanRequest defines a software upgrade proposal request body
+type PlanRequest struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string                  `json:"title" yaml:"title"`
+	Description   string                  `json:"description" yaml:"description"`
+	UpgradeName   string                  `json:"name" yaml:"name"`
+	UpgradeHeight int64                   `json:"upgrade_height" yaml:"upgrade_height"`
+	UpgradeInfo   string                  `json:"info" yaml:"info"`
+	Proposer      hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Validator     hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+}
+
+// CancelRequest defines a cancel software upgrade proposal request body
+type CancelRequest struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	Proposer    hmTypes.HeimdallAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Validator   hmTypes.ValidatorID     `json:"validator" yaml:"validator"`
+}
+
+// ProposalRESTHandler returns the software upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "upgrade",
+		Handler:  postPlanHandler(cliCtx),
+	}
+}
+
+// CancelProposalRESTHandler returns the cancel software upgrade REST handler with a given sub-route.
+func CancelProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "upgrade_cancel",
+		Handler:  postCancelPlanHandler(cliCtx),
+	}
+}
+
+func postPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlanRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		plan := types.NewPlan(req.UpgradeName, req.UpgradeHeight, req.UpgradeInfo)
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+
+		msg := govTypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, req.Validator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,32 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// InitGenesis sets the done upgrades and schedules the upgrade plan of genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	for _, upgrade := range data.DoneUpgrades {
+		keeper.setDoneHeight(ctx, upgrade.Name, upgrade.Height)
+	}
+
+	if data.Plan != nil {
+		if err := keeper.ScheduleUpgrade(ctx, *data.Plan); err != nil {
+			panic(fmt.Sprintf("failed to schedule upgrade plan of genesis: %s", err.Error()))
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	var plan *types.Plan
+	if scheduled, found := keeper.GetUpgradePlan(ctx); found {
+		plan = &scheduled
+	}
+
+	return types.NewGenesisState(plan, keeper.GetDoneUpgrades(ctx))
+}
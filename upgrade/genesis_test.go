@@ -0,0 +1,122 @@
+package upgrade_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+// SetupTest setup necessary things for genesis test
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(true)
+}
+
+// TestGenesisTestSuite
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	plan := types.NewPlan("upgrade-3", 100, "info")
+	genesisState := types.NewGenesisState(&plan, []types.DoneUpgrade{
+		{Name: "upgrade-1", Height: 10},
+		{Name: "upgrade-2", Height: 20},
+	})
+	require.NoError(t, types.ValidateGenesis(genesisState))
+
+	upgrade.InitGenesis(ctx, app.UpgradeKeeper, genesisState)
+
+	actualPlan, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, actualPlan)
+	require.Equal(t, int64(10), app.UpgradeKeeper.GetDoneHeight(ctx, "upgrade-1"))
+	require.Equal(t, int64(20), app.UpgradeKeeper.GetDoneHeight(ctx, "upgrade-2"))
+
+	// done upgrades cannot be scheduled again
+	require.NotNil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, types.NewPlan("upgrade-1", 200, "")))
+
+	actualParams := upgrade.ExportGenesis(ctx, app.UpgradeKeeper)
+	require.Equal(t, genesisState, actualParams)
+}
+
+func (suite *GenesisTestSuite) TestAppGenesis() {
+	t := suite.T()
+
+	// the upgrade state of app genesis is imported on init chain and exported again
+	plan := types.NewPlan("upgrade-2", 100, "")
+	genesisState := types.NewGenesisState(&plan, []types.DoneUpgrade{{Name: "upgrade-1", Height: 10}})
+
+	appState := app.NewDefaultGenesisState()
+	appState[types.ModuleName] = types.ModuleCdc.MustMarshalJSON(genesisState)
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(appState))
+
+	happ := app.NewHeimdallApp(log.NewNopLogger(), dbm.NewMemDB())
+	stateBytes, err := codec.MarshalJSONIndent(happ.Codec(), appState)
+	require.NoError(t, err)
+	happ.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	happ.Commit()
+
+	exportedBytes, _, err := happ.ExportAppStateAndValidators()
+	require.NoError(t, err)
+
+	var exported map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exportedBytes, &exported))
+	require.Equal(t, genesisState, types.GetGenesisStateFromAppState(exported))
+}
+
+func (suite *GenesisTestSuite) TestExportDefaultGenesis() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	genesisState := upgrade.ExportGenesis(ctx, app.UpgradeKeeper)
+	require.Equal(t, types.DefaultGenesisState(), genesisState)
+
+	// applied upgrade plan is exported as done
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {})
+	ctx = ctx.WithBlockHeight(10)
+	plan := types.NewPlan("test", 10, "")
+	app.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	genesisState = upgrade.ExportGenesis(ctx, app.UpgradeKeeper)
+	require.Nil(t, genesisState.Plan)
+	require.Equal(t, []types.DoneUpgrade{{Name: "test", Height: 10}}, genesisState.DoneUpgrades)
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	t := suite.T()
+
+	plan := types.NewPlan("upgrade-2", 100, "")
+	done := []types.DoneUpgrade{{Name: "upgrade-1", Height: 10}}
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState(&plan, done)))
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	invalid := []types.GenesisState{
+		types.NewGenesisState(&types.Plan{Name: "", Height: 100}, nil),
+		types.NewGenesisState(&types.Plan{Name: "upgrade-1", Height: 100}, done),
+		types.NewGenesisState(nil, []types.DoneUpgrade{{Name: "upgrade-1", Height: 0}}),
+		types.NewGenesisState(nil, []types.DoneUpgrade{{Name: "", Height: 10}}),
+		types.NewGenesisState(nil, append(done, done...)),
+	}
+	for _, genesisState := range invalid {
+		require.Error(t, types.ValidateGenesis(genesisState), "%+v", genesisState)
+	}
+}
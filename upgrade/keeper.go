@@ -0,0 +1,177 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// upgrade handlers registered by the running binary
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+) Keeper {
+	return Keeper{
+		cdc:             cdc,
+		storeKey:        storeKey,
+		codespace:       codespace,
+		upgradeHandlers: make(map[string]types.UpgradeHandler),
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetUpgradeHandler registers the store migrations of an upgrade by name.
+// Handlers must be registered before the app starts processing blocks.
+func (k Keeper) SetUpgradeHandler(name string, handler types.UpgradeHandler) {
+	if _, ok := k.upgradeHandlers[name]; ok {
+		panic(fmt.Sprintf("upgrade handler %s has already been registered", name))
+	}
+
+	k.upgradeHandlers[name] = handler
+}
+
+// HasHandler returns true if the running binary knows the upgrade with given name
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan.
+// If there is another plan already scheduled, it will overwrite it.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(k.codespace, fmt.Sprintf("upgrade cannot be scheduled in the past, current height %d", ctx.BlockHeight()))
+	}
+
+	if doneHeight := k.GetDoneHeight(ctx, plan.Name); doneHeight != 0 {
+		return types.ErrUpgradeDone(k.codespace, plan.Name, doneHeight)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+
+	k.Logger(ctx).Info("Scheduled upgrade", "name", plan.Name, "height", plan.Height)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(plan.Height, 10)),
+		),
+	)
+
+	return nil
+}
+
+// GetUpgradePlan returns the currently scheduled plan, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+// GetDoneHeight returns the height at which the given upgrade was applied, 0 if never
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// GetDoneUpgrades returns all applied upgrades ordered by name
+func (k Keeper) GetDoneUpgrades(ctx sdk.Context) []types.DoneUpgrade {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DoneKeyPrefix)
+	defer iterator.Close()
+
+	upgrades := []types.DoneUpgrade{}
+	for ; iterator.Valid(); iterator.Next() {
+		upgrades = append(upgrades, types.DoneUpgrade{
+			Name:   string(iterator.Key()[len(types.DoneKeyPrefix):]),
+			Height: int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	return upgrades
+}
+
+// setDone marks the upgrade with given name as applied at current height
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	k.setDoneHeight(ctx, name, ctx.BlockHeight())
+}
+
+// setDoneHeight marks the upgrade with given name as applied at given height
+func (k Keeper) setDoneHeight(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(types.GetDoneKey(name), bz)
+}
+
+// ApplyUpgrade runs the registered handler of the plan and marks the plan as done
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("no upgrade handler registered for %s", plan.Name))
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+}
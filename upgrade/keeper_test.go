@@ -0,0 +1,144 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestScheduleUpgrade() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.UpgradeKeeper
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// past height
+	err := keeper.ScheduleUpgrade(ctx, types.NewPlan("test", 10, ""))
+	require.NotNil(t, err)
+
+	// empty name
+	err = keeper.ScheduleUpgrade(ctx, types.NewPlan("", 20, ""))
+	require.NotNil(t, err)
+
+	plan := types.NewPlan("test", 20, "info")
+	err = keeper.ScheduleUpgrade(ctx, plan)
+	require.Nil(t, err)
+
+	actual, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, actual)
+
+	// overwrite existing plan
+	plan = types.NewPlan("test2", 30, "")
+	err = keeper.ScheduleUpgrade(ctx, plan)
+	require.Nil(t, err)
+
+	actual, found = keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, actual)
+
+	keeper.ClearUpgradePlan(ctx)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerWithoutHandler() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.UpgradeKeeper
+
+	err := keeper.ScheduleUpgrade(ctx, types.NewPlan("test", 20, ""))
+	require.Nil(t, err)
+
+	// not due yet
+	require.NotPanics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(19), keeper)
+	})
+
+	// halt at upgrade height
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(20), keeper)
+	})
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerWithHandler() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	keeper := app.UpgradeKeeper
+
+	err := keeper.ScheduleUpgrade(ctx, types.NewPlan("test", 20, ""))
+	require.Nil(t, err)
+
+	called := 0
+	keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {
+		called++
+	})
+
+	// handler shipped with the scheduling binary waits for the plan height
+	require.NotPanics(t, func() {
+		upgrade.BeginBlocker(ctx.WithBlockHeight(19), keeper)
+	})
+	require.Equal(t, 0, called)
+
+	// apply upgrade
+	upgrade.BeginBlocker(ctx.WithBlockHeight(20), keeper)
+	require.Equal(t, 1, called)
+	require.Equal(t, int64(20), keeper.GetDoneHeight(ctx, "test"))
+
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// handler runs only once
+	upgrade.BeginBlocker(ctx.WithBlockHeight(21), keeper)
+	require.Equal(t, 1, called)
+
+	// same upgrade can't be scheduled again
+	err = keeper.ScheduleUpgrade(ctx.WithBlockHeight(21), types.NewPlan("test", 30, ""))
+	require.NotNil(t, err)
+}
+
+func (suite *KeeperTestSuite) TestProposalHandler() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+	handler := upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)
+
+	// nothing to cancel
+	err := handler(ctx, types.NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.NotNil(t, err)
+
+	plan := types.NewPlan("test", 20, "")
+	err = handler(ctx, types.NewSoftwareUpgradeProposal("title", "description", plan))
+	require.Nil(t, err)
+
+	actual, found := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, actual)
+
+	err = handler(ctx, types.NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.Nil(t, err)
+
+	_, found = app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
@@ -0,0 +1,102 @@
+package upgrade_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/upgrade"
+	"github.com/maticnetwork/heimdall/upgrade/types"
+)
+
+// QuerierTestSuite integrate test suite context object
+type QuerierTestSuite struct {
+	suite.Suite
+
+	app     *app.HeimdallApp
+	ctx     sdk.Context
+	querier sdk.Querier
+}
+
+// SetupTest setup all necessary things for querier tesing
+func (suite *QuerierTestSuite) SetupTest() {
+	suite.app, suite.ctx = createTestApp(false)
+	suite.querier = upgrade.NewQuerier(suite.app.UpgradeKeeper)
+}
+
+// TestQuerierTestSuite
+func TestQuerierTestSuite(t *testing.T) {
+	suite.Run(t, new(QuerierTestSuite))
+}
+
+// TestInvalidQuery checks request query
+func (suite *QuerierTestSuite) TestInvalidQuery() {
+	t, _, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	req := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{"other"}, req)
+	require.Error(t, err)
+	require.Nil(t, bz)
+}
+
+// TestQueryCurrent queries current plan
+func (suite *QuerierTestSuite) TestQueryCurrent() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryCurrent}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent),
+		Data: []byte{},
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	plan := types.NewPlan("test", 20, "info")
+	require.Nil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	var actual types.Plan
+	require.NoError(t, json.Unmarshal(res, &actual))
+	require.Equal(t, plan, actual)
+}
+
+// TestQueryApplied queries applied upgrade height
+func (suite *QuerierTestSuite) TestQueryApplied() {
+	t, app, ctx, querier := suite.T(), suite.app, suite.ctx, suite.querier
+
+	path := []string{types.QueryApplied}
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryAppliedParams("test")),
+	}
+
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	require.Nil(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, types.NewPlan("test", 20, "")))
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan) {})
+	upgrade.BeginBlocker(ctx.WithBlockHeight(20), app.UpgradeKeeper)
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var height int64
+	require.NoError(t, json.Unmarshal(res, &height))
+	require.Equal(t, int64(20), height)
+}
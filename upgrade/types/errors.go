@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = "upgrade"
+
+	CodeInvalidPlan     sdk.CodeType = 1
+	CodeUpgradeDone     sdk.CodeType = 2
+	CodeNoUpgradePlan   sdk.CodeType = 3
+	CodeInvalidProposal sdk.CodeType = 4
+)
+
+// ErrInvalidPlan returns an error for an invalid upgrade plan
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, fmt.Sprintf("invalid upgrade plan: %s", msg))
+}
+
+// ErrUpgradeDone returns an error when plan name has already been applied
+func ErrUpgradeDone(codespace sdk.CodespaceType, name string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeDone, fmt.Sprintf("upgrade with name %s has already been completed at height %d", name, height))
+}
+
+// ErrNoUpgradePlan returns an error when no upgrade plan is scheduled
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "there is no upgrade plan scheduled")
+}
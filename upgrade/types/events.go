@@ -0,0 +1,13 @@
+package types
+
+// Upgrade tags
+var (
+	EventTypeUpgrade         = "upgrade"
+	EventTypeScheduleUpgrade = "schedule-upgrade"
+	EventTypeCancelUpgrade   = "cancel-upgrade"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+
+	AttributeValueCategory = ModuleName
+)
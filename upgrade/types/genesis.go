@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState is the upgrade state that must be provided at genesis.
+type GenesisState struct {
+	Plan         *Plan         `json:"plan" yaml:"plan"`                   // scheduled upgrade, if any
+	DoneUpgrades []DoneUpgrade `json:"done_upgrades" yaml:"done_upgrades"` // applied upgrades ordered by name
+}
+
+// DoneUpgrade is an upgrade applied at a height
+type DoneUpgrade struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(plan *Plan, doneUpgrades []DoneUpgrade) GenesisState {
+	return GenesisState{
+		Plan:         plan,
+		DoneUpgrades: doneUpgrades,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil, []DoneUpgrade{})
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	done := make(map[string]bool, len(data.DoneUpgrades))
+	for _, upgrade := range data.DoneUpgrades {
+		if upgrade.Name == "" || upgrade.Height <= 0 {
+			return fmt.Errorf("invalid done upgrade %s at height %d", upgrade.Name, upgrade.Height)
+		}
+
+		if done[upgrade.Name] {
+			return fmt.Errorf("duplicate done upgrade %s", upgrade.Name)
+		}
+		done[upgrade.Name] = true
+	}
+
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid upgrade plan: %s", err.Error())
+		}
+
+		if done[data.Plan.Name] {
+			return fmt.Errorf("upgrade plan %s has already been done", data.Plan.Name)
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns upgrade GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
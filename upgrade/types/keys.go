@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PlanKey is the key under which the current upgrade plan is saved
+	PlanKey = []byte{0x00}
+
+	// DoneKeyPrefix is the prefix for the height at which an upgrade was applied
+	DoneKeyPrefix = []byte{0x01}
+)
+
+// GetDoneKey returns the store key of applied upgrade with given name
+func GetDoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler runs the store migrations of a named upgrade. It is executed
+// exactly once, in the begin blocker of the height the upgrade is scheduled at.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Name is the upgrade name. The new binary registers its upgrade handler
+	// under this name, so it must be unique across all upgrades.
+	Name string `json:"name" yaml:"name"`
+
+	// Height is the heimdall height at which the upgrade is applied
+	Height int64 `json:"height" yaml:"height"`
+
+	// Info is any application specific information, eg. binary download links
+	Info string `json:"info,omitempty" yaml:"info,omitempty"`
+}
+
+// NewPlan creates a new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the plan is due at the current block height
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}